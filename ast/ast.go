@@ -340,7 +340,7 @@ func (as *AssertionStatement) EvLogString(negate bool) string {
 	}
 	out.WriteString(as.Constraint.Left.String())
 	out.WriteString(" ")
-	if !as.Assume && negate {
+	if !as.Assume && negate && !as.IsLTL() {
 		out.WriteString(util.OP_NEGATE[as.Constraint.Operator])
 	} else {
 		out.WriteString(as.Constraint.Operator)
@@ -359,6 +359,70 @@ func (as *AssertionStatement) EvLogString(negate bool) string {
 	out.WriteString(";")
 	return out.String()
 }
+
+// IsLTL is true if temporal operators are nested inside the
+// constraint, these asserts are encoded as bounded LTL instead
+// of with a trailing temporal filter
+func (as *AssertionStatement) IsLTL() bool {
+	return as.Constraint != nil && HasTemporal(as.Constraint)
+}
+
+// IsProbabilistic is true if the assert bounds the probability
+// of an event, prob(event) < 0.01
+func (as *AssertionStatement) IsProbabilistic() bool {
+	if as.Constraint == nil {
+		return false
+	}
+	_, l := as.Constraint.Left.(*ProbabilityExpression)
+	_, r := as.Constraint.Right.(*ProbabilityExpression)
+	return l || r
+}
+
+// OptimizeStatement asks for the largest or smallest value an
+// expression reaches at the end of a round, or in any round if
+// Round is -1: maximize queue.depth;
+type OptimizeStatement struct {
+	Token     Token
+	Direction string // maximize or minimize
+	Target    Expression
+	Round     int64
+}
+
+func (ot *OptimizeStatement) statementNode()       {}
+func (ot *OptimizeStatement) TokenLiteral() string { return ot.Token.Literal }
+func (ot *OptimizeStatement) Position() []int      { return ot.Token.GetPosition() }
+func (ot *OptimizeStatement) String() string {
+	if ot.Round < 0 {
+		return fmt.Sprintf("%s %s;", ot.Direction, ot.Target.String())
+	}
+	return fmt.Sprintf("%s %s at %d;", ot.Direction, ot.Target.String(), ot.Round)
+}
+func (ot *OptimizeStatement) GetToken() Token {
+	return ot.Token
+}
+func (ot *OptimizeStatement) Type() string {
+	return ot.Target.Type()
+}
+func (ot *OptimizeStatement) SetType(ty *Type) {
+	//Skip
+}
+
+// HasTemporal is true if there's a temporal operator anywhere
+// in the expression
+func HasTemporal(ex Expression) bool {
+	switch e := ex.(type) {
+	case *TemporalPrefix, *TemporalInfix, *TemporalWindow:
+		return true
+	case *InvariantClause:
+		return TEMPORAL_BINARY[e.Operator] || HasTemporal(e.Left) || HasTemporal(e.Right)
+	case *InfixExpression:
+		return HasTemporal(e.Left) || HasTemporal(e.Right)
+	case *PrefixExpression:
+		return HasTemporal(e.Right)
+	}
+	return false
+}
+
 func (as *AssertionStatement) GetToken() Token {
 	return as.Token
 }
@@ -869,14 +933,23 @@ func (n *Natural) RawId() []string {
 type Uncertain struct {
 	Token         Token
 	InferredType  *Type
-	Mean          float64
+	Mean          float64 // Normal distribution
 	Sigma         float64
+	Dist          string    // Any other distribution, normal if empty
+	Params        []float64 // Parameters of Dist, see DISTRIBUTIONS
+	Truncated     bool      // Values are kept between Low and High
+	Low           float64
+	High          float64
 	ProcessedName []string
 }
 
 func (u *Uncertain) expressionNode()      {}
 func (u *Uncertain) TokenLiteral() string { return u.Token.Literal }
 func (u *Uncertain) String() string {
+	if u.Dist != "" && u.Dist != "normal" {
+		return u.Distribution().String() + ";"
+	}
+
 	var out bytes.Buffer
 	out.WriteString("Mean: ")
 	out.WriteString(strconv.FormatFloat(u.Mean, 'f', 6, 64))
@@ -888,6 +961,14 @@ func (u *Uncertain) String() string {
 func (u *Uncertain) GetToken() Token {
 	return u.Token
 }
+func (u *Uncertain) Distribution() *Distribution {
+	d := &Distribution{Kind: u.Dist, Params: u.Params, Truncated: u.Truncated, Low: u.Low, High: u.High}
+	if u.Dist == "" || u.Dist == "normal" {
+		d.Kind = "normal"
+		d.Params = []float64{u.Mean, u.Sigma}
+	}
+	return d
+}
 func (u *Uncertain) Type() string {
 	t := u.InferredType
 	if t != nil {
@@ -1045,6 +1126,218 @@ func (ie *InfixExpression) SetType(ty *Type) {
 	ie.InferredType = ty
 }
 
+// TemporalPrefix is a unary bounded LTL operator over the run
+// rounds (next, always, eventually)
+type TemporalPrefix struct {
+	Token        Token
+	InferredType *Type
+	Operator     string
+	Right        Expression
+}
+
+func (tp *TemporalPrefix) expressionNode()      {}
+func (tp *TemporalPrefix) TokenLiteral() string { return tp.Token.Literal }
+func (tp *TemporalPrefix) Position() []int      { return tp.Token.GetPosition() }
+func (tp *TemporalPrefix) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(tp.Operator + " ")
+	out.WriteString(tp.Right.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+func (tp *TemporalPrefix) GetToken() Token {
+	return tp.Token
+}
+func (tp *TemporalPrefix) Type() string {
+	if tp.InferredType != nil {
+		return tp.InferredType.Type
+	}
+	return "BOOL"
+}
+func (tp *TemporalPrefix) SetType(ty *Type) {
+	tp.InferredType = ty
+}
+
+// TemporalInfix is a binary bounded LTL operator over the run
+// rounds (until, weak-until, release)
+type TemporalInfix struct {
+	Token        Token
+	InferredType *Type
+	Left         Expression
+	Operator     string
+	Right        Expression
+}
+
+func (ti *TemporalInfix) expressionNode()      {}
+func (ti *TemporalInfix) TokenLiteral() string { return ti.Token.Literal }
+func (ti *TemporalInfix) Position() []int      { return ti.Token.GetPosition() }
+func (ti *TemporalInfix) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(ti.Left.String())
+	out.WriteString(" " + ti.Operator + " ")
+	out.WriteString(ti.Right.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+func (ti *TemporalInfix) GetToken() Token {
+	return ti.Token
+}
+func (ti *TemporalInfix) Type() string {
+	if ti.InferredType != nil {
+		return ti.InferredType.Type
+	}
+	return "BOOL"
+}
+func (ti *TemporalInfix) SetType(ty *Type) {
+	ti.InferredType = ty
+}
+
+// TemporalWindow bounds when an assert (or the then side of a
+// when/then) has to hold: within N or between N and M rounds,
+// or in every round after N. To is -1 for after, the window
+// runs to the end of the run.
+type TemporalWindow struct {
+	Token        Token
+	InferredType *Type
+	Operator     string
+	From         int
+	To           int
+	Right        Expression
+}
+
+func (tw *TemporalWindow) expressionNode()      {}
+func (tw *TemporalWindow) TokenLiteral() string { return tw.Token.Literal }
+func (tw *TemporalWindow) Position() []int      { return tw.Token.GetPosition() }
+func (tw *TemporalWindow) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(tw.Right.String())
+	switch tw.Operator {
+	case "within":
+		out.WriteString(fmt.Sprintf(" within %d", tw.To))
+	case "after":
+		out.WriteString(fmt.Sprintf(" after %d", tw.From))
+	case "between":
+		out.WriteString(fmt.Sprintf(" between %d and %d", tw.From, tw.To))
+	}
+	out.WriteString(")")
+
+	return out.String()
+}
+func (tw *TemporalWindow) GetToken() Token {
+	return tw.Token
+}
+func (tw *TemporalWindow) Type() string {
+	if tw.InferredType != nil {
+		return tw.InferredType.Type
+	}
+	return "BOOL"
+}
+func (tw *TemporalWindow) SetType(ty *Type) {
+	tw.InferredType = ty
+}
+
+// Parameters of the distributions an uncertain value can be
+// drawn from, in the order they're written:
+// uncertain(exponential, 0.5)
+var DISTRIBUTIONS = map[string][]string{
+	"normal":      {"mean", "sigma"},
+	"exponential": {"rate"},
+	"poisson":     {"lambda"},
+	"uniform":     {"min", "max"},
+	"lognormal":   {"mu", "sigma"},
+	"beta":        {"alpha", "beta"},
+	"bernoulli":   {"p"}, // Boolean, true with probability p
+}
+
+// Distribution is what an uncertain value is drawn from, with
+// the bounds it's truncated to if Truncated is set
+type Distribution struct {
+	Kind      string
+	Params    []float64
+	Truncated bool
+	Low       float64
+	High      float64
+}
+
+func NewNormal(mean float64, sigma float64) *Distribution {
+	return &Distribution{Kind: "normal", Params: []float64{mean, sigma}}
+}
+
+func (d *Distribution) String() string {
+	var params []string
+	for _, p := range d.Params {
+		params = append(params, strconv.FormatFloat(p, 'f', -1, 64))
+	}
+
+	s := fmt.Sprintf("%s(%s)", d.Kind, strings.Join(params, ", "))
+	if d.Truncated {
+		s = fmt.Sprintf("%s truncated to [%s, %s]", s, strconv.FormatFloat(d.Low, 'f', -1, 64), strconv.FormatFloat(d.High, 'f', -1, 64))
+	}
+	return s
+}
+
+// ProbabilityExpression is the chance an event happens in a
+// run, prob(event). It's only valid bounded by a number in an
+// assert and is estimated by sampling the uncertain values
+// rather than by the solver.
+type ProbabilityExpression struct {
+	Token        Token
+	InferredType *Type
+	Event        Expression
+}
+
+func (pe *ProbabilityExpression) expressionNode()      {}
+func (pe *ProbabilityExpression) TokenLiteral() string { return pe.Token.Literal }
+func (pe *ProbabilityExpression) Position() []int      { return pe.Token.GetPosition() }
+func (pe *ProbabilityExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("prob(")
+	out.WriteString(pe.Event.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+func (pe *ProbabilityExpression) GetToken() Token {
+	return pe.Token
+}
+func (pe *ProbabilityExpression) Type() string {
+	if pe.InferredType != nil {
+		return pe.InferredType.Type
+	}
+	return "FLOAT"
+}
+func (pe *ProbabilityExpression) SetType(ty *Type) {
+	pe.InferredType = ty
+}
+
+// Operators allowed in TemporalPrefix and TemporalInfix
+var TEMPORAL_UNARY = map[string]bool{
+	"next":       true,
+	"always":     true,
+	"eventually": true,
+}
+
+var TEMPORAL_BINARY = map[string]bool{
+	"until":      true,
+	"weak-until": true,
+	"release":    true,
+}
+
+var TEMPORAL_WINDOW = map[string]bool{
+	"within":  true,
+	"after":   true,
+	"between": true,
+}
+
 type Boolean struct {
 	Token         Token
 	InferredType  *Type
@@ -646,3 +646,33 @@ func TestIsCompare(t *testing.T) {
 		}
 	}
 }
+
+func TestIsLTL(t *testing.T) {
+	token := Token{Literal: "test", Position: []int{1, 2, 3, 4}}
+	a := &Identifier{Token: token, Value: "a"}
+	b := &Identifier{Token: token, Value: "b"}
+
+	plain := &AssertionStatement{Token: token, Constraint: &InvariantClause{Left: a, Operator: "&&", Right: b}}
+	if plain.IsLTL() {
+		t.Fatal("assert without temporal operators is LTL")
+	}
+
+	nested := &AssertionStatement{Token: token, Constraint: &InvariantClause{
+		Left:     &PrefixExpression{Operator: "!", Right: &TemporalPrefix{Token: token, Operator: "next", Right: a}},
+		Operator: "||",
+		Right:    b,
+	}}
+	if !nested.IsLTL() {
+		t.Fatal("nested next not found")
+	}
+
+	until := &AssertionStatement{Token: token, Constraint: &InvariantClause{Left: a, Operator: "until", Right: b}}
+	if !until.IsLTL() {
+		t.Fatal("until not found")
+	}
+
+	te := &TemporalInfix{Token: token, Left: a, Operator: "release", Right: b}
+	if te.String() != "(a release b)" || te.Type() != "BOOL" {
+		t.Fatalf("temporal expression not correct. got=%s %s", te.String(), te.Type())
+	}
+}
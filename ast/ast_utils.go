@@ -173,3 +173,36 @@ func Evaluate(n *InfixExpression) Expression {
 		}
 	}
 }
+
+// Error is a problem with the spec, at the position of the
+// node it was found on. Position is nil when there's no
+// node to blame, nodes built outside the parser are at line 0.
+type Error struct {
+	Position []int
+	Msg      string
+}
+
+func Errorf(pos []int, format string, a ...interface{}) *Error {
+	return &Error{Position: pos, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (e *Error) Error() string {
+	if e.Line() == 0 {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s line: %d, col: %d", e.Msg, e.Position[0], e.Position[1])
+}
+
+func (e *Error) Line() int {
+	if len(e.Position) < 2 {
+		return 0
+	}
+	return e.Position[0]
+}
+
+func (e *Error) Col() int {
+	if len(e.Position) < 2 {
+		return 0
+	}
+	return e.Position[1]
+}
@@ -0,0 +1,107 @@
+package execute
+
+import "fmt"
+
+// Iterative deepening. The generator logs the violations of
+// each round separately, the model checker asks about one
+// round at a time and stops at the first that fails. Every
+// earlier round is known to hold, so the failure is as short
+// as a failure gets. If the generator split the rules by round
+// too, each round's rules are added to the context just before
+// it's checked, so the solver never gets the model twice.
+
+type Deepening struct {
+	Checked int      // Rounds that hold, in order from round 0
+	Round   int      // Round the failure happens in, -1 if none does
+	Failure *Failure // Cut off after the round that fails
+}
+
+// Deepen checks the rounds in order. checked, if not nil, is
+// called with each round that holds before the next is checked.
+func (mc *ModelChecker) Deepen(checked func(round int)) (*Deepening, error) {
+	d := &Deepening{Round: -1}
+	for r, v := range mc.Log.Rounds {
+		if err := mc.addRound(r); err != nil {
+			return d, err
+		}
+
+		f, err := mc.failsIn(v)
+		if err != nil {
+			return d, err
+		}
+
+		if f != nil {
+			f.Log.Cut(r)
+			d.Round, d.Failure = r, f
+			return d, mc.addRounds(r + 1)
+		}
+
+		d.Checked = r + 1
+		if checked != nil {
+			checked(r)
+		}
+	}
+	return d, nil
+}
+
+// addRounds adds every round from the first on, so checks after
+// deepening see the whole model
+func (mc *ModelChecker) addRounds(first int) error {
+	for r := first; r < len(mc.Log.RoundRules); r++ {
+		if err := mc.addRound(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addRound adds a round's rules outside of any scope, they stay
+// for the rounds after it
+func (mc *ModelChecker) addRound(r int) error {
+	if r >= len(mc.Log.RoundRules) {
+		return nil
+	}
+	for _, rule := range mc.Log.RoundRules[r] {
+		if err := mc.command(rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// failsIn looks for a run with the violation, the most likely
+// one if mc.Likely is set
+func (mc *ModelChecker) failsIn(violation string) (*Failure, error) {
+	if err := mc.Push(); err != nil {
+		return nil, err
+	}
+	defer mc.Pop()
+
+	if err := mc.Assert(violation); err != nil {
+		return nil, err
+	}
+
+	results, ok, err := mc.NextFailure()
+	if err != nil || !ok {
+		return nil, err
+	}
+	results, err = mc.Filter(results)
+	if err != nil {
+		return nil, err
+	}
+	return &Failure{
+		Results: results,
+		Values:  mc.ResultValues,
+		Log:     copyLog(mc.Log),
+	}, nil
+}
+
+func (d *Deepening) String() string {
+	if d.Failure == nil && d.Checked == 0 {
+		return "no rounds to check\n"
+	}
+	if d.Failure == nil {
+		return fmt.Sprintf("no failures, checked up to round %d\n", d.Checked-1)
+	}
+	return fmt.Sprintf("shortest failure in round %d\n", d.Round)
+}
@@ -0,0 +1,151 @@
+package execute
+
+import (
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Fails in round 2, every earlier round holds
+func deepenStub(t *testing.T) *Solver {
+	path := stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(pop 1)"*) fails= ;;
+*"(assert (< a_value_2 0))"*) fails=1 ;;
+*check-sat*) if [ -n "$fails$probe" ]; then echo sat; else echo unsat; fi ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; probe=; continue; fi
+	echo "(model"
+	echo "  (define-fun a_value_0 () Real 30.0)"
+	echo "  (define-fun a_value_1 () Real 10.0)"
+	echo "  (define-fun a_value_2 () Real (- 10.0))"
+	echo "  (define-fun a_value_3 () Real (- 30.0))"
+	echo ")" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepDeepen(t *testing.T, rounds ...string) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = deepenStub(t)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	l.Add(resultlog.NewChange(1, "", "a_value_1"))
+	l.Add(resultlog.NewChange(2, "", "a_value_2"))
+	l.Add(resultlog.NewChange(3, "", "a_value_3"))
+	l.Rounds = rounds
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{}, nil, nil, l)
+	mc.LoadMeta(forks.InitFork())
+	return mc
+}
+
+func TestDeepen(t *testing.T) {
+	mc := prepDeepen(t, "(< a_value_0 0)", "(< a_value_1 0)", "(< a_value_2 0)", "(< a_value_3 0)")
+	defer mc.Close()
+
+	var checked []int
+	d, err := mc.Deepen(func(round int) { checked = append(checked, round) })
+	if err != nil {
+		t.Fatalf("deepening failed. got=%s", err)
+	}
+
+	if d.Round != 2 || d.Checked != 2 || len(checked) != 2 || checked[1] != 1 {
+		t.Fatalf("shortest failure not found. got=%+v checked=%v", d, checked)
+	}
+
+	if d.String() != "shortest failure in round 2\n" {
+		t.Fatalf("deepening not formatted correctly. got=%s", d)
+	}
+
+	for _, e := range d.Failure.Log.Events {
+		if e.Dead != (e.Round > 2) {
+			t.Fatalf("failure not cut after round 2. got=%+v", e)
+		}
+	}
+
+	ok, err := mc.Check()
+	if err != nil || ok {
+		t.Fatalf("violation not removed after the round. got=%v %s", ok, err)
+	}
+}
+
+func TestDeepenHolds(t *testing.T) {
+	mc := prepDeepen(t, "(< a_value_0 0)", "false")
+	defer mc.Close()
+
+	d, err := mc.Deepen(nil)
+	if err != nil {
+		t.Fatalf("deepening failed. got=%s", err)
+	}
+
+	if d.Failure != nil || d.Round != -1 || d.Checked != 2 {
+		t.Fatalf("failure found in rounds that hold. got=%+v", d)
+	}
+
+	if d.String() != "no failures, checked up to round 1\n" {
+		t.Fatalf("deepening not formatted correctly. got=%s", d)
+	}
+}
+
+func TestDeepenAddsRounds(t *testing.T) {
+	sent := filepath.Join(t.TempDir(), "sent")
+	path := stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+echo "$line" >> `+sent+`
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*check-sat*) if [ -n "$probe" ]; then echo sat; else echo unsat; fi ;;
+*get-model*) echo "(model (define-fun fault_probe () Real 2.0))"; probe= ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	mc := prepDeepen(t, "(< a_value_0 0)", "(< a_value_1 0)")
+	mc.solver["stub"] = NewSolver("stub", path, nil)
+	mc.Log.RoundRules = [][]string{{"(assert (= a_value_0 30.0))"}, {"(assert (= a_value_1 10.0))"}}
+
+	d, err := mc.Deepen(nil)
+	if err != nil {
+		t.Fatalf("deepening failed. got=%s", err)
+	}
+	mc.Close()
+
+	if d.Failure != nil || d.Checked != 2 {
+		t.Fatalf("failure found in rounds that hold. got=%+v", d)
+	}
+
+	data, err := os.ReadFile(sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := string(data)
+
+	expected := []string{
+		"(declare-fun a_value_0 () Real)",
+		"(assert (= a_value_0 30.0))",
+		"(push 1)", "(assert (< a_value_0 0))", "(check-sat)", "(pop 1)",
+		"(assert (= a_value_1 10.0))",
+		"(push 1)", "(assert (< a_value_1 0))", "(check-sat)", "(pop 1)",
+	}
+	for _, e := range expected {
+		i := strings.Index(input, e)
+		if i == -1 {
+			t.Fatalf("%s not sent in order. got=%s", e, string(data))
+		}
+		input = input[i+len(e):]
+	}
+
+	if strings.Count(string(data), "(declare-fun a_value_0 () Real)") != 1 || strings.Count(string(data), "(assert (= a_value_1 10.0))") != 1 {
+		t.Fatalf("model sent more than once. got=%s", string(data))
+	}
+}
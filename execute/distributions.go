@@ -0,0 +1,118 @@
+package execute
+
+import (
+	"fault/ast"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Distributions for uncertain values. Scenarios are weighted by
+// the density of the value the solver picked and simulations
+// draw from the same distribution, both honoring the truncation
+// bounds if there are any.
+
+type density interface {
+	Prob(float64) float64
+	CDF(float64) float64
+	Mean() float64
+	StdDev() float64
+}
+
+type sampler struct {
+	dist     density
+	quantile func(float64) float64
+	discrete bool
+	low      float64 // Truncation bounds, infinite if there are none
+	high     float64
+	mass     float64 // Probability inside the bounds
+}
+
+func newSampler(d *ast.Distribution) (*sampler, error) {
+	s := &sampler{low: math.Inf(-1), high: math.Inf(1)}
+	if d.Truncated {
+		s.low, s.high = d.Low, d.High
+	}
+
+	p := d.Params
+	switch d.Kind {
+	case "normal":
+		n := distuv.Normal{Mu: p[0], Sigma: p[1]}
+		s.dist, s.quantile = n, n.Quantile
+	case "exponential":
+		e := distuv.Exponential{Rate: p[0]}
+		s.dist, s.quantile = e, e.Quantile
+	case "poisson": // declared Int by the generator
+		ps := distuv.Poisson{Lambda: p[0]}
+		s.dist, s.discrete = ps, true
+		s.quantile = func(u float64) float64 {
+			k := 0.0
+			for ps.CDF(k) < u {
+				k++
+			}
+			return k
+		}
+	case "bernoulli": // 1 for true, 0 for false
+		b := distuv.Bernoulli{P: p[0]}
+		s.dist, s.discrete = b, true
+		s.quantile = func(u float64) float64 {
+			if u < 1-b.P {
+				return 0
+			}
+			return 1
+		}
+	case "uniform":
+		un := distuv.Uniform{Min: p[0], Max: p[1]}
+		s.dist, s.quantile = un, un.Quantile
+	case "lognormal":
+		ln := distuv.LogNormal{Mu: p[0], Sigma: p[1]}
+		s.dist, s.quantile = ln, ln.Quantile
+	case "beta":
+		b := distuv.Beta{Alpha: p[0], Beta: p[1]}
+		s.dist, s.quantile = b, b.Quantile
+	default:
+		return nil, fmt.Errorf("unknown distribution %s", d.Kind)
+	}
+
+	s.mass = s.upTo(s.high) - s.below(s.low)
+	if s.mass <= 0 {
+		return nil, fmt.Errorf("%s has no probability left after truncation", d)
+	}
+	return s, nil
+}
+
+// below is the probability of a value under x
+func (s *sampler) below(x float64) float64 {
+	if math.IsInf(x, -1) {
+		return 0
+	}
+	if s.discrete {
+		return s.dist.CDF(math.Ceil(x) - 1)
+	}
+	return s.dist.CDF(x)
+}
+
+// upTo is the probability of a value of at most x
+func (s *sampler) upTo(x float64) float64 {
+	if math.IsInf(x, 1) {
+		return 1
+	}
+	return s.dist.CDF(x)
+}
+
+// Prob is the density of x, rescaled to the truncated range
+func (s *sampler) Prob(x float64) float64 {
+	if x < s.low || x > s.high {
+		return 0
+	}
+	return s.dist.Prob(x) / s.mass
+}
+
+// Draw inverts the CDF over the part of the range left after
+// truncation
+func (s *sampler) Draw(r *rand.Rand) float64 {
+	lo := s.below(s.low)
+	return s.quantile(lo + r.Float64()*s.mass)
+}
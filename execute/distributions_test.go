@@ -0,0 +1,103 @@
+package execute
+
+import (
+	"fault/ast"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSamplers(t *testing.T) {
+	tests := []struct {
+		dist *ast.Distribution
+		mean float64
+	}{
+		{ast.NewNormal(10, 2), 10},
+		{&ast.Distribution{Kind: "exponential", Params: []float64{0.5}}, 2},
+		{&ast.Distribution{Kind: "poisson", Params: []float64{4}}, 4},
+		{&ast.Distribution{Kind: "uniform", Params: []float64{1, 3}}, 2},
+		{&ast.Distribution{Kind: "lognormal", Params: []float64{0, 0.25}}, math.Exp(0.25 * 0.25 / 2)},
+		{&ast.Distribution{Kind: "beta", Params: []float64{2, 8}}, 0.2},
+		{&ast.Distribution{Kind: "bernoulli", Params: []float64{0.3}}, 0.3},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, test := range tests {
+		s, err := newSampler(test.dist)
+		if err != nil {
+			t.Fatalf("sampler for %s failed. got=%s", test.dist, err)
+		}
+
+		var sum float64
+		for i := 0; i < 5000; i++ {
+			sum += s.Draw(r)
+		}
+		if got := sum / 5000; math.Abs(got-test.mean) > 0.05*math.Max(test.mean, 1) {
+			t.Errorf("samples from %s have the wrong mean. want=%f got=%f", test.dist, test.mean, got)
+		}
+	}
+}
+
+func TestTruncatedSampler(t *testing.T) {
+	d := ast.NewNormal(10, 2)
+	d.Truncated, d.Low, d.High = true, 10, 11
+
+	s, err := newSampler(d)
+	if err != nil {
+		t.Fatalf("sampler for %s failed. got=%s", d, err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		if v := s.Draw(r); v < 10 || v > 11 {
+			t.Fatalf("sample outside the truncation bounds. got=%f", v)
+		}
+	}
+
+	if s.Prob(9) != 0 {
+		t.Fatalf("value outside the bounds has a weight. got=%f", s.Prob(9))
+	}
+
+	untruncated, _ := newSampler(ast.NewNormal(10, 2))
+	if s.Prob(10.5) <= untruncated.Prob(10.5) {
+		t.Fatalf("truncated density not rescaled. got=%f", s.Prob(10.5))
+	}
+
+	d.Low, d.High = 100, 101
+	if _, err := newSampler(d); err == nil {
+		t.Fatal("truncation with no probability left did not fail")
+	}
+}
+
+func TestBoolWeights(t *testing.T) {
+	mc := NewModelChecker()
+	mc.Uncertains = map[string]*ast.Distribution{
+		"test_flip": {Kind: "bernoulli", Params: []float64{0.9}},
+		"test_x":    ast.NewNormal(10, 2),
+	}
+
+	flip := NewBoolTrace()
+	flip.Add(0, false)
+	x := NewFloatTrace()
+	x.Add(0, 10)
+	x.Add(1, 30)
+
+	results, err := mc.Filter(map[string]Scenario{"test_flip": flip, "test_x": x})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := results["test_flip"].(*BoolTrace).GetWeights()
+	if math.Abs(w[0]-0.1) > 1e-9 {
+		t.Fatalf("false bernoulli value weighted wrong. want=0.1 got=%f", w[0])
+	}
+
+	p, ok := mc.likelihood(results)
+	normal, _ := newSampler(ast.NewNormal(10, 2))
+	if !ok || math.Abs(p-0.1*normal.Prob(10)) > 1e-9 {
+		t.Fatalf("scenario likelihood wrong. want=%f got=%f", 0.1*normal.Prob(10), p)
+	}
+
+	if _, ok := mc.likelihood(map[string]Scenario{}); ok {
+		t.Fatal("scenario with no uncertain values has a likelihood")
+	}
+}
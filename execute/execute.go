@@ -1,8 +1,9 @@
 package execute
 
 import (
-	"bytes"
+	"context"
 	"errors"
+	"fault/ast"
 	"fault/execute/parser"
 	"fault/smt/forks"
 	"fault/smt/log"
@@ -10,71 +11,74 @@ import (
 	"fault/smt/variables"
 	"fault/util"
 	"fmt"
-	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
-	"gonum.org/v1/gonum/stat/distuv"
 )
 
-// Takes SMTLib2 and runs z3. If Uncertain types are present
-// execute will calculate the odds of z3 suggested state actually
+// Takes SMTLib2 and runs a solver (z3 by default). If Uncertain types
+// are present execute will calculate the odds of the suggested state actually
 // occurring and rerun the model.
 
-type Solver struct {
-	Command   string
-	Arguments []string
-}
-
 type ModelChecker struct {
 	SMT          string
-	Uncertains   map[string][]float64
+	Uncertains   map[string]*ast.Distribution
 	Unknowns     []string
 	Results      map[string][]*variables.VarChange
 	ResultValues map[string]string
 	Log          *resultlog.ResultLog
 	solver       map[string]*Solver
+	backend      string
+	session      *Session
+	scopes       [][]string // Assertions pushed when no session is available
+	sat          bool       // Current context has been checked and is sat
+	ctx          context.Context
 	Forks        *forks.Fork
+	Likely       bool // Search for the most probable failure, see likely.go
 }
 
 func NewModelChecker() *ModelChecker {
 
 	mc := &ModelChecker{
 		solver:       GenerateSolver(),
+		backend:      DefaultSolver(),
+		ctx:          context.Background(),
 		ResultValues: make(map[string]string),
 	}
 	return mc
 }
 
-func GenerateSolver() map[string]*Solver {
-	command, _ := os.LookupEnv("SOLVERCMD")
-	if command == "" {
-		panic("No solver is loaded, missing SOLVERCMD")
+// UseSolver selects which registered backend runs the model
+func (mc *ModelChecker) UseSolver(name string) error {
+	if name == "" {
+		name = DefaultSolver()
 	}
-
-	args, _ := os.LookupEnv("SOLVERARG")
-	if args == "" {
-		panic("No solver is loaded, missing SOLVERARG")
+	if _, ok := mc.solver[name]; !ok {
+		return fmt.Errorf("unknown solver %s, options are: %s", name, strings.Join(SolverNames(), ", "))
 	}
+	mc.backend = name
+	return nil
+}
 
-	s := make(map[string]*Solver)
-	s["basic_run"] = &Solver{
-		Command:   command,
-		Arguments: []string{args},
-		/*Command: "z3",
-		Arguments: []string{"-in"}*/
-	}
-	return s
+// SetContext bounds every solver process started after
+// the call, cancelling ctx kills the solver.
+func (mc *ModelChecker) SetContext(ctx context.Context) {
+	mc.ctx = ctx
+}
+
+func (mc *ModelChecker) Solver() *Solver {
+	return mc.solver[mc.backend]
 }
 
-func (mc *ModelChecker) LoadModel(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*variables.VarChange, log *resultlog.ResultLog) {
+func (mc *ModelChecker) LoadModel(smt string, uncertains map[string]*ast.Distribution, unknowns []string, results map[string][]*variables.VarChange, log *resultlog.ResultLog) {
 	mc.SMT = smt
 	mc.Uncertains = uncertains
 	mc.Unknowns = unknowns
 	mc.Results = results
 	mc.Log = log
+	mc.Close()
+	mc.scopes = nil
 }
 
 func (mc *ModelChecker) LoadMeta(frks *forks.Fork) {
@@ -82,26 +86,124 @@ func (mc *ModelChecker) LoadMeta(frks *forks.Fork) {
 	mc.Forks = frks
 }
 
-func (mc *ModelChecker) run(command string, actions []string) (string, error) {
-	cmd := exec.Command(mc.solver[command].Command,
-		mc.solver[command].Arguments...)
-	cmd.Stdin = strings.NewReader(fmt.Sprint(mc.SMT, strings.Join(actions, "\n")))
+// connect detects the backend's features and opens a
+// session if the solver can hold one.
+func (mc *ModelChecker) connect() (*Features, error) {
+	s := mc.Solver()
+	if s == nil {
+		return nil, fmt.Errorf("no solver is loaded for backend %s", mc.backend)
+	}
 
-	var out bytes.Buffer
-	var stderr bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &stderr
+	if !s.Available() {
+		return nil, fmt.Errorf("solver %s not found, is %s installed?", s.Name, s.Command)
+	}
 
-	err := cmd.Run()
+	f, err := s.DetectContext(mc.ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	if f.Echo && mc.session == nil {
+		mc.session, err = s.OpenContext(mc.ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = mc.session.Send(mc.SMT)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (mc *ModelChecker) run(actions []string) (string, error) {
+	f, err := mc.connect()
 	if err != nil {
-		return "", fmt.Errorf(fmt.Sprint(err) + ": " + stderr.String())
+		return "", err
+	}
+
+	if util.InStringSlice(actions, "(get-model)") && !f.GetModel {
+		return "", fmt.Errorf("solver %s does not support (get-model) on stdin", mc.Solver().Name)
+	}
+
+	if mc.session != nil {
+		return mc.session.Query(actions...)
+	}
+
+	// No session, replay everything in a fresh process
+	s := mc.Solver()
+	var scoped []string
+	for _, sc := range mc.scopes {
+		scoped = append(scoped, sc...)
+	}
+	input := fmt.Sprint(strings.Join(s.Preamble, "\n"), "\n", mc.SMT, strings.Join(scoped, "\n"), "\n", strings.Join(actions, "\n"))
+	return s.exec(mc.ctx, input)
+}
+
+// Push opens a new assertion scope on top of the model
+func (mc *ModelChecker) Push() error {
+	mc.sat = false
+	if _, err := mc.connect(); err != nil {
+		return err
+	}
+
+	if mc.session != nil {
+		return mc.session.Push()
+	}
+	mc.scopes = append(mc.scopes, []string{})
+	return nil
+}
+
+// Pop drops every assertion added since the last Push
+func (mc *ModelChecker) Pop() error {
+	mc.sat = false
+	if mc.session != nil {
+		return mc.session.Pop()
+	}
+
+	if len(mc.scopes) == 0 {
+		return fmt.Errorf("solver %s has no context to pop", mc.backend)
 	}
-	return strings.TrimSpace(out.String()), err
+	mc.scopes = mc.scopes[:len(mc.scopes)-1]
+	return nil
+}
+
+// Assert adds a constraint to the current scope
+func (mc *ModelChecker) Assert(rule string) error {
+	return mc.command(fmt.Sprintf("(assert %s)", rule))
+}
+
+// command adds anything that changes the context, an assert
+// or an objective, to the current scope
+func (mc *ModelChecker) command(a string) error {
+	mc.sat = false
+	if _, err := mc.connect(); err != nil {
+		return err
+	}
+
+	if mc.session != nil {
+		return mc.session.Send(a)
+	}
+
+	if len(mc.scopes) == 0 {
+		mc.scopes = append(mc.scopes, []string{})
+	}
+	mc.scopes[len(mc.scopes)-1] = append(mc.scopes[len(mc.scopes)-1], a)
+	return nil
+}
+
+func (mc *ModelChecker) Close() error {
+	mc.sat = false
+	if mc.session == nil {
+		return nil
+	}
+	err := mc.session.Close()
+	mc.session = nil
+	return err
 }
 
 func (mc *ModelChecker) Check() (bool, error) {
-	results, err := mc.run("basic_run", []string{"(check-sat)"})
+	results, err := mc.run([]string{"(check-sat)"})
 	if err != nil {
 		return false, err
 	}
@@ -109,6 +211,7 @@ func (mc *ModelChecker) Check() (bool, error) {
 	if util.FromEnd(results, 5) == "unsat" {
 		return false, nil
 	} else if util.FromEnd(results, 3) == "sat" {
+		mc.sat = true
 		return true, nil
 	} else {
 		return false, errors.New(results)
@@ -116,7 +219,7 @@ func (mc *ModelChecker) Check() (bool, error) {
 }
 
 func (mc *ModelChecker) Solve() (map[string]Scenario, error) {
-	results, err := mc.run("basic_run", []string{"(check-sat)", "(get-model)"})
+	results, err := mc.run(mc.modelActions())
 	if err != nil {
 		return nil, err
 	}
@@ -138,21 +241,32 @@ func (mc *ModelChecker) Solve() (map[string]Scenario, error) {
 }
 
 func (mc *ModelChecker) PlainSolve() (string, error) {
-	return mc.run("basic_run", []string{"(check-sat)", "(get-model)"})
+	return mc.run(mc.modelActions())
 }
 
-func (mc *ModelChecker) Filter(results map[string]Scenario) map[string]Scenario {
+// If the context was already checked in the open session
+// the model is still loaded, no need to solve again. Without
+// a session every call starts a fresh solver that has to
+// check the context before it has a model.
+func (mc *ModelChecker) modelActions() []string {
+	if mc.sat && mc.session != nil {
+		return []string{"(get-model)"}
+	}
+	return []string{"(check-sat)", "(get-model)"}
+}
+
+func (mc *ModelChecker) Filter(results map[string]Scenario) (map[string]Scenario, error) {
 	for k, uncertain := range mc.Uncertains {
 		if results[k] != nil {
-			dist := distuv.Normal{
-				Mu:    uncertain[0],
-				Sigma: uncertain[1],
+			dist, err := newSampler(uncertain)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
 			}
 
 			results[k] = mc.stateAssessment(dist, results[k])
 		}
 	}
-	return results
+	return results, nil
 }
 
 func (mc *ModelChecker) Eval(a *resultlog.Assert) bool {
@@ -524,7 +638,7 @@ func (mc *ModelChecker) LookupClause(clause string) int {
 	return -1
 }
 
-func (mc *ModelChecker) stateAssessment(dist distuv.Normal, states Scenario) Scenario {
+func (mc *ModelChecker) stateAssessment(dist *sampler, states Scenario) Scenario {
 	var weighted Scenario
 	switch s := states.(type) {
 	case *FloatTrace:
@@ -540,12 +654,15 @@ func (mc *ModelChecker) stateAssessment(dist distuv.Normal, states Scenario) Sce
 			weighted.(*IntTrace).AddWeight(i, dist.Prob(float64(state)))
 		}
 	case *BoolTrace:
-		//Requires Gaussian distr, TODO LATER
-		/*weighted = NewBoolTrace()
+		weighted = NewBoolTrace()
 		weighted.(*BoolTrace).results = s.results
 		for i, state := range s.results {
-			weighted.(*BoolTrace).AddWeight(i, dist.Prob(state))
-		}*/
+			x := 0.0
+			if state {
+				x = 1
+			}
+			weighted.(*BoolTrace).AddWeight(i, dist.Prob(x))
+		}
 	}
 	return weighted
 }
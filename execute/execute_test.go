@@ -1,6 +1,7 @@
 package execute
 
 import (
+	"fault/ast"
 	resultlog "fault/smt/log"
 	"fault/smt/variables"
 	"testing"
@@ -13,7 +14,7 @@ func TestSMTOk(t *testing.T) {
 	(assert (= imports_fl3_vault_value_1 (+ imports_fl3_vault_value_0 10.0)))
 	(assert (= imports_fl3_vault_value_2 (+ imports_fl3_vault_value_1 10.0)))
 	`
-	model := prepTest(test, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model := prepTest(test, make(map[string]*ast.Distribution), []string{}, map[string][]*variables.VarChange{})
 
 	response, err := model.Check()
 
@@ -49,14 +50,17 @@ func TestProbability(t *testing.T) {
 	(assert (= imports_fl3_vault_value_1 (+ imports_fl3_vault_value_0 10.0)))
 	(assert (= imports_fl3_vault_value_2 (+ imports_fl3_vault_value_1 10.0)))
 	`
-	uncertains := make(map[string][]float64)
-	uncertains["imports_fl3_vault_value"] = []float64{30.0, 5}
+	uncertains := make(map[string]*ast.Distribution)
+	uncertains["imports_fl3_vault_value"] = ast.NewNormal(30.0, 5)
 
 	model := prepTest(test, uncertains, []string{}, map[string][]*variables.VarChange{})
 
 	model.Check()
 	solution, _ := model.Solve()
-	filter := model.Filter(solution)
+	filter, err := model.Filter(solution)
+	if err != nil {
+		t.Fatal(err)
+	}
 	got := filter["imports_fl3_vault_value"].(*FloatTrace).GetWeights()
 	expected := map[int64]float64{0: 0.07978845608028654, 1: 0.010798193302637605, 2: 2.6766045152977058e-05}
 	if got[0] != expected[0] {
@@ -72,7 +76,7 @@ func TestEventLog(t *testing.T) {
 	(assert (= imports_fl3_vault_value_1 (+ imports_fl3_vault_value_0 10.0)))
 	(assert (= imports_fl3_vault_value_2 (+ imports_fl3_vault_value_1 10.0)))
 	`
-	model := prepTest(test, make(map[string][]float64), []string{}, map[string][]*variables.VarChange{})
+	model := prepTest(test, make(map[string]*ast.Distribution), []string{}, map[string][]*variables.VarChange{})
 
 	model.Log = resultlog.NewLog()
 	model.Log.Add(resultlog.NewInit(0, "", "imports_fl3_vault_value_0"))
@@ -209,7 +213,7 @@ func TestEvalClause(t *testing.T) {
 	}
 }
 
-func prepTest(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*variables.VarChange) *ModelChecker {
+func prepTest(smt string, uncertains map[string]*ast.Distribution, unknowns []string, results map[string][]*variables.VarChange) *ModelChecker {
 	ex := NewModelChecker()
 	ex.LoadModel(smt, uncertains, unknowns, results, &resultlog.ResultLog{})
 	return ex
@@ -0,0 +1,120 @@
+package execute
+
+import (
+	"errors"
+	resultlog "fault/smt/log"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Explaining a model with no failures. A model that can't fail
+// is usually a model that's wrong, so on unsat the solver is
+// asked for the named terms (see smt.Generator.NamedTerms) it
+// needed to rule the failures out. The core doesn't have to be
+// minimal, so an assert missing from it says nothing. Only if
+// the model can't be satisfied without the asserts does every
+// assert pass vacuously.
+
+type Explanation struct {
+	Vacuous bool              `json:"vacuous"` // The model is unsat without the asserts
+	Causes  []*resultlog.Term `json:"causes"`  // What rules the failures out
+	Core    []string          `json:"core"`    // As returned by the solver
+}
+
+var coreNames = regexp.MustCompile(`[^\s()]+`)
+
+// UnsatCore is the names of the terms the solver used to
+// prove the model unsat, nil if the model is sat
+func (mc *ModelChecker) UnsatCore() ([]string, error) {
+	// Both in one go, solvers without a session start over on
+	// every call
+	results, err := mc.run([]string{"(check-sat)", "(get-unsat-core)"})
+	if err != nil {
+		return nil, err
+	}
+
+	results = strings.TrimSpace(results)
+	if strings.HasPrefix(results, "sat") {
+		return nil, nil
+	}
+	if !strings.HasPrefix(results, "unsat") {
+		return nil, errors.New(results)
+	}
+
+	core := strings.TrimSpace(strings.TrimPrefix(results, "unsat"))
+	if !strings.HasPrefix(core, "(") || strings.HasPrefix(core, "(error") {
+		return nil, fmt.Errorf("solver %s did not return an unsat core: %s", mc.Solver().Name, core)
+	}
+	return coreNames.FindAllString(core, -1), nil
+}
+
+// Explain says why the model has no failures, nil if it has
+// some. model is the SMT without the asserts (see
+// smt.Generator.Model).
+func (mc *ModelChecker) Explain(model string) (*Explanation, error) {
+	core, err := mc.UnsatCore()
+	if err != nil || core == nil {
+		return nil, err
+	}
+
+	sat, err := mc.satisfiable(model)
+	if err != nil {
+		return nil, err
+	}
+
+	ex := &Explanation{Vacuous: !sat, Core: core, Causes: []*resultlog.Term{}}
+	for _, name := range core {
+		var t *resultlog.Term
+		if mc.Log != nil {
+			t = mc.Log.Terms[name]
+		}
+		if t == nil {
+			t = &resultlog.Term{Kind: "rule", Desc: name}
+		}
+
+		if t.Kind == "assert" {
+			continue
+		}
+		ex.Causes = append(ex.Causes, t)
+	}
+	return ex, nil
+}
+
+// satisfiable checks smt on its own in a fresh solver, the
+// session (if there is one) holds the model with the asserts
+func (mc *ModelChecker) satisfiable(smt string) (bool, error) {
+	s := mc.Solver()
+	results, err := s.exec(mc.ctx, fmt.Sprint(strings.Join(s.Preamble, "\n"), "\n", smt, "\n(check-sat)\n"))
+	if err != nil {
+		return false, err
+	}
+
+	results = strings.TrimSpace(results)
+	switch {
+	case strings.HasPrefix(results, "unsat"):
+		return false, nil
+	case strings.HasPrefix(results, "sat"):
+		return true, nil
+	default:
+		return false, errors.New(results)
+	}
+}
+
+func (ex *Explanation) String() string {
+	var out strings.Builder
+	if ex.Vacuous {
+		out.WriteString("Warning: the model contradicts itself, every assert holds vacuously. The contradiction is between:\n")
+	} else {
+		out.WriteString("Failures are ruled out by:\n")
+	}
+
+	for _, kind := range []string{"assume", "init", "rule"} {
+		for _, t := range ex.Causes {
+			if t.Kind == kind {
+				fmt.Fprintf(&out, "  %s: %s\n", kind, t)
+			}
+		}
+	}
+	return out.String()
+}
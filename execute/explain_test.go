@@ -0,0 +1,85 @@
+package execute
+
+import (
+	resultlog "fault/smt/log"
+	"strings"
+	"testing"
+)
+
+func prepExplain(t *testing.T, core string) *ModelChecker {
+	path := stubSolver(t, `while IFS= read -r line; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*produce-unsat-cores*|*"(assert false)"*) unsat=1 ;;
+*check-sat*) if [ -n "$probe" ] || [ -z "$unsat" ]; then echo sat; else echo unsat; fi ;;
+*get-model*) echo "(model (define-fun fault_probe () Real 2.0))" ;;
+*get-unsat-core*) echo "`+core+`" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = NewSolver("stub", path, nil)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Terms["__init_0"] = &resultlog.Term{Kind: "init", Desc: "initial value of test_s_a"}
+	l.Terms["__assert_1"] = &resultlog.Term{Kind: "assert", Line: 13, Col: 1, Desc: "assert (test_s_a) >= 0;"}
+	l.Terms["__assume_2"] = &resultlog.Term{Kind: "assume", Line: 12, Col: 1, Desc: "assume (test_s_b) > 3;"}
+	mc.LoadModel("(set-option :produce-unsat-cores true)(declare-fun test_s_a_0 () Real)", nil, nil, nil, l)
+	return mc
+}
+
+func TestExplain(t *testing.T) {
+	mc := prepExplain(t, "(__init_0 __assert_1)")
+	defer mc.Close()
+
+	ex, err := mc.Explain("(declare-fun test_s_a_0 () Real)")
+	if err != nil {
+		t.Fatalf("explain failed. got=%s", err)
+	}
+
+	if ex.Vacuous || len(ex.Causes) != 1 || ex.Causes[0].Kind != "init" {
+		t.Fatalf("explanation not correct. got=%+v", ex)
+	}
+
+	if !strings.Contains(ex.String(), "init: initial value of test_s_a") {
+		t.Fatalf("explanation not printed correctly. got=%s", ex)
+	}
+}
+
+func TestExplainCoreWithoutAsserts(t *testing.T) {
+	// Cores aren't minimal, leaving the asserts out of one
+	// doesn't make the model contradictory
+	mc := prepExplain(t, "(__assume_2 __init_0)")
+	defer mc.Close()
+
+	ex, err := mc.Explain("(declare-fun test_s_a_0 () Real)")
+	if err != nil {
+		t.Fatalf("explain failed. got=%s", err)
+	}
+
+	if ex.Vacuous || len(ex.Causes) != 2 {
+		t.Fatalf("satisfiable model reported as contradictory. got=%+v", ex)
+	}
+}
+
+func TestExplainVacuous(t *testing.T) {
+	mc := prepExplain(t, "(__assume_2 __init_0)")
+	defer mc.Close()
+
+	ex, err := mc.Explain("(declare-fun test_s_a_0 () Real)\n(assert false)")
+	if err != nil {
+		t.Fatalf("explain failed. got=%s", err)
+	}
+
+	if !ex.Vacuous || len(ex.Causes) != 2 {
+		t.Fatalf("contradictory assumptions not caught. got=%+v", ex)
+	}
+
+	out := ex.String()
+	if !strings.HasPrefix(out, "Warning") || strings.Index(out, "assume (test_s_b) > 3; (line 12, col 1)") > strings.Index(out, "init:") {
+		t.Fatalf("explanation not printed correctly. got=%s", out)
+	}
+}
@@ -64,19 +64,7 @@ func (mc *ModelChecker) Format(results map[string]Scenario) {
 
 func (mc *ModelChecker) Static(results map[string]Scenario) {
 	var out bytes.Buffer
-	for k, v := range results {
-		mc.mapToLog(k, v)
-	}
-
-	deadVars := mc.DeadVariables()
-	mc.Log.FilterOut(deadVars)
-	var violations []string
-	var pass = true
-
-	if len(mc.Log.ProcessedAsserts) > 0 {
-		mc.CheckAsserts()
-		violations, pass = mc.FetchViolations()
-	}
+	violations, pass := mc.evaluate(results)
 
 	if !pass || len(mc.Log.ProcessedAsserts) == 0 {
 		out.WriteString("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~\n")
@@ -94,19 +82,7 @@ func (mc *ModelChecker) Static(results map[string]Scenario) {
 
 func (mc *ModelChecker) EventLog(results map[string]Scenario) {
 	var out bytes.Buffer
-	for k, v := range results {
-		mc.mapToLog(k, v)
-	}
-
-	deadVars := mc.DeadVariables()
-	mc.Log.FilterOut(deadVars)
-	var violations []string
-	var pass = true
-
-	if len(mc.Log.ProcessedAsserts) > 0 {
-		mc.CheckAsserts()
-		violations, pass = mc.FetchViolations()
-	}
+	violations, pass := mc.evaluate(results)
 
 	if !pass || len(mc.Log.ProcessedAsserts) == 0 {
 		out.WriteString("~~~~~~~~~~\n  Fault found the following scenario\n~~~~~~~~~~\n")
@@ -122,6 +98,29 @@ func (mc *ModelChecker) EventLog(results map[string]Scenario) {
 	fmt.Println(out.String())
 }
 
+// evaluate loads the results into the event log, removes
+// dead branches and checks the asserts against what's left
+func (mc *ModelChecker) evaluate(results map[string]Scenario) ([]string, bool) {
+	for k, v := range results {
+		mc.mapToLog(k, v)
+	}
+	if p, ok := mc.likelihood(results); ok {
+		mc.Log.UpdateLikelihood(p)
+	}
+	mc.Log.ResolveSchedules(mc.ResultValues)
+
+	deadVars := mc.deadVariables()
+	mc.Log.FilterOut(deadVars)
+	var violations []string
+	var pass = true
+
+	if len(mc.Log.ProcessedAsserts) > 0 {
+		mc.CheckAsserts()
+		violations, pass = mc.FetchViolations()
+	}
+	return violations, pass
+}
+
 func (mc *ModelChecker) mapToLog(k string, vals Scenario) {
 	switch v := vals.(type) {
 	case *BoolTrace:
@@ -130,6 +129,9 @@ func (mc *ModelChecker) mapToLog(k string, vals Scenario) {
 			j := mc.Log.Index(name)
 			if j >= 0 {
 				mc.Log.UpdateCurrent(j, fmt.Sprintf("%v", s))
+				if w, ok := v.weights[idx]; ok {
+					mc.Log.UpdateProbability(j, w)
+				}
 			}
 		}
 	case *FloatTrace:
@@ -138,6 +140,9 @@ func (mc *ModelChecker) mapToLog(k string, vals Scenario) {
 			j := mc.Log.Index(name)
 			if j >= 0 {
 				mc.Log.UpdateCurrent(j, fmt.Sprintf("%v", s))
+				if w, ok := v.weights[idx]; ok {
+					mc.Log.UpdateProbability(j, w)
+				}
 			}
 		}
 	case *IntTrace:
@@ -146,12 +151,53 @@ func (mc *ModelChecker) mapToLog(k string, vals Scenario) {
 			j := mc.Log.Index(name)
 			if j >= 0 {
 				mc.Log.UpdateCurrent(j, fmt.Sprintf("%v", s))
+				if w, ok := v.weights[idx]; ok {
+					mc.Log.UpdateProbability(j, w)
+				}
 			}
 		}
 	}
 
 }
 
+// likelihood multiplies together the weights of the values
+// picked for each uncertain value, false if none were weighted
+func (mc *ModelChecker) likelihood(results map[string]Scenario) (float64, bool) {
+	p, weighted := 1.0, false
+	for k := range mc.Uncertains {
+		if w, ok := initialWeight(results[k]); ok {
+			p *= w
+			weighted = true
+		}
+	}
+	return p, weighted
+}
+
+// initialWeight is the weight of the first value in the trace,
+// the one drawn from the distribution
+func initialWeight(s Scenario) (float64, bool) {
+	var weights map[int16]float64
+	switch v := s.(type) {
+	case *FloatTrace:
+		weights = v.weights
+	case *IntTrace:
+		weights = v.weights
+	case *BoolTrace:
+		weights = v.weights
+	}
+
+	first := int16(-1)
+	for i := range weights {
+		if first < 0 || i < first {
+			first = i
+		}
+	}
+	if first < 0 {
+		return 0, false
+	}
+	return weights[first], true
+}
+
 func generateRows(v Scenario) []string {
 	switch s := v.(type) {
 	case *FloatTrace:
@@ -267,6 +313,12 @@ func (mc *ModelChecker) CheckAsserts() {
 	for _, c := range mc.Log.ChainOrder {
 		mc.CheckChain(mc.Log.AssertChains[c])
 	}
+
+	for i, f := range mc.Log.Temporal {
+		if i < len(mc.Log.ProcessedAsserts) {
+			mc.Log.ProcessedAsserts[i].Violated = !mc.EvalTemporal(f, 0, f.Rounds()-1)
+		}
+	}
 }
 
 func (mc *ModelChecker) dontBackTrack(clauses map[string]bool, subclause string) bool {
@@ -290,8 +342,14 @@ func (mc *ModelChecker) FetchViolations() ([]string, bool) {
 	var checked []string
 	var pass = true
 	var foundViolation bool
-	for _, a := range mc.Log.ProcessedAsserts {
-		checked = append(checked, a.EvLogString(false))
+	for i, a := range mc.Log.ProcessedAsserts {
+		line := a.EvLogString(false)
+		if f, ok := mc.Log.Temporal[i]; ok && a.Violated {
+			if w := mc.ViolatedWindow(f, 0, f.Rounds()-1); w != nil {
+				line = fmt.Sprintf("%s %s", line, w)
+			}
+		}
+		checked = append(checked, line)
 		if a.Violated && !foundViolation {
 			pass = false
 			foundViolation = true
@@ -297,3 +297,24 @@ func TestChains(t *testing.T) {
 		t.Fatalf("Assert Check %s failed", mc.Log.ProcessedAsserts[1].String())
 	}
 }
+
+func TestSchedules(t *testing.T) {
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewSchedule(1, "@__run", "__schedule_0"))
+	l.Schedules["__schedule_0"] = &resultlog.Schedule{
+		Steps: []string{"test_a_fn", "test_b_fn", "test_a_fn"},
+		Vars:  []string{"__schedule_0_0", "__schedule_0_1", "__schedule_0_2"},
+	}
+
+	mc := NewModelChecker()
+	mc.LoadMeta(forks.InitFork())
+	mc.Log = l
+	mc.ResultValues["__schedule_0_0"] = "2"
+	mc.ResultValues["__schedule_0_1"] = "0"
+	mc.ResultValues["__schedule_0_2"] = "1"
+	mc.evaluate(map[string]Scenario{})
+
+	if l.Events[0].Current != "test_b_fn test_a_fn test_a_fn" {
+		t.Fatalf("schedule not resolved. got=%s", l.Events[0].Current)
+	}
+}
@@ -0,0 +1,139 @@
+package execute
+
+import (
+	"encoding/json"
+	resultlog "fault/smt/log"
+	"fault/util"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Machine readable results. Fields are only ever added to the
+// schema, anything that changes the meaning of an existing
+// field bumps SchemaVersion.
+
+const SchemaVersion = "1.0"
+
+type Report struct {
+	Version   string            `json:"version"`
+	Spec      Metadata          `json:"spec"`
+	Solver    SolverReport      `json:"solver"`
+	Verdict   string            `json:"verdict"` // "fail" if any scenario breaks the model, otherwise "pass"
+	Scenarios []*ScenarioReport `json:"scenarios"`
+}
+
+type Metadata struct {
+	File  string `json:"file"`
+	Type  string `json:"type"`  // fspec or fsystem
+	Input string `json:"input"` // fspec, ll or smt2
+}
+
+type SolverReport struct {
+	Name   string `json:"name"`
+	Status string `json:"status"` // sat or unsat
+}
+
+type ScenarioReport struct {
+	Failure    bool               `json:"failure"`
+	Likelihood *float64           `json:"likelihood,omitempty"` // Joint probability of the uncertain values picked
+	Violations []*ViolationReport `json:"violations"`
+	Events     []*EventReport     `json:"events"`
+}
+
+type ViolationReport struct {
+	Assert   string `json:"assert"`
+	Violated bool   `json:"violated"`
+}
+
+type EventReport struct {
+	Round       int      `json:"round"`
+	Type        string   `json:"type"`
+	Scope       string   `json:"scope"`
+	Variable    string   `json:"variable"`
+	Rule        string   `json:"rule,omitempty"`
+	Previous    string   `json:"previous"`
+	Current     string   `json:"current"`
+	Probability *float64 `json:"probability,omitempty"`
+	Dead        bool     `json:"dead"`
+}
+
+// Report builds the JSON report for the failures found. No
+// failures means the solver returned unsat.
+func (mc *ModelChecker) Report(meta Metadata, failures []*Failure) *Report {
+	r := &Report{
+		Version:   SchemaVersion,
+		Spec:      meta,
+		Solver:    SolverReport{Name: mc.backend, Status: "unsat"},
+		Verdict:   "pass",
+		Scenarios: []*ScenarioReport{},
+	}
+
+	if len(failures) > 0 {
+		r.Solver.Status = "sat"
+	}
+
+	for _, f := range failures {
+		mc.LoadFailure(f)
+		s := mc.scenarioReport(f.Results)
+		if s.Failure {
+			r.Verdict = "fail"
+		}
+		r.Scenarios = append(r.Scenarios, s)
+	}
+	return r
+}
+
+func (mc *ModelChecker) JSON(meta Metadata, failures []*Failure) error {
+	out, err := json.MarshalIndent(mc.Report(meta, failures), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to generate JSON report: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func (mc *ModelChecker) scenarioReport(results map[string]Scenario) *ScenarioReport {
+	_, pass := mc.evaluate(results)
+	s := &ScenarioReport{
+		Failure:    !pass || len(mc.Log.ProcessedAsserts) == 0,
+		Violations: []*ViolationReport{},
+		Events:     []*EventReport{},
+	}
+
+	for _, a := range mc.Log.ProcessedAsserts {
+		text := strings.TrimPrefix(strings.TrimPrefix(a.EvLogString(false), "FAILED  "), "OK  ")
+		s.Violations = append(s.Violations, &ViolationReport{Assert: text, Violated: a.Violated})
+	}
+
+	if p, err := strconv.ParseFloat(mc.Log.Likelihood, 64); err == nil {
+		s.Likelihood = &p
+	}
+
+	for _, e := range mc.Log.Events {
+		s.Events = append(s.Events, eventReport(mc.Log, e))
+	}
+	return s
+}
+
+func eventReport(l *resultlog.ResultLog, e *resultlog.Event) *EventReport {
+	ev := &EventReport{
+		Round:    e.Round,
+		Type:     e.Type,
+		Scope:    e.Scope,
+		Variable: e.Variable,
+		Previous: e.Previous,
+		Current:  e.Current,
+		Dead:     e.Dead,
+	}
+
+	if l.IsStringRule[e.Variable] {
+		base, _ := util.GetVarBase(e.Variable)
+		ev.Rule = l.StringRules[base]
+	}
+
+	if p, err := strconv.ParseFloat(e.Probability, 64); err == nil {
+		ev.Probability = &p
+	}
+	return ev
+}
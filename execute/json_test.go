@@ -0,0 +1,119 @@
+package execute
+
+import (
+	"encoding/json"
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"strings"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "test_value_0"))
+	l.Add(resultlog.NewChange(1, "test_func", "test_value_1"))
+	l.UpdateProbability(1, 0.25)
+
+	trace := NewFloatTrace()
+	trace.Add(0, 2.0)
+	trace.Add(1, 3.5)
+
+	mc := NewModelChecker()
+	mc.LoadMeta(forks.InitFork())
+	f := &Failure{
+		Results: map[string]Scenario{"test_value": trace},
+		Values:  map[string]string{"test_value_0": "2.0", "test_value_1": "3.5"},
+		Log:     l,
+	}
+
+	meta := Metadata{File: "test.fspec", Type: "fspec", Input: "fspec"}
+	r := mc.Report(meta, []*Failure{f})
+
+	if r.Version != SchemaVersion || r.Verdict != "fail" || r.Solver.Status != "sat" {
+		t.Fatalf("report header not correct. got=%+v", r)
+	}
+
+	if len(r.Scenarios) != 1 || len(r.Scenarios[0].Events) != 2 {
+		t.Fatalf("report scenarios not correct. got=%+v", r.Scenarios)
+	}
+
+	ev := r.Scenarios[0].Events[1]
+	if ev.Round != 1 || ev.Type != "CHANGE" || ev.Scope != "test_func" || ev.Current != "3.5" {
+		t.Fatalf("report event not correct. got=%+v", ev)
+	}
+
+	if ev.Probability == nil || *ev.Probability != 0.25 {
+		t.Fatalf("report event probability not correct. got=%v", ev.Probability)
+	}
+
+	if r.Scenarios[0].Events[0].Probability != nil {
+		t.Fatal("report event probability set without a value")
+	}
+
+	if r.Scenarios[0].Likelihood != nil {
+		t.Fatal("scenario likelihood set without uncertain values")
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("report failed to marshal. got=%s", err)
+	}
+
+	var raw map[string]interface{}
+	json.Unmarshal(out, &raw)
+	if raw["version"] != SchemaVersion || raw["spec"].(map[string]interface{})["file"] != "test.fspec" {
+		t.Fatalf("report JSON not correct. got=%s", out)
+	}
+}
+
+func TestReportUnsat(t *testing.T) {
+	mc := NewModelChecker()
+	r := mc.Report(Metadata{File: "test.fspec"}, nil)
+
+	if r.Verdict != "pass" || r.Solver.Status != "unsat" {
+		t.Fatalf("unsat report not correct. got=%+v", r)
+	}
+
+	out, _ := json.Marshal(r)
+	var raw map[string]interface{}
+	json.Unmarshal(out, &raw)
+	if s, ok := raw["scenarios"].([]interface{}); !ok || len(s) != 0 {
+		t.Fatalf("unsat report scenarios should be an empty list. got=%s", out)
+	}
+}
+
+func TestReportLikelihood(t *testing.T) {
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "test_flip_0"))
+
+	trace := NewBoolTrace()
+	trace.Add(0, true)
+
+	mc := NewModelChecker()
+	mc.LoadMeta(forks.InitFork())
+	mc.Uncertains = map[string]*ast.Distribution{"test_flip": {Kind: "bernoulli", Params: []float64{0.8}}}
+	results, err := mc.Filter(map[string]Scenario{"test_flip": trace})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &Failure{
+		Results: results,
+		Values:  map[string]string{"test_flip_0": "true"},
+		Log:     l,
+	}
+
+	r := mc.Report(Metadata{File: "test.fspec", Type: "fspec", Input: "fspec"}, []*Failure{f})
+	s := r.Scenarios[0]
+	if s.Likelihood == nil || *s.Likelihood != 0.8 {
+		t.Fatalf("scenario likelihood not correct. got=%v", s.Likelihood)
+	}
+
+	if ev := s.Events[0]; ev.Probability == nil || *ev.Probability != 0.8 {
+		t.Fatalf("bernoulli event probability not correct. got=%v", ev.Probability)
+	}
+
+	if !strings.HasPrefix(mc.Log.String(), "Scenario likelihood: 0.8\n") {
+		t.Fatalf("event log missing the scenario likelihood. got=%s", mc.Log.String())
+	}
+}
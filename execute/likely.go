@@ -0,0 +1,325 @@
+package execute
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Most likely failure. The solver returns whichever model it
+// finds first, which can sit far out in the tails of the
+// uncertain values. Each uncertain value is charged for how
+// unlikely the value picked is: the negative log of its density
+// relative to the most likely value, or the negative log
+// probability for a boolean. Backends that optimize minimize the
+// total, the rest get a bound on it tightened with repeated
+// checks.
+//
+// The log density isn't linear so the solver gets an
+// approximation: straight lines between points spread over the
+// quantiles and the mode. It's exact at those points, close in
+// between and past the outer points it keeps rising at least one
+// unit per standard deviation. Skewed distributions keep their
+// shape, so an exponential is cheapest near zero rather than
+// around its mean.
+
+// Checks spent tightening before settling on the best model
+const tighteningSteps = 20
+
+// Tightening stops once the bound is this close to the best cost
+const tighteningTolerance = 1e-3
+
+// Charge for a value with no chance of happening
+const impossibleCost = 1000.0
+
+// Quantiles the log density is approximated between
+var costQuantiles = []float64{0.001, 0.01, 0.05, 0.15, 0.3, 0.5, 0.7, 0.85, 0.95, 0.99, 0.999}
+
+type cost struct {
+	base string
+	sort string
+	s    *sampler
+	p    *piecewise // nil for booleans
+}
+
+// costs returns a cost for every uncertain value declared in
+// the model, sorted so the objective is the same every run
+func (mc *ModelChecker) costs() ([]*cost, error) {
+	sorts := make(map[string]string)
+	for _, m := range declaration.FindAllStringSubmatch(mc.SMT, -1) {
+		sorts[m[1]] = m[2]
+	}
+
+	var costs []*cost
+	for k, u := range mc.Uncertains {
+		ty, ok := sorts[initial(k)]
+		if !ok {
+			continue
+		}
+		s, err := newSampler(u)
+		if err != nil {
+			return nil, fmt.Errorf("uncertain value %s: %s", k, err)
+		}
+		c := &cost{base: k, sort: ty, s: s}
+		if ty != "Bool" {
+			c.p = logDensity(s)
+		}
+		costs = append(costs, c)
+	}
+	sort.Slice(costs, func(i, j int) bool { return costs[i].base < costs[j].base })
+	return costs, nil
+}
+
+// term is the cost as an SMT expression
+func (c *cost) term() string {
+	x := initial(c.base)
+	if c.sort == "Bool" {
+		return fmt.Sprintf("(ite %s %s %s)", x, literal(c.boolean(1), "Real"), literal(c.boolean(0), "Real"))
+	}
+
+	if c.sort == "Int" {
+		x = fmt.Sprintf("(to_real %s)", x)
+	}
+	return c.p.term(x)
+}
+
+// of is the cost of the value picked in a model, the same
+// approximation the solver is given
+func (c *cost) of(s Scenario) float64 {
+	switch v := s.(type) {
+	case *BoolTrace:
+		if b, ok := v.Index(0); ok && b {
+			return c.boolean(1)
+		}
+		return c.boolean(0)
+	case *IntTrace:
+		x, _ := v.Index(0)
+		return c.p.at(float64(x))
+	case *FloatTrace:
+		x, _ := v.Index(0)
+		return c.p.at(x)
+	}
+	return 0
+}
+
+func (c *cost) boolean(x float64) float64 {
+	p := c.s.Prob(x)
+	if p <= 0 {
+		return impossibleCost
+	}
+	return math.Min(math.Abs(math.Log(p)), impossibleCost) // p is at most 1, Abs avoids -0
+}
+
+func spread(s *sampler) float64 {
+	if sd := s.dist.StdDev(); sd > 0 {
+		return sd
+	}
+	return 1
+}
+
+// piecewise is a line through each pair of neighboring points,
+// with the outer lines carried on past the ends
+type piecewise struct {
+	xs     []float64
+	cs     []float64
+	slopes []float64 // Below xs[0], between each pair, above the last
+}
+
+// logDensity approximates -log(p(x)/p(mode)) for a distribution
+func logDensity(s *sampler) *piecewise {
+	var xs []float64
+	lo := s.below(s.low)
+	for _, q := range costQuantiles {
+		xs = append(xs, s.quantile(lo+q*s.mass))
+	}
+	if m, ok := s.dist.(interface{ Mode() float64 }); ok {
+		if mode := m.Mode(); mode >= s.low && mode <= s.high {
+			xs = append(xs, mode)
+		}
+	}
+	sort.Float64s(xs)
+
+	p := &piecewise{}
+	peak := 0.0
+	var dens []float64
+	for _, x := range xs {
+		d := s.dist.Prob(x)
+		if math.IsNaN(x) || math.IsInf(x, 0) || math.IsInf(d, 0) || (len(p.xs) > 0 && x == p.xs[len(p.xs)-1]) {
+			continue
+		}
+		p.xs = append(p.xs, x)
+		dens = append(dens, d)
+		peak = math.Max(peak, d)
+	}
+	if len(p.xs) == 0 { // Nothing to go on, charge the distance from the mean
+		p.xs, dens, peak = []float64{s.dist.Mean()}, []float64{1}, 1
+	}
+
+	for _, d := range dens {
+		if d <= 0 || peak <= 0 {
+			p.cs = append(p.cs, impossibleCost)
+			continue
+		}
+		p.cs = append(p.cs, math.Min(math.Log(peak/d), impossibleCost))
+	}
+
+	n := len(p.xs)
+	p.slopes = make([]float64, n+1)
+	for i := 1; i < n; i++ {
+		p.slopes[i] = (p.cs[i] - p.cs[i-1]) / (p.xs[i] - p.xs[i-1])
+	}
+	rise := 1 / spread(s)
+	p.slopes[0], p.slopes[n] = rise, rise
+	if n > 1 {
+		p.slopes[0] = math.Min(p.slopes[1], -rise)
+		p.slopes[n] = math.Max(p.slopes[n-1], rise)
+	} else {
+		p.slopes[0] = -rise
+	}
+	return p
+}
+
+// segment is the piece x falls on, 0 below the first point
+func (p *piecewise) segment(x float64) int {
+	i := 0
+	for i < len(p.xs) && x > p.xs[i] {
+		i++
+	}
+	return i
+}
+
+func (p *piecewise) at(x float64) float64 {
+	i := p.segment(x)
+	if i == 0 {
+		return p.cs[0] + p.slopes[0]*(x-p.xs[0])
+	}
+	return p.cs[i-1] + p.slopes[i]*(x-p.xs[i-1])
+}
+
+// term writes the pieces as nested ite, checking the points in
+// order so each condition only has to bound x from above
+func (p *piecewise) term(x string) string {
+	n := len(p.xs)
+	t := p.line(x, n-1, n)
+	for i := n - 1; i >= 1; i-- {
+		t = fmt.Sprintf("(ite (<= %s %s) %s %s)", x, literal(p.xs[i], "Real"), p.line(x, i-1, i), t)
+	}
+	return fmt.Sprintf("(ite (<= %s %s) %s %s)", x, literal(p.xs[0], "Real"), p.line(x, 0, 0), t)
+}
+
+// line is the piece through point i with the slope of segment j
+func (p *piecewise) line(x string, i int, j int) string {
+	return fmt.Sprintf("(+ %s (* %s (- %s %s)))", literal(p.cs[i], "Real"), literal(p.slopes[j], "Real"), x, literal(p.xs[i], "Real"))
+}
+
+func objective(costs []*cost) string {
+	var terms []string
+	for _, c := range costs {
+		terms = append(terms, c.term())
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return fmt.Sprintf("(+ %s)", strings.Join(terms, " "))
+}
+
+func total(costs []*cost, results map[string]Scenario) float64 {
+	var t float64
+	for _, c := range costs {
+		t += c.of(results[c.base])
+	}
+	return t
+}
+
+// NextFailure checks the current context and returns a failing
+// model if there is one, the most likely one if mc.Likely is set
+func (mc *ModelChecker) NextFailure() (map[string]Scenario, bool, error) {
+	if !mc.Likely {
+		return mc.solution()
+	}
+
+	costs, err := mc.costs()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(costs) == 0 { // Nothing uncertain, every failure is as likely
+		return mc.solution()
+	}
+
+	if _, err := mc.connect(); err != nil {
+		return nil, false, err
+	}
+	if mc.Solver().DetectOptimize(mc.ctx) {
+		return mc.minimize(costs)
+	}
+	return mc.tighten(costs)
+}
+
+// solution checks the context and fetches the model if it's sat
+func (mc *ModelChecker) solution() (map[string]Scenario, bool, error) {
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	results, err := mc.Solve()
+	if err != nil {
+		return nil, false, err
+	}
+	return results, true, nil
+}
+
+func (mc *ModelChecker) minimize(costs []*cost) (map[string]Scenario, bool, error) {
+	if err := mc.Push(); err != nil {
+		return nil, false, err
+	}
+	defer mc.Pop()
+
+	if err := mc.command(fmt.Sprintf("(minimize %s)", objective(costs))); err != nil {
+		return nil, false, err
+	}
+	return mc.solution()
+}
+
+// tighten bisects between the cost of the best model so far
+// and zero, asking for a failure under the midpoint each time
+func (mc *ModelChecker) tighten(costs []*cost) (map[string]Scenario, bool, error) {
+	results, ok, err := mc.solution()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	obj := objective(costs)
+	best, values := total(costs, results), mc.ResultValues
+	low := 0.0
+	for i := 0; i < tighteningSteps && best-low > tighteningTolerance; i++ {
+		bound := (low + best) / 2
+		r, ok, err := mc.within(obj, bound)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if ok {
+			results, values = r, mc.ResultValues
+			best = math.Min(total(costs, r), bound)
+		} else {
+			low = bound
+		}
+	}
+
+	mc.ResultValues = values
+	return results, true, nil
+}
+
+func (mc *ModelChecker) within(obj string, bound float64) (map[string]Scenario, bool, error) {
+	if err := mc.Push(); err != nil {
+		return nil, false, err
+	}
+	defer mc.Pop()
+
+	if err := mc.Assert(fmt.Sprintf("(<= %s %s)", obj, literal(bound, "Real"))); err != nil {
+		return nil, false, err
+	}
+	return mc.solution()
+}
@@ -0,0 +1,175 @@
+package execute
+
+import (
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"math"
+	"strings"
+	"testing"
+)
+
+// Fails whenever a.value is over 12. With an objective it picks
+// the failure closest to the mean, with a bound on the cost it
+// picks a value right at the bound (the cost of a normal is
+// half its squared distance from the mean in standard
+// deviations), otherwise one far out in the tail.
+func likelyStub(t *testing.T, optimize bool) *Solver {
+	minimize := `echo "(error \"unsupported command\")"`
+	if optimize {
+		minimize = "objective=1"
+	}
+
+	path := stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(minimize"*) `+minimize+` ;;
+*"(pop 1)"*) objective=; bound= ;;
+*"(assert (<="*) bound=$(echo "$line" | sed 's/.* \([0-9.]*\)))$/\1/') ;;
+*check-sat*)
+	if [ -n "$bound" ] && echo "$bound" | awk '{ exit !($1 < 0.5) }'; then echo unsat; else echo sat; fi ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; continue; fi
+	if [ -n "$objective" ]; then value=12.0
+	elif [ -n "$bound" ]; then value=$(echo "$bound" | awk '{ print 10 + 2 * sqrt(2 * $1) }')
+	else value=30.0; fi
+	echo "(model (define-fun a_value_0 () Real $value))" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepLikely(t *testing.T, optimize bool) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = likelyStub(t, optimize)
+	mc.UseSolver("stub")
+	mc.Likely = true
+
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{"a_value": ast.NewNormal(10, 2)}, nil, nil, l)
+	mc.LoadMeta(forks.InitFork())
+	return mc
+}
+
+func TestMostLikelyOptimize(t *testing.T) {
+	mc := prepLikely(t, true)
+	defer mc.Close()
+
+	failures, err := mc.Scenarios(1)
+	if err != nil {
+		t.Fatalf("most likely search failed. got=%s", err)
+	}
+
+	if !mc.Solver().Features.Optimize {
+		t.Fatal("solver optimization not detected")
+	}
+
+	if len(failures) != 1 || failures[0].Values["a_value_0"] != "12.0" {
+		t.Fatalf("most likely failure not found. got=%v", failures)
+	}
+}
+
+func TestMostLikelyTighten(t *testing.T) {
+	mc := prepLikely(t, false)
+	defer mc.Close()
+
+	failures, err := mc.Scenarios(1)
+	if err != nil {
+		t.Fatalf("most likely search failed. got=%s", err)
+	}
+
+	if mc.Solver().Features.Optimize {
+		t.Fatal("solver without optimization detected as optimizing")
+	}
+
+	if len(failures) != 1 {
+		t.Fatalf("most likely failure not found. got=%d failures", len(failures))
+	}
+
+	v, ok := failures[0].Results["a_value"].(*FloatTrace).Index(0)
+	if !ok || math.Abs(v-12) > 0.01 {
+		t.Fatalf("tightening did not converge on the most likely failure. got=%v", v)
+	}
+}
+
+func TestObjective(t *testing.T) {
+	mc := NewModelChecker()
+	mc.SMT = "(declare-fun a_value_0 () Real)(declare-fun a_flip_0 () Bool)"
+	mc.Uncertains = map[string]*ast.Distribution{
+		"a_value": ast.NewNormal(-10, 2),
+		"a_flip":  {Kind: "bernoulli", Params: []float64{1}},
+		"a_other": ast.NewNormal(0, 1),
+	}
+
+	costs, err := mc.costs()
+	if err != nil {
+		t.Fatalf("costs failed. got=%s", err)
+	}
+
+	obj := objective(costs)
+	if !strings.HasPrefix(obj, "(+ (ite a_flip_0 0.0 1000.0) (ite (<= a_value_0 ") {
+		t.Fatalf("objective not correct. got=%s", obj)
+	}
+
+	value := NewFloatTrace()
+	value.Add(0, -6)
+	flip := NewBoolTrace()
+	flip.Add(0, true)
+	if got := total(costs, map[string]Scenario{"a_value": value, "a_flip": flip}); math.Abs(got-2) > 0.1 {
+		t.Fatalf("cost of the model not correct. want=2 got=%v", got)
+	}
+
+	if strings.Contains(objective(costs), "a_other") {
+		t.Fatal("undeclared uncertain value in the objective")
+	}
+}
+
+func TestSkewedCost(t *testing.T) {
+	mc := NewModelChecker()
+	mc.SMT = "(declare-fun a_wait_0 () Real)(declare-fun a_size_0 () Real)(declare-fun a_count_0 () Int)"
+	mc.Uncertains = map[string]*ast.Distribution{
+		"a_wait":  {Kind: "exponential", Params: []float64{1}},
+		"a_size":  {Kind: "lognormal", Params: []float64{0, 1}},
+		"a_count": {Kind: "poisson", Params: []float64{3}},
+	}
+
+	costs, err := mc.costs()
+	if err != nil {
+		t.Fatalf("costs failed. got=%s", err)
+	}
+
+	float := func(x float64) Scenario {
+		v := NewFloatTrace()
+		v.Add(0, x)
+		return v
+	}
+	count := NewIntTrace()
+	count.Add(0, 0)
+
+	// -log(p(x)/p(mode)) for each
+	tests := []struct {
+		cost  *cost
+		value Scenario
+		want  float64
+	}{
+		{costs[0], count, math.Log(4.5)},
+		{costs[1], float(math.Exp(-1)), 0},
+		{costs[1], float(math.Exp(0.5)), 1.125},
+		{costs[2], float(0), 0},
+		{costs[2], float(0.5), 0.5},
+		{costs[2], float(1), 1},
+	}
+
+	for _, test := range tests {
+		if got := test.cost.of(test.value); math.Abs(got-test.want) > 0.05 {
+			t.Fatalf("cost of %s not correct. want=%v got=%v", test.cost.base, test.want, got)
+		}
+	}
+
+	if !strings.Contains(costs[0].term(), "(to_real a_count_0)") {
+		t.Fatalf("integer value not converted in the objective. got=%s", costs[0].term())
+	}
+}
@@ -0,0 +1,114 @@
+package execute
+
+import (
+	resultlog "fault/smt/log"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Optimization queries, maximize and minimize. The generator
+// ties a Real to the target and the solver optimizes it over
+// every run of the model, the asserts are left out. The answer
+// is the value and the run that reaches it. Only backends that
+// accept objectives can answer, see Solver.DetectOptimize.
+
+type Optimum struct {
+	Query     string   `json:"query"`
+	Value     string   `json:"value"`               // As the solver printed it
+	Unbounded bool     `json:"unbounded,omitempty"` // No best value, Value is just one the target can take
+	Witness   *Failure `json:"-"`
+}
+
+// Solvers print infinity as oo in (get-objectives)
+var infinite = regexp.MustCompile(`[\s(]oo[\s)]`)
+
+// Optimize asks the solver for the best value of the target
+// and a run that reaches it
+func (mc *ModelChecker) Optimize(o *resultlog.Objective) (*Optimum, error) {
+	if _, err := mc.connect(); err != nil {
+		return nil, err
+	}
+	if !mc.Solver().DetectOptimize(mc.ctx) {
+		return nil, fmt.Errorf("solver %s does not support objectives, %s needs one that does (z3)", mc.backend, o.Query)
+	}
+
+	if err := mc.Push(); err != nil {
+		return nil, err
+	}
+	defer mc.Pop()
+
+	for _, r := range o.Rules {
+		if err := mc.command(r); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.command(fmt.Sprintf("(%s %s)", o.Direction, o.Var)); err != nil {
+		return nil, err
+	}
+
+	results, ok, err := mc.solution()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%s has no runs to search, the assumes rule them all out", o.Query)
+	}
+
+	unbounded, err := mc.unbounded()
+	if err != nil {
+		return nil, err
+	}
+
+	// The witness is any run, not a failure, so it isn't
+	// checked against the asserts
+	l := copyLog(mc.Log)
+	l.ProcessedAsserts = nil
+	results, err = mc.Filter(results)
+	if err != nil {
+		return nil, err
+	}
+	return &Optimum{
+		Query:     o.Query,
+		Value:     mc.ResultValues[o.Var],
+		Unbounded: unbounded,
+		Witness: &Failure{
+			Results: results,
+			Values:  mc.ResultValues,
+			Log:     l,
+		},
+	}, nil
+}
+
+// OptimizeAll answers every query in the log, in spec order
+func (mc *ModelChecker) OptimizeAll() ([]*Optimum, error) {
+	var optima []*Optimum
+	for _, o := range mc.Log.Objectives {
+		opt, err := mc.Optimize(o)
+		if err != nil {
+			return optima, err
+		}
+		optima = append(optima, opt)
+	}
+	return optima, nil
+}
+
+func (mc *ModelChecker) unbounded() (bool, error) {
+	actions := []string{"(get-objectives)"}
+	if mc.session == nil { // A fresh process has to check again
+		actions = append([]string{"(check-sat)"}, actions...)
+	}
+
+	out, err := mc.run(actions)
+	if err != nil {
+		return false, err
+	}
+	return infinite.MatchString(out), nil
+}
+
+func (o *Optimum) String() string {
+	if o.Unbounded {
+		return fmt.Sprintf("%s unbounded, for example %s\n", strings.TrimSuffix(o.Query, ";"), o.Value)
+	}
+	return fmt.Sprintf("%s = %s\n", strings.TrimSuffix(o.Query, ";"), o.Value)
+}
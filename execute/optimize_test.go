@@ -0,0 +1,106 @@
+package execute
+
+import (
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"testing"
+)
+
+// Answers the objective with the value given, or complains
+// about the command if it's empty
+func optimizeStub(t *testing.T, value string) *Solver {
+	objective := `echo "(error \"unsupported command\")"`
+	if value != "" {
+		objective = "objective=1"
+	}
+
+	path := stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(maximize"*|*"(minimize"*) `+objective+` ;;
+*check-sat*) echo sat ;;
+*get-objectives*) echo "(objectives"; echo " (__objective_0 `+value+`)"; echo ")" ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; continue; fi
+	echo "(model"
+	echo "  (define-fun a_value_0 () Real 40.0)"
+	echo "  (define-fun __objective_0 () Real 40.0)"
+	echo ")" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepOptimize(t *testing.T, value string) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = optimizeStub(t, value)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	l.Objectives = append(l.Objectives, &resultlog.Objective{
+		Query:     "maximize a.value;",
+		Line:      4,
+		Col:       1,
+		Direction: "maximize",
+		Var:       "__objective_0",
+		Rules:     []string{"(declare-fun __objective_0 () Real)", "(assert (= __objective_0 a_value_0))"},
+	})
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{}, nil, nil, l)
+	mc.LoadMeta(forks.InitFork())
+	return mc
+}
+
+func TestOptimize(t *testing.T) {
+	mc := prepOptimize(t, "40.0")
+	defer mc.Close()
+
+	optima, err := mc.OptimizeAll()
+	if err != nil {
+		t.Fatalf("optimization failed. got=%s", err)
+	}
+
+	if len(optima) != 1 || optima[0].Value != "40.0" || optima[0].Unbounded {
+		t.Fatalf("optimum not correct. got=%+v", optima)
+	}
+
+	if optima[0].String() != "maximize a.value = 40.0\n" {
+		t.Fatalf("optimum not formatted correctly. got=%s", optima[0])
+	}
+
+	w := optima[0].Witness
+	if w.Values["a_value_0"] != "40.0" || len(w.Log.ProcessedAsserts) != 0 {
+		t.Fatalf("witness not correct. got=%+v", w)
+	}
+
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("objective not removed after the query. got=%v %s", ok, err)
+	}
+}
+
+func TestOptimizeUnbounded(t *testing.T) {
+	mc := prepOptimize(t, "oo")
+	defer mc.Close()
+
+	optima, err := mc.OptimizeAll()
+	if err != nil {
+		t.Fatalf("optimization failed. got=%s", err)
+	}
+
+	if !optima[0].Unbounded {
+		t.Fatalf("unbounded objective not detected. got=%+v", optima[0])
+	}
+}
+
+func TestOptimizeUnsupported(t *testing.T) {
+	mc := prepOptimize(t, "")
+	defer mc.Close()
+
+	if _, err := mc.OptimizeAll(); err == nil {
+		t.Fatal("solver without objectives did not fail")
+	}
+}
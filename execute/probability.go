@@ -0,0 +1,164 @@
+package execute
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Probabilistic asserts. Each prob() assert is checked by
+// drawing the uncertain values the same way the simulation
+// does and asking the solver about the event in every sample.
+// Anything the spec leaves open (unknowns, choices) goes
+// against the bound: for prob(x) < p a sample counts if the
+// event can happen, for prob(x) > p only if it has to. The
+// verdict compares the bound to the confidence interval.
+
+const (
+	Met          = "met"
+	NotMet       = "not met"
+	Inconclusive = "inconclusive"
+)
+
+type Verdict struct {
+	Assert     string    `json:"assert"`
+	Line       int       `json:"line"`
+	Col        int       `json:"col"`
+	Outcome    string    `json:"outcome"`
+	Estimate   *Estimate `json:"estimate"`
+	Samples    int       `json:"samples"`
+	Uncertains int       `json:"uncertains"` // Uncertain values drawn in each sample
+	Confidence float64   `json:"confidence"`
+	Method     string    `json:"method"`
+}
+
+// CheckProbabilities estimates the probability of each prob()
+// assert in the log. The model should be the spec without its
+// asserts (see smt.Generator.Model).
+func (mc *ModelChecker) CheckProbabilities(opts *SimulationOptions) ([]*Verdict, error) {
+	if mc.Log == nil || len(mc.Log.Probabilities) == 0 {
+		return nil, nil
+	}
+
+	confidence, err := confidenceLevel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := mc.newDraws(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	n := opts.Samples
+	if len(d.uncertains) == 0 {
+		n = 1 // Every run is the same
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("probabilistic asserts need at least one sample, got %d", n)
+	}
+
+	hits := make([]int, len(mc.Log.Probabilities))
+	for i := 0; i < n; i++ {
+		pins := d.next()
+		for j, p := range mc.Log.Probabilities {
+			ok, err := mc.occurs(pins, p.Event, isUpper(p.Op))
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				hits[j]++
+			}
+		}
+	}
+
+	z := zScore(confidence)
+	var verdicts []*Verdict
+	for j, p := range mc.Log.Probabilities {
+		e := estimate("", hits[j], n, z)
+		v := &Verdict{
+			Assert:     p.Assert,
+			Line:       p.Line,
+			Col:        p.Col,
+			Estimate:   e,
+			Samples:    n,
+			Uncertains: len(d.uncertains),
+			Confidence: confidence,
+		}
+
+		if len(d.uncertains) == 0 {
+			e.Low, e.High = e.Probability, e.Probability
+			v.Method = "the spec has no uncertain values, a single run decides the probability"
+		} else {
+			v.Method = fmt.Sprintf("Monte Carlo over %d runs drawing %d uncertain values (seed %d), %.0f%% Wilson interval", n, len(d.uncertains), opts.Seed, confidence*100)
+		}
+		v.Outcome = outcome(p.Op, p.Bound, e)
+		verdicts = append(verdicts, v)
+	}
+	return verdicts, nil
+}
+
+// occurs checks the event in one sample, for an upper bound the
+// event counts if it can happen and for a lower bound if it
+// can't be avoided
+func (mc *ModelChecker) occurs(pins []string, event string, upper bool) (bool, error) {
+	if err := mc.Push(); err != nil {
+		return false, err
+	}
+	defer mc.Pop()
+
+	for _, p := range pins {
+		if err := mc.Assert(p); err != nil {
+			return false, err
+		}
+	}
+
+	if upper {
+		if err := mc.Assert(event); err != nil {
+			return false, err
+		}
+		return mc.Check()
+	}
+
+	if err := mc.Assert(fmt.Sprintf("(not %s)", event)); err != nil {
+		return false, err
+	}
+	ok, err := mc.Check()
+	return !ok, err
+}
+
+func isUpper(op string) bool {
+	return op == "<" || op == "<="
+}
+
+// outcome is met if the whole interval is on the right side of
+// the bound, not met if it's all on the wrong side
+func outcome(op string, bound float64, e *Estimate) string {
+	var met, missed bool
+	switch op {
+	case "<":
+		met, missed = e.High < bound, e.Low >= bound
+	case "<=":
+		met, missed = e.High <= bound, e.Low > bound
+	case ">":
+		met, missed = e.Low > bound, e.High <= bound
+	case ">=":
+		met, missed = e.Low >= bound, e.High < bound
+	}
+
+	switch {
+	case met:
+		return Met
+	case missed:
+		return NotMet
+	default:
+		return Inconclusive
+	}
+}
+
+func (v *Verdict) String() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "%s (line %d, col %d)\n", v.Assert, v.Line, v.Col)
+	fmt.Fprintf(&out, "  %s: estimated probability %.4f [%.4f, %.4f]\n", strings.ToUpper(v.Outcome), v.Estimate.Probability, v.Estimate.Low, v.Estimate.High)
+	fmt.Fprintf(&out, "  computed with %s\n", v.Method)
+	return out.String()
+}
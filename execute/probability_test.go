@@ -0,0 +1,101 @@
+package execute
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"testing"
+)
+
+// The event is a_value_0 > 10, so it happens in about half the
+// runs
+func prepProbability(t *testing.T, op string, bound float64) *ModelChecker {
+	path := stubSolver(t, `while IFS= read -r line; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(push"*) negated= ;;
+*"(assert (= a_value_0"*) value=$(echo "$line" | sed 's/.*a_value_0 \(.*\)))/\1/') ;;
+*"(assert (not (> a_value_0 10)))"*) negated=1 ;;
+*check-sat*)
+	if [ -n "$probe" ]; then echo sat; continue; fi
+	if echo "$value" | awk '{ exit !($1 > 10) }'; then big=1; else big=; fi
+	if [ -n "$negated" ]; then
+		if [ -n "$big" ]; then echo unsat; else echo sat; fi
+	else
+		if [ -n "$big" ]; then echo sat; else echo unsat; fi
+	fi ;;
+*get-model*) echo "(model (define-fun fault_probe () Real 2.0))" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = NewSolver("stub", path, nil)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Probabilities = append(l.Probabilities, &resultlog.Probability{
+		Assert: "assert prob(a.value > 10) < 0.5;",
+		Line:   4,
+		Col:    1,
+		Op:     op,
+		Bound:  bound,
+		Event:  "(> a_value_0 10)",
+	})
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{"a_value": ast.NewNormal(10, 2)}, nil, nil, l)
+	return mc
+}
+
+func TestCheckProbabilities(t *testing.T) {
+	tests := []struct {
+		op    string
+		bound float64
+		want  string
+	}{
+		{"<", 0.9, Met},
+		{"<", 0.1, NotMet},
+		{"<", 0.5, Inconclusive},
+		{">=", 0.2, Met},
+		{">", 0.8, NotMet},
+	}
+
+	for _, test := range tests {
+		mc := prepProbability(t, test.op, test.bound)
+		verdicts, err := mc.CheckProbabilities(&SimulationOptions{Samples: 200, Seed: 1})
+		mc.Close()
+		if err != nil {
+			t.Fatalf("checking probabilities failed. got=%s", err)
+		}
+
+		if len(verdicts) != 1 {
+			t.Fatalf("wrong number of verdicts. want=1 got=%d", len(verdicts))
+		}
+
+		v := verdicts[0]
+		if v.Outcome != test.want {
+			t.Errorf("prob %s %v has the wrong outcome. want=%s got=%s (%s)", test.op, test.bound, test.want, v.Outcome, v.Estimate)
+		}
+		if v.Samples != 200 || v.Uncertains != 1 {
+			t.Errorf("verdict settings not correct. got=%d samples %d uncertains", v.Samples, v.Uncertains)
+		}
+	}
+}
+
+func TestCheckProbabilitiesCertain(t *testing.T) {
+	mc := prepProbability(t, "<", 0.5)
+	defer mc.Close()
+	mc.Uncertains = nil
+
+	verdicts, err := mc.CheckProbabilities(&SimulationOptions{Samples: 200, Seed: 1})
+	if err != nil {
+		t.Fatalf("checking probabilities failed. got=%s", err)
+	}
+
+	v := verdicts[0]
+	if v.Samples != 1 {
+		t.Fatalf("spec with no uncertain values should take one run. got=%d", v.Samples)
+	}
+	if v.Estimate.Low != v.Estimate.High {
+		t.Fatalf("single run should give an exact probability. got=%s", v.Estimate)
+	}
+}
@@ -0,0 +1,179 @@
+package execute
+
+import (
+	resultlog "fault/smt/log"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// All-SAT enumeration of failure scenarios. Each time a model
+// is found we block that assignment of the live state variables
+// and ask again. Models that only differ in values the event
+// log hides (dead branches, identical transition sequences)
+// are dropped.
+
+// Models can repeat a transition sequence with different
+// values, give up after this many tries per scenario.
+const attemptsPerScenario = 4
+
+type Failure struct {
+	Results map[string]Scenario
+	Values  map[string]string
+	Log     *resultlog.ResultLog
+}
+
+func (mc *ModelChecker) Scenarios(n int) ([]*Failure, error) {
+	var failures []*Failure
+	seen := make(map[string]bool)
+	base := mc.Log
+
+	if err := mc.Push(); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		mc.Log = base
+		mc.Pop()
+	}()
+
+	for attempts := 0; len(failures) < n && attempts < n*attemptsPerScenario; attempts++ {
+		results, ok, err := mc.NextFailure()
+		if err != nil {
+			return failures, err
+		}
+		if !ok {
+			break
+		}
+		results, err = mc.Filter(results)
+		if err != nil {
+			return failures, err
+		}
+
+		// Work on a copy, the event log is filtered in place
+		mc.Log = copyLog(base)
+		for k, v := range results {
+			mc.mapToLog(k, v)
+		}
+		dead := mc.deadVariables()
+		skip := make(map[string]bool)
+		for _, d := range dead {
+			skip[d] = true
+		}
+		mc.Log.FilterOut(dead)
+
+		key := mc.transitionSequence(skip)
+		if !seen[key] {
+			seen[key] = true
+			failures = append(failures, &Failure{
+				Results: results,
+				Values:  mc.ResultValues,
+				Log:     copyLog(base),
+			})
+		}
+
+		block := mc.blockingClause(skip)
+		if block == "" { // Nothing left to vary
+			break
+		}
+
+		if err := mc.Assert(block); err != nil {
+			return failures, err
+		}
+	}
+	return failures, nil
+}
+
+// LoadFailure points the formatters at a scenario
+// returned by Scenarios
+func (mc *ModelChecker) LoadFailure(f *Failure) {
+	mc.Log = f.Log
+	mc.ResultValues = f.Values
+	if mc.Forks != nil {
+		mc.Forks.ClearMarks()
+	}
+}
+
+func (mc *ModelChecker) deadVariables() []string {
+	if mc.Forks == nil {
+		return nil
+	}
+	mc.Forks.ClearMarks()
+	return mc.DeadVariables()
+}
+
+func (mc *ModelChecker) blockingClause(skip map[string]bool) string {
+	// Prefer state variables, fall back on everything
+	// the event log reports if there aren't any
+	vars := mc.liveVars(skip, func(e *resultlog.Event) bool { return e.Type == "STATEVAR" })
+	if len(vars) == 0 {
+		vars = mc.liveVars(skip, func(e *resultlog.Event) bool { return e.Type == "INIT" || e.Type == "CHANGE" })
+	}
+
+	var clauses []string
+	for _, v := range vars {
+		clauses = append(clauses, fmt.Sprintf("(= %s %s)", v, smtLiteral(mc.ResultValues[v])))
+	}
+
+	switch len(clauses) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("(not %s)", clauses[0])
+	default:
+		return fmt.Sprintf("(not (and %s))", strings.Join(clauses, " "))
+	}
+}
+
+func (mc *ModelChecker) liveVars(skip map[string]bool, include func(*resultlog.Event) bool) []string {
+	var vars []string
+	for _, e := range mc.Log.Events {
+		if !include(e) || skip[e.Variable] {
+			continue
+		}
+		if _, ok := mc.ResultValues[e.Variable]; ok {
+			vars = append(vars, e.Variable)
+		}
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// transitionSequence is the key scenarios are deduplicated on:
+// the transitions left in the log and which state each live
+// state variable settled on. Specs without state charts fall
+// back on the live values.
+func (mc *ModelChecker) transitionSequence(skip map[string]bool) string {
+	var seq []string
+	for _, e := range mc.Log.Events {
+		if !e.Dead && e.Type == "TRANSITION" {
+			seq = append(seq, fmt.Sprintf("%d:%s>%s", e.Round, e.Previous, e.Current))
+		}
+	}
+
+	for _, v := range mc.liveVars(skip, func(e *resultlog.Event) bool { return e.Type == "STATEVAR" }) {
+		seq = append(seq, fmt.Sprintf("%s=%s", v, mc.ResultValues[v]))
+	}
+
+	if len(seq) > 0 {
+		return strings.Join(seq, ",")
+	}
+
+	for _, e := range mc.Log.Events {
+		if !e.Dead {
+			seq = append(seq, fmt.Sprintf("%s=%s", e.Variable, e.Current))
+		}
+	}
+	return strings.Join(seq, ",")
+}
+
+func smtLiteral(v string) string {
+	if strings.HasPrefix(v, "-") {
+		return fmt.Sprintf("(- %s)", v[1:])
+	}
+	return v
+}
+
+func copyLog(l *resultlog.ResultLog) *resultlog.ResultLog {
+	return l.Copy()
+}
@@ -0,0 +1,106 @@
+package execute
+
+import (
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"testing"
+)
+
+// Returns a different model for each blocking clause
+// asserted, the second one repeats the first state.
+func scenarioStub(t *testing.T) *Solver {
+	path := stubSolver(t, `blocks=0
+while IFS= read -r line; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(pop 1)"*) blocks=0 ;;
+*"(assert (not"*) blocks=$((blocks+1)) ;;
+*check-sat*)
+	if [ $blocks -gt 2 ]; then echo unsat; else echo sat; fi ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; continue; fi
+	case $blocks in
+	0) state=true; value=1.0 ;;
+	1) state=true; value=2.0 ;;
+	*) state=false; value=3.0 ;;
+	esac
+	echo "(model"
+	echo "  (define-fun a_value_0 () Real $value)"
+	echo "  (define-fun a_state_1 () Bool $state)"
+	echo ")" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepScenarios(t *testing.T) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = scenarioStub(t)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	l.Add(resultlog.NewStateVar(1, "", "a_state_1"))
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{}, nil, nil, l)
+	mc.LoadMeta(forks.InitFork())
+	return mc
+}
+
+func TestScenarios(t *testing.T) {
+	mc := prepScenarios(t)
+	defer mc.Close()
+
+	failures, err := mc.Scenarios(5)
+	if err != nil {
+		t.Fatalf("scenario enumeration failed. got=%s", err)
+	}
+
+	if len(failures) != 2 {
+		t.Fatalf("wrong number of distinct scenarios. want=2 got=%d", len(failures))
+	}
+
+	if failures[0].Values["a_state_1"] != "true" || failures[1].Values["a_state_1"] != "false" {
+		t.Fatalf("scenarios not correct. got=%s and %s", failures[0].Values, failures[1].Values)
+	}
+
+	if failures[0].Log.Events[0].Current != "" {
+		t.Fatalf("scenario log was modified during enumeration. got=%s", failures[0].Log.Events[0].Current)
+	}
+
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("blocking clauses not removed after enumeration. got=%v %s", ok, err)
+	}
+}
+
+func TestScenariosLimit(t *testing.T) {
+	mc := prepScenarios(t)
+	defer mc.Close()
+
+	failures, err := mc.Scenarios(1)
+	if err != nil {
+		t.Fatalf("scenario enumeration failed. got=%s", err)
+	}
+
+	if len(failures) != 1 {
+		t.Fatalf("wrong number of scenarios. want=1 got=%d", len(failures))
+	}
+}
+
+func TestBlockingClause(t *testing.T) {
+	mc := NewModelChecker()
+	mc.Log = resultlog.NewLog()
+	mc.Log.Add(resultlog.NewInit(0, "", "a_value_0"))
+	mc.Log.Add(resultlog.NewChange(1, "", "a_value_1"))
+	mc.Log.Add(resultlog.NewChange(2, "", "a_value_2"))
+	mc.ResultValues = map[string]string{"a_value_0": "1.0", "a_value_1": "-2.0", "a_value_2": "4.0"}
+
+	got := mc.blockingClause(map[string]bool{"a_value_2": true})
+	expected := "(not (and (= a_value_0 1.0) (= a_value_1 (- 2.0))))"
+	if got != expected {
+		t.Fatalf("blocking clause not correct. want=%s got=%s", expected, got)
+	}
+}
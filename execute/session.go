@@ -0,0 +1,122 @@
+package execute
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// A persistent solver process. The model is loaded once and
+// every query after that reuses the asserted context. Responses
+// are delimited by echoing a sentinel after each query, so we
+// never have to guess where a model ends.
+
+const sentinel = "fault_echo"
+
+type Session struct {
+	solver *Solver
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	stderr *bytes.Buffer
+	depth  int
+}
+
+func (s *Solver) Open() (*Session, error) {
+	return s.OpenContext(context.Background())
+}
+
+// OpenContext starts a session that is killed when ctx is done
+func (s *Solver) OpenContext(ctx context.Context) (*Session, error) {
+	cmd := exec.CommandContext(ctx, s.Command, s.Arguments...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("solver %s failed to start: %s", s.Name, err)
+	}
+
+	ss := &Session{
+		solver: s,
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: bufio.NewReader(stdout),
+		stderr: &stderr,
+	}
+
+	if err := ss.Send(s.Preamble...); err != nil {
+		ss.Close()
+		return nil, err
+	}
+	return ss, nil
+}
+
+// Send writes commands without waiting for a response
+func (ss *Session) Send(cmds ...string) error {
+	for _, c := range cmds {
+		if _, err := io.WriteString(ss.stdin, c+"\n"); err != nil {
+			return fmt.Errorf("solver %s closed: %s %s", ss.solver.Name, err, ss.stderr.String())
+		}
+	}
+	return nil
+}
+
+// Query sends commands and collects everything the solver
+// returns until the sentinel comes back.
+func (ss *Session) Query(cmds ...string) (string, error) {
+	cmds = append(cmds, fmt.Sprintf("(echo \"%s\")", sentinel))
+	if err := ss.Send(cmds...); err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	for {
+		line, err := ss.stdout.ReadString('\n')
+		if strings.Trim(strings.TrimSpace(line), "\"") == sentinel {
+			break
+		}
+		out.WriteString(line)
+		if err != nil {
+			return strings.TrimSpace(out.String()), fmt.Errorf("solver %s closed: %s %s", ss.solver.Name, err, ss.stderr.String())
+		}
+	}
+
+	res := strings.TrimSpace(out.String())
+	if strings.HasPrefix(res, "(error") {
+		return res, fmt.Errorf("solver %s returned %s", ss.solver.Name, res)
+	}
+	return res, nil
+}
+
+func (ss *Session) Push() error {
+	ss.depth++
+	return ss.Send("(push 1)")
+}
+
+func (ss *Session) Pop() error {
+	if ss.depth == 0 {
+		return fmt.Errorf("solver %s has no context to pop", ss.solver.Name)
+	}
+	ss.depth--
+	return ss.Send("(pop 1)")
+}
+
+func (ss *Session) Close() error {
+	ss.Send("(exit)")
+	ss.stdin.Close()
+	return ss.cmd.Wait()
+}
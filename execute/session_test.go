@@ -0,0 +1,129 @@
+package execute
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Answers line by line like an interactive solver and
+// records every launch in starts.
+func interactiveStub(t *testing.T) (*Solver, string) {
+	starts := filepath.Join(t.TempDir(), "starts")
+	path := stubSolver(t, `echo start >> `+starts+`
+probe=0
+depth=0
+while IFS= read -r line; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(push 1)"*) depth=$((depth+1)) ;;
+*"(pop 1)"*) depth=$((depth-1)) ;;
+*"(assert blocked)"*) blocked=$depth ;;
+*check-sat*)
+	if [ -n "$blocked" ] && [ "$blocked" -le "$depth" ]; then echo unsat; else echo sat; fi ;;
+*get-model*)
+	if [ $probe = 1 ]; then echo "(model (define-fun fault_probe () Real 2.0))"
+	else echo "(model"; echo "  (define-fun test_value_0 () Real 1.0)"; echo ")"; fi ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil), starts
+}
+
+func launches(t *testing.T, starts string) int {
+	data, err := os.ReadFile(starts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Count(string(data), "start")
+}
+
+func TestSessionReuse(t *testing.T) {
+	s, starts := interactiveStub(t)
+	f, err := s.Detect()
+	if err != nil {
+		t.Fatalf("feature detection failed. got=%s", err)
+	}
+
+	if !f.Echo {
+		t.Fatalf("echo support not detected. got=%+v", f)
+	}
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	mc.UseSolver("stub")
+	mc.LoadModel("(declare-fun test_value_0 () Real)", nil, nil, nil, nil)
+	defer mc.Close()
+
+	before := launches(t, starts)
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("stub session check failed. got=%v %s", ok, err)
+	}
+
+	solution, err := mc.Solve()
+	if err != nil {
+		t.Fatalf("stub session solve failed. got=%s", err)
+	}
+
+	if solution["test_value"] == nil {
+		t.Fatalf("model not parsed from session. got=%s", solution)
+	}
+
+	if launches(t, starts)-before != 1 {
+		t.Fatalf("solver restarted between queries. got=%d launches", launches(t, starts)-before)
+	}
+}
+
+func TestSessionPushPop(t *testing.T) {
+	s, _ := interactiveStub(t)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	mc.UseSolver("stub")
+	mc.LoadModel("(declare-fun test_value_0 () Real)", nil, nil, nil, nil)
+	defer mc.Close()
+
+	mc.Push()
+	mc.Assert("blocked")
+	ok, err := mc.Check()
+	if err != nil || ok {
+		t.Fatalf("assertion in pushed scope ignored. got=%v %s", ok, err)
+	}
+
+	mc.Pop()
+	ok, err = mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("pop did not drop assertion. got=%v %s", ok, err)
+	}
+
+	if mc.Pop() == nil {
+		t.Fatal("pop past the model did not return an error")
+	}
+}
+
+func TestScopesWithoutSession(t *testing.T) {
+	s := NewSolver("stub", stubSolver(t, `input=$(cat)
+case "$input" in
+*"(assert blocked)"*) echo unsat ;;
+*) echo sat ;;
+esac`), nil)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	mc.UseSolver("stub")
+	mc.LoadModel("", nil, nil, nil, nil)
+
+	mc.Push()
+	mc.Assert("blocked")
+	if ok, _ := mc.Check(); ok {
+		t.Fatal("assertion in pushed scope ignored")
+	}
+
+	mc.Pop()
+	if ok, _ := mc.Check(); !ok {
+		t.Fatal("pop did not drop assertion")
+	}
+}
@@ -0,0 +1,292 @@
+package execute
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Monte Carlo simulation. Every uncertain value is drawn from
+// its distribution and pinned in the model, unknowns are either
+// fixed by the user or left to the solver (which picks the
+// worst case). With the inputs pinned the model only has one
+// execution, so each sample is a concrete run of the spec and
+// the share of runs that break an assertion is an estimate of
+// its real failure probability.
+
+type SimulationOptions struct {
+	Samples    int
+	Seed       int64
+	Confidence float64            // Level of the confidence intervals, 0.95 if not set
+	Fixed      map[string]float64 // Values for unknowns, by variable name
+}
+
+type Simulation struct {
+	Samples    int         `json:"samples"`
+	Confidence float64     `json:"confidence"`
+	Failures   *Estimate   `json:"failures"` // Runs that broke any assertion
+	Asserts    []*Estimate `json:"asserts"`  // Per assertion, in spec order
+}
+
+type Estimate struct {
+	Assert      string  `json:"assert,omitempty"`
+	Violations  int     `json:"violations"`
+	Probability float64 `json:"probability"`
+	Low         float64 `json:"low"`
+	High        float64 `json:"high"`
+}
+
+var declaration = regexp.MustCompile(`\(declare-fun\s+(\S+)\s+\(\)\s+(\w+)\)`)
+
+// Simulate runs the model once per sample and counts the
+// assertions broken along the way
+func (mc *ModelChecker) Simulate(opts *SimulationOptions) (*Simulation, error) {
+	if opts.Samples < 1 {
+		return nil, fmt.Errorf("simulation needs at least one sample, got %d", opts.Samples)
+	}
+
+	confidence, err := confidenceLevel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := mc.newDraws(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var asserts []string
+	if mc.Log != nil {
+		for _, a := range mc.Log.ProcessedAsserts {
+			asserts = append(asserts, strings.TrimPrefix(strings.TrimPrefix(a.EvLogString(false), "FAILED  "), "OK  "))
+		}
+	}
+
+	var failures int
+	violations := make([]int, len(asserts))
+	for i := 0; i < opts.Samples; i++ {
+		violated, broken, err := mc.sample(d.next())
+		if err != nil {
+			return nil, err
+		}
+
+		if violated {
+			failures++
+		}
+		for j, b := range broken {
+			if b && j < len(violations) {
+				violations[j]++
+			}
+		}
+	}
+
+	z := zScore(confidence)
+	sim := &Simulation{
+		Samples:    opts.Samples,
+		Confidence: confidence,
+		Failures:   estimate("", failures, opts.Samples, z),
+		Asserts:    []*Estimate{},
+	}
+	for j, v := range violations {
+		sim.Asserts = append(sim.Asserts, estimate(asserts[j], v, opts.Samples, z))
+	}
+	return sim, nil
+}
+
+// draws pins the inputs of the model for each sample: the
+// unknowns the user fixed and a fresh value for every
+// uncertain one
+type draws struct {
+	sorts      map[string]string
+	fixed      []string
+	uncertains []string
+	samplers   map[string]*sampler
+	r          *rand.Rand
+}
+
+func (mc *ModelChecker) newDraws(opts *SimulationOptions) (*draws, error) {
+	sorts := make(map[string]string)
+	for _, m := range declaration.FindAllStringSubmatch(mc.SMT, -1) {
+		sorts[m[1]] = m[2]
+	}
+
+	fixed, err := mc.fixUnknowns(opts.Fixed, sorts)
+	if err != nil {
+		return nil, err
+	}
+
+	var uncertains []string
+	samplers := make(map[string]*sampler)
+	for k, u := range mc.Uncertains {
+		if _, ok := sorts[initial(k)]; ok {
+			s, err := newSampler(u)
+			if err != nil {
+				return nil, fmt.Errorf("uncertain value %s: %s", k, err)
+			}
+			uncertains = append(uncertains, k)
+			samplers[k] = s
+		}
+	}
+	sort.Strings(uncertains) // Same seed, same samples
+
+	return &draws{
+		sorts:      sorts,
+		fixed:      fixed,
+		uncertains: uncertains,
+		samplers:   samplers,
+		r:          rand.New(rand.NewSource(opts.Seed)),
+	}, nil
+}
+
+func (d *draws) next() []string {
+	pins := append([]string{}, d.fixed...)
+	for _, k := range d.uncertains {
+		v := d.samplers[k].Draw(d.r)
+		pins = append(pins, fmt.Sprintf("(= %s %s)", initial(k), literal(v, d.sorts[initial(k)])))
+	}
+	return pins
+}
+
+func confidenceLevel(opts *SimulationOptions) (float64, error) {
+	confidence := opts.Confidence
+	if confidence == 0 {
+		confidence = 0.95
+	}
+	if confidence <= 0 || confidence >= 1 {
+		return 0, fmt.Errorf("confidence level must be between 0 and 1, got %v", confidence)
+	}
+	return confidence, nil
+}
+
+// zScore is the two sided critical value for a confidence level
+func zScore(confidence float64) float64 {
+	return distuv.UnitNormal.Quantile(1 - (1-confidence)/2)
+}
+
+// sample checks the model with the inputs pinned. Returns
+// whether the run failed and which assertions it broke.
+func (mc *ModelChecker) sample(pins []string) (bool, []bool, error) {
+	if err := mc.Push(); err != nil {
+		return false, nil, err
+	}
+	defer mc.Pop()
+
+	for _, p := range pins {
+		if err := mc.Assert(p); err != nil {
+			return false, nil, err
+		}
+	}
+
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		return false, nil, err // unsat, no assertion can break
+	}
+
+	results, err := mc.Solve()
+	if err != nil {
+		return false, nil, err
+	}
+
+	base := mc.Log
+	if base == nil {
+		return true, nil, nil
+	}
+	mc.Log = copyLog(base)
+	defer func() { mc.Log = base }()
+
+	_, pass := mc.evaluate(results)
+	broken := make([]bool, len(mc.Log.ProcessedAsserts))
+	for j, a := range mc.Log.ProcessedAsserts {
+		broken[j] = a.Violated
+	}
+	return !pass || len(mc.Log.ProcessedAsserts) == 0, broken, nil
+}
+
+// fixUnknowns turns the user's values into constraints. Names
+// can be the full variable name or drop the spec (and use dots)
+// as long as they only match one unknown.
+func (mc *ModelChecker) fixUnknowns(values map[string]float64, sorts map[string]string) ([]string, error) {
+	var names []string
+	for n := range values {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var pins []string
+	for _, n := range names {
+		var match []string
+		suffix := "_" + strings.ReplaceAll(n, ".", "_")
+		for _, u := range mc.Unknowns {
+			if u == n || strings.HasSuffix(u, suffix) {
+				match = append(match, u)
+			}
+		}
+
+		switch len(match) {
+		case 0:
+			return nil, fmt.Errorf("%s is not an unknown in this spec", n)
+		case 1:
+			pins = append(pins, fmt.Sprintf("(= %s %s)", initial(match[0]), literal(values[n], sorts[initial(match[0])])))
+		default:
+			return nil, fmt.Errorf("%s is ambiguous, could be %s", n, strings.Join(match, " or "))
+		}
+	}
+	return pins, nil
+}
+
+// estimate is the observed rate with a Wilson score interval,
+// which stays inside [0, 1] even when nothing (or everything)
+// failed
+func estimate(assert string, k int, n int, z float64) *Estimate {
+	p := float64(k) / float64(n)
+	z2 := z * z
+	center := (p + z2/(2*float64(n))) / (1 + z2/float64(n))
+	margin := z * math.Sqrt(p*(1-p)/float64(n)+z2/(4*float64(n)*float64(n))) / (1 + z2/float64(n))
+
+	return &Estimate{
+		Assert:      assert,
+		Violations:  k,
+		Probability: p,
+		Low:         math.Max(0, center-margin),
+		High:        math.Min(1, center+margin),
+	}
+}
+
+func initial(base string) string {
+	return fmt.Sprintf("%s_0", base)
+}
+
+func literal(v float64, sort string) string {
+	if sort == "Bool" {
+		return strconv.FormatBool(v >= 0.5)
+	}
+	if sort == "Int" {
+		return smtLiteral(strconv.FormatInt(int64(math.Round(v)), 10))
+	}
+
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s = s + ".0"
+	}
+	return smtLiteral(s)
+}
+
+func (s *Simulation) String() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "Simulated %d runs (%.0f%% confidence intervals)\n", s.Samples, s.Confidence*100)
+	fmt.Fprintf(&out, "Failure rate: %s\n", s.Failures)
+	for _, a := range s.Asserts {
+		fmt.Fprintf(&out, "  %s: %s\n", a.Assert, a)
+	}
+	return out.String()
+}
+
+func (e *Estimate) String() string {
+	return fmt.Sprintf("%.4f [%.4f, %.4f] (%d violations)", e.Probability, e.Low, e.High, e.Violations)
+}
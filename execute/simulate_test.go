@@ -0,0 +1,116 @@
+package execute
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"testing"
+)
+
+// sat whenever the pinned value is over 10
+func simulationStub(t *testing.T) *Solver {
+	path := stubSolver(t, `while IFS= read -r line; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(assert (= a_value_0"*) value=$(echo "$line" | sed 's/.*a_value_0 \(.*\)))/\1/') ;;
+*check-sat*)
+	if [ -n "$probe" ]; then echo sat; continue; fi
+	if echo "$value" | awk '{ exit !($1 > 10) }'; then echo sat; else echo unsat; fi ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; continue; fi
+	echo "(model (define-fun a_value_0 () Real $value))" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepSimulation(t *testing.T) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = simulationStub(t)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	mc.LoadModel("(declare-fun a_value_0 () Real)", map[string]*ast.Distribution{"a_value": ast.NewNormal(10, 2)}, []string{"a_limit"}, nil, l)
+	return mc
+}
+
+func TestSimulate(t *testing.T) {
+	mc := prepSimulation(t)
+	defer mc.Close()
+
+	sim, err := mc.Simulate(&SimulationOptions{Samples: 100, Seed: 1})
+	if err != nil {
+		t.Fatalf("simulation failed. got=%s", err)
+	}
+
+	if sim.Samples != 100 || sim.Confidence != 0.95 {
+		t.Fatalf("simulation settings not correct. got=%d %v", sim.Samples, sim.Confidence)
+	}
+
+	f := sim.Failures
+	if f.Probability < 0.3 || f.Probability > 0.7 {
+		t.Fatalf("failure rate not close to 0.5. got=%v", f.Probability)
+	}
+
+	if f.Low >= f.Probability || f.High <= f.Probability {
+		t.Fatalf("confidence interval does not contain the estimate. got=%s", f)
+	}
+
+	again, err := mc.Simulate(&SimulationOptions{Samples: 100, Seed: 1})
+	if err != nil {
+		t.Fatalf("simulation failed. got=%s", err)
+	}
+	if again.Failures.Violations != f.Violations {
+		t.Fatalf("same seed gave different results. got=%d and %d", f.Violations, again.Failures.Violations)
+	}
+}
+
+func TestSimulateOptions(t *testing.T) {
+	mc := prepSimulation(t)
+	defer mc.Close()
+
+	if _, err := mc.Simulate(&SimulationOptions{}); err == nil {
+		t.Fatal("simulation with no samples did not fail")
+	}
+
+	if _, err := mc.Simulate(&SimulationOptions{Samples: 1, Confidence: 2}); err == nil {
+		t.Fatal("simulation with a confidence level over 1 did not fail")
+	}
+
+	if _, err := mc.Simulate(&SimulationOptions{Samples: 1, Fixed: map[string]float64{"missing": 1}}); err == nil {
+		t.Fatal("fixing a variable that isn't an unknown did not fail")
+	}
+}
+
+func TestFixUnknowns(t *testing.T) {
+	mc := NewModelChecker()
+	mc.Unknowns = []string{"test_s_limit", "test_s_rate", "test_t_rate"}
+	sorts := map[string]string{"test_s_limit_0": "Int", "test_s_rate_0": "Real"}
+
+	pins, err := mc.fixUnknowns(map[string]float64{"s.limit": -2.6, "test_s_rate": 3}, sorts)
+	if err != nil {
+		t.Fatalf("fixing unknowns failed. got=%s", err)
+	}
+
+	if len(pins) != 2 || pins[0] != "(= test_s_limit_0 (- 3))" || pins[1] != "(= test_s_rate_0 3.0)" {
+		t.Fatalf("unknowns not pinned correctly. got=%s", pins)
+	}
+
+	if _, err := mc.fixUnknowns(map[string]float64{"rate": 1}, sorts); err == nil {
+		t.Fatal("ambiguous unknown did not fail")
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	e := estimate("", 0, 100, 1.96)
+	if e.Probability != 0 || e.Low != 0 || e.High < 0.03 || e.High > 0.04 {
+		t.Fatalf("estimate with no violations not correct. got=%s", e)
+	}
+
+	e = estimate("", 100, 100, 1.96)
+	if e.Probability != 1 || e.High < 0.999 || e.Low < 0.96 || e.Low > 0.97 {
+		t.Fatalf("estimate with only violations not correct. got=%s", e)
+	}
+}
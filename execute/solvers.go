@@ -0,0 +1,200 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// Solver backends. Every backend reads SMTLib2 on stdin and
+// writes responses on stdout, but solvers disagree on flags and
+// on which commands they accept without extra options. Profiles
+// capture the launch conventions, Detect works out the rest.
+
+const probeSMT = `(declare-fun fault_probe () Real)
+(assert (> fault_probe 1.0))
+(check-sat)
+(get-model)
+(echo "fault_echo")
+`
+
+const probeOptimize = `(declare-fun fault_probe () Real)
+(assert (> fault_probe 1.0))
+(minimize fault_probe)
+(check-sat)
+`
+
+const produceModels = "(set-option :produce-models true)"
+
+type Features struct {
+	Detected      bool
+	CheckSat      bool // answers (check-sat) with sat/unsat
+	GetModel      bool // answers (get-model) after (check-sat) on stdin
+	ProduceModels bool // (get-model) requires :produce-models to be set first
+	Echo          bool // supports (echo), needed for interactive sessions
+	Optimize      bool // accepts (minimize) objectives, only probed for on request
+
+	optimizeDetected bool
+}
+
+type Solver struct {
+	Name      string
+	Command   string
+	Arguments []string
+	Preamble  []string // Commands sent ahead of the model
+	Features  *Features
+}
+
+func NewSolver(name string, command string, args []string) *Solver {
+	return &Solver{
+		Name:      name,
+		Command:   command,
+		Arguments: args,
+		Features:  &Features{},
+	}
+}
+
+// Built in profiles. Commands are the names the solvers
+// install under by default.
+var profiles = map[string]func() *Solver{
+	"z3": func() *Solver {
+		return NewSolver("z3", "z3", []string{"-in"})
+	},
+	"cvc5": func() *Solver {
+		return NewSolver("cvc5", "cvc5", []string{"--lang=smt2", "--incremental"})
+	},
+	"yices": func() *Solver {
+		return NewSolver("yices", "yices-smt2", []string{"--incremental"})
+	},
+	"mathsat": func() *Solver {
+		return NewSolver("mathsat", "mathsat", []string{"-input=smt2"})
+	},
+}
+
+// GenerateSolver returns every known backend keyed by name. If
+// SOLVERCMD is set it is registered as the "env" backend.
+func GenerateSolver() map[string]*Solver {
+	s := make(map[string]*Solver)
+	for k, p := range profiles {
+		s[k] = p()
+	}
+
+	command, _ := os.LookupEnv("SOLVERCMD")
+	if command != "" {
+		args, _ := os.LookupEnv("SOLVERARG")
+		s["env"] = NewSolver("env", command, strings.Fields(args))
+	}
+	return s
+}
+
+// DefaultSolver is the backend used when none is requested.
+func DefaultSolver() string {
+	if os.Getenv("SOLVERCMD") != "" {
+		return "env"
+	}
+	return "z3"
+}
+
+func SolverNames() []string {
+	var names []string
+	for k := range GenerateSolver() {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupSolver fetches a backend by name, falling back on
+// DefaultSolver when name is empty.
+func LookupSolver(name string) (*Solver, error) {
+	if name == "" {
+		name = DefaultSolver()
+	}
+	s, ok := GenerateSolver()[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown solver %s, options are: %s", name, strings.Join(SolverNames(), ", "))
+	}
+	return s, nil
+}
+
+func (s *Solver) Available() bool {
+	_, err := exec.LookPath(s.Command)
+	return err == nil
+}
+
+func (s *Solver) exec(ctx context.Context, input string) (string, error) {
+	cmd := exec.CommandContext(ctx, s.Command, s.Arguments...)
+	cmd.Stdin = strings.NewReader(input)
+
+	var out bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return strings.TrimSpace(out.String()), fmt.Errorf("%s: %s", err, stderr.String())
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// Detect probes the solver with a trivial model to learn
+// which SMTLib commands it accepts on stdin. Results are
+// cached on the backend.
+func (s *Solver) Detect() (*Features, error) {
+	return s.DetectContext(context.Background())
+}
+
+func (s *Solver) DetectContext(ctx context.Context) (*Features, error) {
+	if s.Features.Detected {
+		return s.Features, nil
+	}
+
+	out, err := s.exec(ctx, probeSMT)
+	if err != nil && out == "" {
+		return s.Features, fmt.Errorf("solver %s failed to start: %s", s.Name, err)
+	}
+
+	s.Features.CheckSat = firstLine(out) == "sat"
+	s.Features.Echo = strings.Contains(out, sentinel)
+	if s.Features.CheckSat && hasModel(out) {
+		s.Features.GetModel = true
+	} else if s.Features.CheckSat {
+		// Some solvers only keep models when asked to
+		out, _ = s.exec(ctx, fmt.Sprint(produceModels, "\n", probeSMT))
+		if firstLine(out) == "sat" && hasModel(out) {
+			s.Features.GetModel = true
+			s.Features.ProduceModels = true
+			s.Preamble = append(s.Preamble, produceModels)
+		}
+	}
+
+	s.Features.Detected = true
+	return s.Features, nil
+}
+
+// DetectOptimize probes whether the solver can minimize an
+// objective. Most can't, so it is kept out of Detect.
+func (s *Solver) DetectOptimize(ctx context.Context) bool {
+	if s.Features.optimizeDetected {
+		return s.Features.Optimize
+	}
+
+	out, _ := s.exec(ctx, fmt.Sprint(strings.Join(s.Preamble, "\n"), "\n", probeOptimize))
+	s.Features.Optimize = firstLine(out) == "sat" && !strings.Contains(out, "(error")
+	s.Features.optimizeDetected = true
+	return s.Features.Optimize
+}
+
+func firstLine(out string) string {
+	lines := strings.SplitN(out, "\n", 2)
+	return strings.TrimSpace(lines[0])
+}
+
+func hasModel(out string) bool {
+	return strings.Contains(out, "fault_probe") && !strings.Contains(out, "(error")
+}
@@ -0,0 +1,171 @@
+package execute
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Setenv("SOLVERCMD", "")
+	s := GenerateSolver()
+	for _, name := range []string{"z3", "cvc5", "yices", "mathsat"} {
+		if s[name] == nil {
+			t.Fatalf("solver %s missing from registry", name)
+		}
+	}
+
+	if s["env"] != nil {
+		t.Fatal("env solver registered without SOLVERCMD")
+	}
+
+	if DefaultSolver() != "z3" {
+		t.Fatalf("default solver not correct. want=z3 got=%s", DefaultSolver())
+	}
+
+	if _, err := LookupSolver("foo"); err == nil {
+		t.Fatal("unknown solver did not return an error")
+	}
+
+	if s["cvc5"].Arguments[0] != "--lang=smt2" {
+		t.Fatalf("cvc5 arguments not correct. got=%s", s["cvc5"].Arguments)
+	}
+}
+
+func TestRegistryEnv(t *testing.T) {
+	t.Setenv("SOLVERCMD", "mysolver")
+	t.Setenv("SOLVERARG", "-in -smt2")
+
+	s, err := LookupSolver("")
+	if err != nil {
+		t.Fatalf("env solver lookup failed. got=%s", err)
+	}
+
+	if s.Name != "env" || s.Command != "mysolver" {
+		t.Fatalf("env solver not correct. got=%s %s", s.Name, s.Command)
+	}
+
+	if len(s.Arguments) != 2 || s.Arguments[1] != "-smt2" {
+		t.Fatalf("env solver arguments not correct. got=%s", s.Arguments)
+	}
+}
+
+func TestDetectModels(t *testing.T) {
+	s := NewSolver("stub", stubSolver(t, `cat > /dev/null
+echo sat
+echo "(model (define-fun fault_probe () Real 2.0))"`), nil)
+
+	f, err := s.Detect()
+	if err != nil {
+		t.Fatalf("feature detection failed. got=%s", err)
+	}
+
+	if !f.CheckSat || !f.GetModel {
+		t.Fatalf("features not detected. got=%+v", f)
+	}
+
+	if f.ProduceModels || len(s.Preamble) != 0 {
+		t.Fatalf("produce-models set when not needed. got=%+v", f)
+	}
+}
+
+func TestDetectProduceModels(t *testing.T) {
+	s := NewSolver("stub", stubSolver(t, `input=$(cat)
+echo sat
+case "$input" in
+*produce-models*) echo "((define-fun fault_probe () Real 2.0))" ;;
+*) echo '(error "model generation not enabled")' ;;
+esac`), nil)
+
+	f, err := s.Detect()
+	if err != nil {
+		t.Fatalf("feature detection failed. got=%s", err)
+	}
+
+	if !f.GetModel || !f.ProduceModels {
+		t.Fatalf("produce-models not detected. got=%+v", f)
+	}
+
+	if len(s.Preamble) != 1 || s.Preamble[0] != produceModels {
+		t.Fatalf("preamble not correct. got=%s", s.Preamble)
+	}
+}
+
+func TestDetectNoModels(t *testing.T) {
+	s := NewSolver("stub", stubSolver(t, `cat > /dev/null
+echo sat
+echo unsupported`), nil)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	err := mc.UseSolver("stub")
+	if err != nil {
+		t.Fatalf("stub solver not selected. got=%s", err)
+	}
+
+	_, err = mc.Solve()
+	if err == nil || !strings.Contains(err.Error(), "get-model") {
+		t.Fatalf("solver without models did not return an error. got=%s", err)
+	}
+}
+
+func TestStubCheck(t *testing.T) {
+	s := NewSolver("stub", stubSolver(t, `cat > /dev/null
+echo unsat`), nil)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	mc.UseSolver("stub")
+	mc.LoadModel("(assert false)", nil, nil, nil, nil)
+
+	ok, err := mc.Check()
+	if err != nil {
+		t.Fatalf("stub solver failed. got=%s", err)
+	}
+
+	if ok {
+		t.Fatal("stub solver returned sat")
+	}
+}
+
+func TestSolveAfterCheckWithoutSession(t *testing.T) {
+	// A fresh process per call, it only knows the model if it's
+	// asked to check the context again
+	s := NewSolver("stub", stubSolver(t, `input=$(cat)
+case "$input" in
+*fault_probe*) echo sat; echo "(model (define-fun fault_probe () Real 2.0))"; exit 0 ;;
+*check-sat*) echo sat ;;
+*) exit 0 ;;
+esac
+case "$input" in
+*get-model*) echo "(model (define-fun a_0 () Real 2.0))" ;;
+esac`), nil)
+
+	mc := NewModelChecker()
+	mc.solver["stub"] = s
+	mc.UseSolver("stub")
+	mc.LoadModel("(declare-fun a_0 () Real)", nil, nil, nil, nil)
+
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("stub solver did not return sat. got=%v %s", ok, err)
+	}
+
+	if _, err := mc.Solve(); err != nil {
+		t.Fatalf("stub solver failed. got=%s", err)
+	}
+
+	if mc.ResultValues["a_0"] != "2.0" {
+		t.Fatalf("model lost after check. got=%v", mc.ResultValues)
+	}
+}
+
+func stubSolver(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "solver")
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,431 @@
+package execute
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Parameter synthesis. A failure picks whichever unknown values
+// break the spec, synthesis looks for the values where nothing
+// does. A box of values is safe if no run inside it breaks an
+// assert, whatever the uncertain values turn out to be. The
+// search starts from the parameters of runs that hold (or, if
+// none of those are safe, splits the range in half until part
+// of it is) and pushes each side of the box out with bisection
+// as far as it stays safe. The envelope is one safe box, there
+// can be others elsewhere in the range. The model checker is
+// loaded with the model without the asserts, the violation
+// comes from the log.
+
+type SynthesisOptions struct {
+	Low  float64 // Every unknown is searched for in [Low, High]
+	High float64
+}
+
+type Envelope struct {
+	Parameters []*Interval `json:"parameters"` // Sorted by name
+	Safe       bool        `json:"safe"`       // False if no part of the range is safe
+	Low        float64     `json:"low"`        // Search range
+	High       float64     `json:"high"`
+}
+
+type Interval struct {
+	Name string `json:"name"`
+	Sort string `json:"sort"`
+	Low  *Bound `json:"low"`
+	High *Bound `json:"high"`
+}
+
+type Bound struct {
+	Value float64 `json:"value"`
+	Open  bool    `json:"open,omitempty"`  // The value itself isn't safe
+	Limit bool    `json:"limit,omitempty"` // Safe up to the end of the search range
+}
+
+// Runs that hold tried as a starting point
+const seedCandidates = 16
+
+// Checks spent splitting the range looking for a safe box
+const seedChecks = 64
+
+// Bisection steps spent on each side of the box
+const boundarySteps = 40
+
+// Synthesize finds a box of unknown values where every assert
+// holds. Boolean unknowns have no range and are an error.
+func (mc *ModelChecker) Synthesize(opts *SynthesisOptions) (*Envelope, error) {
+	if opts.Low >= opts.High {
+		return nil, fmt.Errorf("search range [%g, %g] is empty", opts.Low, opts.High)
+	}
+	if mc.Log == nil || mc.Log.Violation == "" {
+		return nil, fmt.Errorf("spec has no asserts, every value is safe")
+	}
+
+	sorts := make(map[string]string)
+	for _, m := range declaration.FindAllStringSubmatch(mc.SMT, -1) {
+		sorts[m[1]] = m[2]
+	}
+
+	env := &Envelope{Low: opts.Low, High: opts.High}
+	for _, u := range mc.Unknowns {
+		ty, ok := sorts[initial(u)]
+		if !ok {
+			continue
+		}
+		if ty != "Real" && ty != "Int" {
+			return nil, fmt.Errorf("unknown %s is a %s, only numbers have a range", u, strings.ToLower(ty))
+		}
+
+		low, high := opts.Low, opts.High
+		if ty == "Int" {
+			low, high = math.Ceil(low), math.Floor(high)
+			if low > high {
+				return nil, fmt.Errorf("search range [%g, %g] has no whole numbers for %s", opts.Low, opts.High, u)
+			}
+		}
+		env.Parameters = append(env.Parameters, &Interval{Name: u, Sort: ty, Low: &Bound{Value: low}, High: &Bound{Value: high}})
+	}
+	if len(env.Parameters) == 0 {
+		return nil, fmt.Errorf("spec has no unknown values to synthesize")
+	}
+	sort.Slice(env.Parameters, func(i, j int) bool { return env.Parameters[i].Name < env.Parameters[j].Name })
+
+	seed, err := mc.seed(env.Parameters)
+	if err != nil || seed == nil {
+		return env, err
+	}
+	env.Parameters, env.Safe = seed, true
+
+	for i := range env.Parameters {
+		for _, up := range []bool{false, true} {
+			if err := mc.extend(env, i, up); err != nil {
+				return env, err
+			}
+		}
+	}
+	return env, nil
+}
+
+// seed is a safe box to grow, nil if there isn't one to be found
+func (mc *ModelChecker) seed(full []*Interval) ([]*Interval, error) {
+	box, err := mc.candidates(full)
+	if err != nil || box != nil {
+		return box, err
+	}
+	return mc.split(full)
+}
+
+// candidates tries the parameters of runs that hold as a single
+// point, skipping the points already found to be unsafe
+func (mc *ModelChecker) candidates(full []*Interval) ([]*Interval, error) {
+	var tried []string
+	for i := 0; i < seedCandidates; i++ {
+		point, err := mc.candidate(full, tried)
+		if err != nil || point == nil {
+			return nil, err
+		}
+
+		ok, err := mc.safe(point)
+		if err != nil || ok {
+			return point, err
+		}
+
+		var pins []string
+		for _, p := range point {
+			pins = append(pins, fmt.Sprintf("(= %s %s)", initial(p.Name), literal(p.Low.Value, p.Sort)))
+		}
+		if len(pins) == 1 {
+			tried = append(tried, fmt.Sprintf("(not %s)", pins[0]))
+		} else {
+			tried = append(tried, fmt.Sprintf("(not (and %s))", strings.Join(pins, " ")))
+		}
+	}
+	return nil, nil
+}
+
+// candidate is the parameters of a run that holds, nil if
+// every run in the range fails
+func (mc *ModelChecker) candidate(full []*Interval, tried []string) ([]*Interval, error) {
+	if err := mc.Push(); err != nil {
+		return nil, err
+	}
+	defer mc.Pop()
+
+	rules := append([]string{fmt.Sprintf("(not %s)", mc.Log.Violation)}, tried...)
+	for _, p := range full {
+		rules = append(rules, p.constraints()...)
+	}
+	for _, r := range rules {
+		if err := mc.Assert(r); err != nil {
+			return nil, err
+		}
+	}
+
+	results, ok, err := mc.solution()
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var point []*Interval
+	for _, p := range full {
+		v := p.Low.Value + (p.High.Value-p.Low.Value)/2 // Solvers can leave out values that don't matter
+		switch r := results[p.Name].(type) {
+		case *FloatTrace:
+			if x, ok := r.Index(0); ok {
+				v = x
+			}
+		case *IntTrace:
+			if x, ok := r.Index(0); ok {
+				v = float64(x)
+			}
+		}
+		if p.Sort == "Int" {
+			v = math.Round(v)
+		}
+		point = append(point, &Interval{Name: p.Name, Sort: p.Sort, Low: &Bound{Value: v}, High: &Bound{Value: v}})
+	}
+	return point, nil
+}
+
+// split halves the range along its widest side until it finds
+// a box that's safe, nil if it runs out of checks
+func (mc *ModelChecker) split(full []*Interval) ([]*Interval, error) {
+	queue := [][]*Interval{full}
+	for checks := 0; len(queue) > 0 && checks < seedChecks; checks++ {
+		box := queue[0]
+		queue = queue[1:]
+
+		ok, err := mc.safe(box)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return box, nil
+		}
+
+		widest, width := -1, 0.0
+		for i, p := range box {
+			w := p.High.Value - p.Low.Value
+			if p.Sort == "Int" && w < 1 {
+				continue
+			}
+			if w > width {
+				widest, width = i, w
+			}
+		}
+		if widest < 0 { // Down to a single point
+			continue
+		}
+
+		p := box[widest]
+		mid := p.Low.Value + width/2
+		lower, upper := mid, mid
+		if p.Sort == "Int" {
+			lower = math.Floor(mid)
+			upper = lower + 1
+		}
+		queue = append(queue,
+			replace(box, widest, &Interval{Name: p.Name, Sort: p.Sort, Low: p.Low, High: &Bound{Value: lower}}),
+			replace(box, widest, &Interval{Name: p.Name, Sort: p.Sort, Low: &Bound{Value: upper}, High: p.High}))
+	}
+	return nil, nil
+}
+
+// extend pushes one side of the box out as far as it stays
+// safe, bisecting between the last safe and first unsafe value
+func (mc *ModelChecker) extend(env *Envelope, i int, up bool) error {
+	p := env.Parameters[i]
+	end := math.Ceil(env.Low)
+	if up {
+		end = math.Floor(env.High)
+	}
+	if p.Sort == "Real" {
+		end = env.Low
+		if up {
+			end = env.High
+		}
+	}
+
+	try := func(b *Bound) (bool, error) {
+		q := &Interval{Name: p.Name, Sort: p.Sort, Low: b, High: p.High}
+		if up {
+			q = &Interval{Name: p.Name, Sort: p.Sort, Low: p.Low, High: b}
+		}
+		return mc.safe(replace(env.Parameters, i, q))
+	}
+	set := func(b *Bound) {
+		if up {
+			p.High = b
+		} else {
+			p.Low = b
+		}
+	}
+
+	safe := p.Low.Value
+	if up {
+		safe = p.High.Value
+	}
+
+	ok, err := try(&Bound{Value: end})
+	if err != nil {
+		return err
+	}
+	if ok {
+		set(&Bound{Value: end, Limit: true})
+		return nil
+	}
+
+	unsafe := end
+	for s := 0; s < boundarySteps; s++ {
+		if p.Sort == "Int" && math.Abs(unsafe-safe) <= 1 {
+			break
+		}
+
+		mid := safe + (unsafe-safe)/2
+		if p.Sort == "Int" {
+			mid = math.Trunc(mid)
+		}
+		ok, err := try(&Bound{Value: mid})
+		if err != nil {
+			return err
+		}
+		if ok {
+			safe = mid
+		} else {
+			unsafe = mid
+		}
+	}
+
+	if p.Sort == "Int" {
+		set(&Bound{Value: safe})
+		return nil
+	}
+
+	// Bisection only gets close, the boundary is most likely
+	// a round number somewhere in between
+	x := snap(math.Min(safe, unsafe), math.Max(safe, unsafe))
+	for _, b := range []*Bound{{Value: x}, {Value: x, Open: true}} {
+		ok, err := try(b)
+		if err != nil {
+			return err
+		}
+		if ok {
+			set(b)
+			return nil
+		}
+	}
+	set(&Bound{Value: safe})
+	return nil
+}
+
+// safe is true if no run with the parameters in the box fails
+func (mc *ModelChecker) safe(box []*Interval) (bool, error) {
+	if err := mc.Push(); err != nil {
+		return false, err
+	}
+	defer mc.Pop()
+
+	rules := []string{mc.Log.Violation}
+	for _, p := range box {
+		rules = append(rules, p.constraints()...)
+	}
+	for _, r := range rules {
+		if err := mc.Assert(r); err != nil {
+			return false, err
+		}
+	}
+
+	fails, err := mc.Check()
+	return !fails, err
+}
+
+func (p *Interval) constraints() []string {
+	x := initial(p.Name)
+	low, high := ">=", "<="
+	if p.Low.Open {
+		low = ">"
+	}
+	if p.High.Open {
+		high = "<"
+	}
+	return []string{
+		fmt.Sprintf("(%s %s %s)", low, x, literal(p.Low.Value, p.Sort)),
+		fmt.Sprintf("(%s %s %s)", high, x, literal(p.High.Value, p.Sort)),
+	}
+}
+
+// replace copies the box with one side changed
+func replace(box []*Interval, i int, p *Interval) []*Interval {
+	b := append([]*Interval{}, box...)
+	b[i] = p
+	return b
+}
+
+// snap is the number with the fewest decimals in [low, high]
+func snap(low float64, high float64) float64 {
+	for d := 0; d < 16; d++ {
+		scale := math.Pow(10, float64(d))
+		if x := math.Ceil(low*scale) / scale; x <= high {
+			return x
+		}
+	}
+	return low
+}
+
+func (e *Envelope) String() string {
+	if !e.Safe {
+		var names []string
+		for _, p := range e.Parameters {
+			names = append(names, p.Name)
+		}
+		return fmt.Sprintf("No safe values found for %s in [%s, %s]\n", strings.Join(names, ", "), number(e.Low, "Real"), number(e.High, "Real"))
+	}
+
+	width := 0
+	for _, p := range e.Parameters {
+		if len(p.Name) > width {
+			width = len(p.Name)
+		}
+	}
+
+	var out strings.Builder
+	var limit bool
+	out.WriteString("Safe operating envelope:\n")
+	for _, p := range e.Parameters {
+		fmt.Fprintf(&out, "  %-*s in %s\n", width, p.Name, p)
+		limit = limit || p.Low.Limit || p.High.Limit
+	}
+	if limit {
+		out.WriteString("  * end of the search range\n")
+	}
+	return out.String()
+}
+
+func (p *Interval) String() string {
+	open, close := "[", "]"
+	if p.Low.Open {
+		open = "("
+	}
+	if p.High.Open {
+		close = ")"
+	}
+	return fmt.Sprintf("%s%s, %s%s", open, p.Low.format(p.Sort), p.High.format(p.Sort), close)
+}
+
+func (b *Bound) format(sort string) string {
+	if b.Limit {
+		return number(b.Value, sort) + "*"
+	}
+	return number(b.Value, sort)
+}
+
+func number(v float64, sort string) string {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if sort == "Real" && !strings.Contains(s, ".") {
+		s = s + ".0"
+	}
+	return s
+}
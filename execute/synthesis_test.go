@@ -0,0 +1,112 @@
+package execute
+
+import (
+	"fault/ast"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"testing"
+)
+
+// Every run holds with a_retry in [2, 7] and a_timeout in
+// (0.5, 3.0], the solver finds a failure anywhere else. The
+// first run that holds has a_retry at 9, which isn't safe.
+func synthesisStub(t *testing.T) *Solver {
+	path := stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*"(pop 1)"*) bounds=; holds= ;;
+*"(assert (not fails))"*) holds=1 ;;
+*"(= a_retry_0 9)"*) tried=1 ;;
+*"(assert ("[\<\>]*) bounds="$bounds $(echo "$line" | tr -d '()' | awk '{ v = $4; if (v == "-") v = -$5; print $2 ":" $3 ":" v }')" ;;
+*check-sat*)
+	if [ -n "$probe$holds" ]; then echo sat; continue; fi
+	echo "$bounds" | tr ' ' '\n' | awk -F: '
+		$2 == "a_retry_0" && $1 == ">=" && $3 >= 2 { rlo = 1 }
+		$2 == "a_retry_0" && $1 == "<=" && $3 <= 7 { rhi = 1 }
+		$2 == "a_timeout_0" && (($1 == ">=" && $3 > 0.5) || ($1 == ">" && $3 >= 0.5)) { tlo = 1 }
+		$2 == "a_timeout_0" && $1 ~ /^</ && $3 <= 3 { thi = 1 }
+		END { if (rlo && rhi && tlo && thi) print "unsat"; else print "sat" }' ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; probe=; continue; fi
+	if [ -n "$tried" ]; then retry=4; else retry=9; fi
+	echo "(model (define-fun a_retry_0 () Int $retry) (define-fun a_timeout_0 () Real 1.0))" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+	return NewSolver("stub", path, nil)
+}
+
+func prepSynthesis(t *testing.T, unknowns []string) *ModelChecker {
+	mc := NewModelChecker()
+	mc.solver["stub"] = synthesisStub(t)
+	mc.UseSolver("stub")
+
+	l := resultlog.NewLog()
+	l.Violation = "fails"
+	mc.LoadModel("(declare-fun a_retry_0 () Int)(declare-fun a_timeout_0 () Real)(declare-fun a_on_0 () Bool)", map[string]*ast.Distribution{}, unknowns, nil, l)
+	mc.LoadMeta(forks.InitFork())
+	return mc
+}
+
+func TestSynthesize(t *testing.T) {
+	mc := prepSynthesis(t, []string{"a_timeout", "a_retry"})
+	defer mc.Close()
+
+	env, err := mc.Synthesize(&SynthesisOptions{Low: -1000, High: 1000})
+	if err != nil {
+		t.Fatalf("synthesis failed. got=%s", err)
+	}
+
+	expected := "Safe operating envelope:\n  a_retry   in [2, 7]\n  a_timeout in (0.5, 3.0]\n"
+	if !env.Safe || env.String() != expected {
+		t.Fatalf("envelope not correct. want=%s got=%s", expected, env)
+	}
+
+	ok, err := mc.Check()
+	if err != nil || !ok {
+		t.Fatalf("bounds not removed after synthesis. got=%v %s", ok, err)
+	}
+}
+
+func TestSynthesizeNothingSafe(t *testing.T) {
+	mc := prepSynthesis(t, []string{"a_retry"})
+	defer mc.Close()
+
+	env, err := mc.Synthesize(&SynthesisOptions{Low: 0, High: 5})
+	if err != nil {
+		t.Fatalf("synthesis failed. got=%s", err)
+	}
+
+	// a_timeout is free, so nothing is safe
+	if env.Safe {
+		t.Fatalf("envelope found with a free unknown failing. got=%s", env)
+	}
+
+	if env.String() != "No safe values found for a_retry in [0.0, 5.0]\n" {
+		t.Fatalf("envelope not formatted correctly. got=%s", env)
+	}
+}
+
+func TestSynthesizeBool(t *testing.T) {
+	mc := prepSynthesis(t, []string{"a_on"})
+	defer mc.Close()
+
+	if _, err := mc.Synthesize(&SynthesisOptions{Low: 0, High: 1}); err == nil {
+		t.Fatal("boolean unknown synthesized")
+	}
+}
+
+func TestSnap(t *testing.T) {
+	for _, tt := range []struct {
+		low, high, want float64
+	}{
+		{0.4999999, 0.5000001, 0.5},
+		{2.9999, 3.0001, 3},
+		{-0.75002, -0.74998, -0.75},
+	} {
+		if got := snap(tt.low, tt.high); got != tt.want {
+			t.Fatalf("snap(%v, %v) not correct. want=%v got=%v", tt.low, tt.high, tt.want, got)
+		}
+	}
+}
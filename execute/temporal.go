@@ -0,0 +1,202 @@
+package execute
+
+import (
+	resultlog "fault/smt/log"
+	"fmt"
+	"strconv"
+)
+
+// EvalTemporal checks a bounded LTL formula against the
+// solver's values from round i on, the same way the SMT
+// encoding expands it (see smt/ltl.go)
+func (mc *ModelChecker) EvalTemporal(f *resultlog.Formula, i int, last int) bool {
+	switch f.Op {
+	case "var", "const":
+		return mc.formulaValue(f, i, last) == "true"
+	case "not":
+		return !mc.EvalTemporal(f.Args[0], i, last)
+	case "and":
+		for _, a := range f.Args {
+			if !mc.EvalTemporal(a, i, last) {
+				return false
+			}
+		}
+		return true
+	case "or":
+		for _, a := range f.Args {
+			if mc.EvalTemporal(a, i, last) {
+				return true
+			}
+		}
+		return false
+	case "=>":
+		return !mc.EvalTemporal(f.Args[0], i, last) || mc.EvalTemporal(f.Args[1], i, last)
+	case "=":
+		l, r := mc.formulaValue(f.Args[0], i, last), mc.formulaValue(f.Args[1], i, last)
+		lf, lerr := strconv.ParseFloat(l, 64)
+		rf, rerr := strconv.ParseFloat(r, 64)
+		if lerr == nil && rerr == nil {
+			return lf == rf
+		}
+		return l == r
+	case "<", "<=", ">", ">=":
+		l, r := mc.formulaNumber(f.Args[0], i, last), mc.formulaNumber(f.Args[1], i, last)
+		switch f.Op {
+		case "<":
+			return l < r
+		case "<=":
+			return l <= r
+		case ">":
+			return l > r
+		default:
+			return l >= r
+		}
+	case "next":
+		return i < last && mc.EvalTemporal(f.Args[0], i+1, last)
+	case "until", "weak-until":
+		for j := i; j <= last; j++ {
+			if mc.EvalTemporal(f.Args[1], j, last) {
+				return true
+			}
+			if !mc.EvalTemporal(f.Args[0], j, last) {
+				return false
+			}
+		}
+		return f.Op == "weak-until"
+	case "release":
+		for j := i; j <= last; j++ {
+			if !mc.EvalTemporal(f.Args[1], j, last) {
+				return false
+			}
+			if mc.EvalTemporal(f.Args[0], j, last) {
+				return true
+			}
+		}
+		return true
+	case "always":
+		for j := i; j <= last; j++ {
+			if !mc.EvalTemporal(f.Args[0], j, last) {
+				return false
+			}
+		}
+		return true
+	case "eventually":
+		for j := i; j <= last; j++ {
+			if mc.EvalTemporal(f.Args[0], j, last) {
+				return true
+			}
+		}
+		return false
+	case "window":
+		from, to, open := f.Window(i, last)
+		if open {
+			return true
+		}
+		for j := from; j <= to; j++ {
+			if mc.EvalTemporal(f.Args[0], j, last) != f.Always() {
+				return !f.Always()
+			}
+		}
+		return f.Always()
+	default:
+		panic(fmt.Sprintf("no option for temporal operator %s", f.Op))
+	}
+}
+
+func (mc *ModelChecker) formulaValue(f *resultlog.Formula, i int, last int) string {
+	switch f.Op {
+	case "var":
+		return mc.ResultValues[f.States[i]]
+	case "const":
+		return f.Value
+	case "+", "-", "*", "/":
+		return strconv.FormatFloat(mc.formulaNumber(f, i, last), 'f', -1, 64)
+	default:
+		return strconv.FormatBool(mc.EvalTemporal(f, i, last))
+	}
+}
+
+func (mc *ModelChecker) formulaNumber(f *resultlog.Formula, i int, last int) float64 {
+	switch f.Op {
+	case "+", "-", "*", "/":
+		var args []float64
+		for _, a := range f.Args {
+			args = append(args, mc.formulaNumber(a, i, last))
+		}
+		if len(args) == 1 && f.Op == "-" {
+			return -args[0]
+		}
+
+		ret := args[0]
+		for _, a := range args[1:] {
+			switch f.Op {
+			case "+":
+				ret += a
+			case "-":
+				ret -= a
+			case "*":
+				ret *= a
+			case "/":
+				ret /= a
+			}
+		}
+		return ret
+	}
+
+	v, err := strconv.ParseFloat(mc.formulaValue(f, i, last), 64)
+	if err != nil {
+		panic(fmt.Errorf("temporal assert compares %s, not a number", mc.formulaValue(f, i, last)))
+	}
+	return v
+}
+
+// Window is a window that was never satisfied: opened in
+// round Start, it covered rounds From to To
+type Window struct {
+	Start int
+	From  int
+	To    int
+}
+
+func (w *Window) String() string {
+	if w.From > w.To {
+		return fmt.Sprintf("window opened in round %d ends after the last round", w.Start)
+	}
+	if w.From == w.To {
+		return fmt.Sprintf("violated in round %d (opened in round %d)", w.From, w.Start)
+	}
+	return fmt.Sprintf("violated in rounds %d to %d (opened in round %d)", w.From, w.To, w.Start)
+}
+
+// ViolatedWindow finds the first window that fails in a formula
+// that doesn't hold from round i, following the operands that
+// made it fail. Returns nil if the failure isn't down to a window.
+func (mc *ModelChecker) ViolatedWindow(f *resultlog.Formula, i int, last int) *Window {
+	switch f.Op {
+	case "window":
+		from, to, _ := f.Window(i, last)
+		if f.Always() { // Point to the round it stopped holding in
+			for j := from; j <= to; j++ {
+				if !mc.EvalTemporal(f.Args[0], j, last) {
+					return &Window{Start: i, From: j, To: j}
+				}
+			}
+		}
+		return &Window{Start: i, From: from, To: to}
+	case "=>":
+		return mc.ViolatedWindow(f.Args[1], i, last)
+	case "and", "or":
+		for _, a := range f.Args {
+			if !mc.EvalTemporal(a, i, last) {
+				return mc.ViolatedWindow(a, i, last)
+			}
+		}
+	case "always":
+		for j := i; j <= last; j++ {
+			if !mc.EvalTemporal(f.Args[0], j, last) {
+				return mc.ViolatedWindow(f.Args[0], j, last)
+			}
+		}
+	}
+	return nil
+}
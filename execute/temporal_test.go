@@ -0,0 +1,132 @@
+package execute
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"strings"
+	"testing"
+)
+
+func TestEvalTemporal(t *testing.T) {
+	mc := NewModelChecker()
+	mc.ResultValues = map[string]string{
+		"test_s_a_0": "1.0",
+		"test_s_a_1": "3.0",
+		"test_s_a_2": "6.0",
+		"test_s_b_0": "true",
+		"test_s_b_1": "true",
+		"test_s_b_2": "false",
+	}
+
+	a := resultlog.NewVar([]string{"test_s_a_0", "test_s_a_1", "test_s_a_2"})
+	b := resultlog.NewVar([]string{"test_s_b_0", "test_s_b_1", "test_s_b_2"})
+	big := resultlog.NewOp(">", a, resultlog.NewConst("5"))
+
+	tests := []struct {
+		f    *resultlog.Formula
+		want bool
+	}{
+		{resultlog.NewOp("until", b, big), true},
+		{resultlog.NewOp("until", b, resultlog.NewOp(">", a, resultlog.NewConst("10"))), false},
+		{resultlog.NewOp("weak-until", b, resultlog.NewOp("not", b)), true},
+		{resultlog.NewOp("release", big, b), false},
+		{resultlog.NewOp("release", resultlog.NewOp(">", a, resultlog.NewConst("2")), b), true},
+		{resultlog.NewOp("next", resultlog.NewOp("=", a, resultlog.NewConst("3"))), true},
+		{resultlog.NewOp("next", resultlog.NewOp("next", resultlog.NewOp("next", b))), false},
+		{resultlog.NewOp("eventually", big), true},
+		{resultlog.NewOp("always", b), false},
+	}
+
+	for _, test := range tests {
+		got := mc.EvalTemporal(test.f, 0, test.f.Rounds()-1)
+		if got != test.want {
+			t.Errorf("formula %s evaluated wrong. want=%v got=%v", test.f, test.want, got)
+		}
+	}
+}
+
+func TestViolatedWindow(t *testing.T) {
+	mc := NewModelChecker()
+	mc.ResultValues = map[string]string{
+		"test_s_a_0": "1.0",
+		"test_s_a_1": "7.0",
+		"test_s_a_2": "2.0",
+		"test_s_a_3": "2.0",
+		"test_s_b_0": "false",
+		"test_s_b_1": "false",
+		"test_s_b_2": "false",
+		"test_s_b_3": "true",
+	}
+
+	a := resultlog.NewVar([]string{"test_s_a_0", "test_s_a_1", "test_s_a_2", "test_s_a_3"})
+	b := resultlog.NewVar([]string{"test_s_b_0", "test_s_b_1", "test_s_b_2", "test_s_b_3"})
+	trigger := resultlog.NewOp(">", a, resultlog.NewConst("5"))
+
+	// when a > 5 then b within 1
+	f := resultlog.NewOp("always", resultlog.NewOp("=>", trigger, resultlog.NewWindow(0, 1, b)))
+	if mc.EvalTemporal(f, 0, 3) {
+		t.Fatalf("within 1 should be violated")
+	}
+
+	w := mc.ViolatedWindow(f, 0, 3)
+	if w == nil {
+		t.Fatal("violated window not found")
+	}
+	if w.Start != 1 || w.From != 1 || w.To != 2 {
+		t.Fatalf("wrong window. want=1 1 2 got=%d %d %d", w.Start, w.From, w.To)
+	}
+
+	// when a > 5 then b between 2 and 3
+	f = resultlog.NewOp("always", resultlog.NewOp("=>", trigger, resultlog.NewWindow(2, 3, b)))
+	if !mc.EvalTemporal(f, 0, 3) {
+		t.Fatalf("between 2 and 3 should hold")
+	}
+
+	// b after 3 holds, b has to hold from round 1 on for after 1
+	if !mc.EvalTemporal(resultlog.NewWindow(3, -1, b), 0, 3) {
+		t.Fatalf("after 3 should hold")
+	}
+	after := resultlog.NewWindow(1, -1, b)
+	if mc.EvalTemporal(after, 0, 3) {
+		t.Fatalf("after 1 should be violated")
+	}
+	if w := mc.ViolatedWindow(after, 0, 3); w == nil || w.String() != "violated in round 1 (opened in round 0)" {
+		t.Fatalf("after 1 should point to round 1. got=%s", w)
+	}
+	if mc.EvalTemporal(resultlog.NewWindow(0, 2, b), 0, 3) {
+		t.Fatalf("within 2 should be violated")
+	}
+}
+
+func TestFetchViolationsWindow(t *testing.T) {
+	mc := NewModelChecker()
+	mc.ResultValues = map[string]string{
+		"test_s_a_0": "7.0",
+		"test_s_a_1": "1.0",
+		"test_s_a_2": "1.0",
+		"test_s_b_0": "false",
+		"test_s_b_1": "false",
+		"test_s_b_2": "true",
+	}
+
+	a := resultlog.NewVar([]string{"test_s_a_0", "test_s_a_1", "test_s_a_2"})
+	b := resultlog.NewVar([]string{"test_s_b_0", "test_s_b_1", "test_s_b_2"})
+	trigger := resultlog.NewOp(">", a, resultlog.NewConst("5"))
+
+	// when s.a > 5 then s.b within 1
+	mc.Log = resultlog.NewLog()
+	mc.Log.ProcessedAsserts = []*ast.AssertionStatement{{
+		Constraint: &ast.InvariantClause{
+			Left:     &ast.AssertVar{Instances: []string{"test_s_a"}},
+			Operator: "then",
+			Right:    &ast.AssertVar{Instances: []string{"test_s_b"}},
+		},
+		Violated: true,
+	}}
+	mc.Log.Temporal[0] = resultlog.NewOp("always", resultlog.NewOp("=>", trigger, resultlog.NewWindow(0, 1, b)))
+
+	checked, pass := mc.FetchViolations()
+	if pass || len(checked) != 1 || !strings.HasSuffix(checked[0], "violated in rounds 0 to 1 (opened in round 0)") {
+		t.Fatalf("violated window not in the event log. got=%v", checked)
+	}
+}
@@ -0,0 +1,237 @@
+package execute
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fault/util"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Counterexamples as a time series: one row per round and one
+// column per stock/flow property, holding the value in effect
+// at the end of that round. Values in dead branches are dropped
+// before the rows are built so every cell is a value the model
+// actually took.
+
+type TimeSeries struct {
+	Columns []string
+	Types   map[string]string // FLOAT, INT or BOOL
+	Rows    [][]string        // Rows[round][column], empty until the variable is set
+}
+
+// TimeSeries resolves the dead branches in a scenario and
+// lays the surviving values out round by round
+func (mc *ModelChecker) TimeSeries(results map[string]Scenario) *TimeSeries {
+	mc.evaluate(results)
+
+	ts := &TimeSeries{Types: make(map[string]string)}
+	for k, v := range results {
+		if strings.HasPrefix(k, "__") { // Scheduler positions, not part of the model
+			continue
+		}
+
+		switch v.(type) {
+		case *FloatTrace:
+			ts.Types[k] = "FLOAT"
+		case *IntTrace:
+			ts.Types[k] = "INT"
+		case *BoolTrace:
+			ts.Types[k] = "BOOL"
+		default:
+			continue
+		}
+		ts.Columns = append(ts.Columns, k)
+	}
+	sort.Strings(ts.Columns)
+
+	column := make(map[string]int)
+	for i, c := range ts.Columns {
+		column[c] = i
+	}
+
+	for _, e := range mc.Log.Events {
+		if e.Dead || (e.Type != "INIT" && e.Type != "CHANGE") || e.Current == "" {
+			continue
+		}
+
+		base, _ := util.GetVarBase(e.Variable)
+		i, ok := column[base]
+		if !ok {
+			continue
+		}
+
+		for len(ts.Rows) <= e.Round {
+			ts.Rows = append(ts.Rows, make([]string, len(ts.Columns)))
+		}
+		ts.Rows[e.Round][i] = e.Current
+	}
+
+	// Carry values forward through the rounds they don't change in
+	for r := 1; r < len(ts.Rows); r++ {
+		for i, cell := range ts.Rows[r] {
+			if cell == "" {
+				ts.Rows[r][i] = ts.Rows[r-1][i]
+			}
+		}
+	}
+	return ts
+}
+
+// CSV writes a round column followed by a column per variable.
+// With more than one scenario a scenario column comes first.
+func (mc *ModelChecker) CSV(w io.Writer, failures []*Failure) error {
+	out := csv.NewWriter(w)
+	multi := len(failures) > 1
+
+	for n, f := range failures {
+		mc.LoadFailure(f)
+		ts := mc.TimeSeries(f.Results)
+
+		if n == 0 {
+			header := append([]string{"round"}, ts.Columns...)
+			if multi {
+				header = append([]string{"scenario"}, header...)
+			}
+			if err := out.Write(header); err != nil {
+				return err
+			}
+		}
+
+		for r, row := range ts.Rows {
+			record := append([]string{strconv.Itoa(r)}, row...)
+			if multi {
+				record = append([]string{strconv.Itoa(n + 1)}, record...)
+			}
+			if err := out.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
+
+// VCD writes a value change dump with one time step per round
+// that opens in waveform viewers (GTKWave, Surfer). Each
+// scenario gets its own scope.
+func (mc *ModelChecker) VCD(w io.Writer, spec string, failures []*Failure) error {
+	var defs, dump bytes.Buffer
+	var series []*TimeSeries
+	var ids [][]string
+	var rounds int
+
+	defs.WriteString("$version Fault $end\n$timescale 1s $end\n")
+	fmt.Fprintf(&defs, "$scope module %s $end\n", vcdName(spec))
+
+	var next int
+	for n, f := range failures {
+		mc.LoadFailure(f)
+		ts := mc.TimeSeries(f.Results)
+		series = append(series, ts)
+		if len(ts.Rows) > rounds {
+			rounds = len(ts.Rows)
+		}
+
+		if len(failures) > 1 {
+			fmt.Fprintf(&defs, "$scope module scenario_%d $end\n", n+1)
+		}
+
+		var vars []string
+		for _, c := range ts.Columns {
+			id := vcdIdentifier(next)
+			next++
+			vars = append(vars, id)
+
+			switch ts.Types[c] {
+			case "FLOAT":
+				fmt.Fprintf(&defs, "$var real 64 %s %s $end\n", id, vcdName(c))
+			case "INT":
+				fmt.Fprintf(&defs, "$var integer 64 %s %s $end\n", id, vcdName(c))
+			case "BOOL":
+				fmt.Fprintf(&defs, "$var wire 1 %s %s $end\n", id, vcdName(c))
+			}
+		}
+		ids = append(ids, vars)
+
+		if len(failures) > 1 {
+			defs.WriteString("$upscope $end\n")
+		}
+	}
+	defs.WriteString("$upscope $end\n$enddefinitions $end\n")
+
+	// Only changes are dumped, everything starts out unknown
+	for r := 0; r < rounds; r++ {
+		fmt.Fprintf(&dump, "#%d\n", r)
+		for n, ts := range series {
+			if r >= len(ts.Rows) {
+				continue
+			}
+			for i, c := range ts.Columns {
+				v := ts.Rows[r][i]
+				if v == "" || (r > 0 && v == ts.Rows[r-1][i]) {
+					continue
+				}
+				dump.WriteString(vcdValue(ts.Types[c], v, ids[n][i]))
+			}
+		}
+	}
+	fmt.Fprintf(&dump, "#%d\n", rounds)
+
+	if _, err := w.Write(defs.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(dump.Bytes())
+	return err
+}
+
+func vcdValue(ty string, v string, id string) string {
+	switch ty {
+	case "BOOL":
+		if b, err := strconv.ParseBool(v); err == nil && b {
+			return fmt.Sprintf("1%s\n", id)
+		}
+		return fmt.Sprintf("0%s\n", id)
+	case "INT":
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Sprintf("bx %s\n", id)
+		}
+		return fmt.Sprintf("b%b %s\n", uint64(i), id)
+	default:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Sprintf("rnan %s\n", id)
+		}
+		return fmt.Sprintf("r%s %s\n", v, id)
+	}
+}
+
+// vcdIdentifier is the short code a variable goes by in the
+// dump, built from the printable ASCII characters
+func vcdIdentifier(n int) string {
+	var id []byte
+	for {
+		id = append(id, byte('!'+n%94))
+		n = n/94 - 1
+		if n < 0 {
+			break
+		}
+	}
+	return string(id)
+}
+
+func vcdName(s string) string {
+	if s == "" {
+		return "fault"
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, s)
+}
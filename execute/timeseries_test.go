@@ -0,0 +1,117 @@
+package execute
+
+import (
+	"bytes"
+	"fault/smt/forks"
+	resultlog "fault/smt/log"
+	"strings"
+	"testing"
+)
+
+// a_value branches in round 1, the first branch wins so the
+// last value logged that round is a dead one
+func prepTimeSeries() (*ModelChecker, *Failure) {
+	l := resultlog.NewLog()
+	l.Add(resultlog.NewInit(0, "", "a_value_0"))
+	l.Add(resultlog.NewInit(0, "", "b_flag_0"))
+	l.Add(resultlog.NewChange(1, "a_fn", "a_value_1"))
+	l.Add(resultlog.NewChange(1, "a_fn", "a_value_2"))
+	l.Add(resultlog.NewChange(2, "b_fn", "b_flag_1"))
+
+	phis := forks.InitFork()
+	phis.Choices["choice1"] = []string{"branch1", "branch2"}
+	phis.AddVar("branch1", "a_value", "a_value_1", forks.NewVar("a_value", true, "1", "choice1", "3"))
+	phis.AddVar("branch2", "a_value", "a_value_2", forks.NewVar("a_value", true, "2", "choice1", "3"))
+
+	values := NewFloatTrace()
+	values.Add(0, 1.0)
+	values.Add(1, 7.0)
+	values.Add(2, 5.0)
+	values.Add(3, 7.0)
+
+	flags := NewBoolTrace()
+	flags.Add(0, false)
+	flags.Add(1, true)
+
+	mc := NewModelChecker()
+	mc.LoadMeta(phis)
+	f := &Failure{
+		Results: map[string]Scenario{"a_value": values, "b_flag": flags},
+		Values: map[string]string{"a_value_0": "1.0", "a_value_1": "7.0", "a_value_2": "5.0", "a_value_3": "7.0",
+			"b_flag_0": "false", "b_flag_1": "true"},
+		Log: l,
+	}
+	return mc, f
+}
+
+func TestTimeSeries(t *testing.T) {
+	mc, f := prepTimeSeries()
+	mc.LoadFailure(f)
+	ts := mc.TimeSeries(f.Results)
+
+	if len(ts.Columns) != 2 || ts.Columns[0] != "a_value" || ts.Columns[1] != "b_flag" {
+		t.Fatalf("time series columns not correct. got=%s", ts.Columns)
+	}
+
+	if ts.Types["a_value"] != "FLOAT" || ts.Types["b_flag"] != "BOOL" {
+		t.Fatalf("time series types not correct. got=%s", ts.Types)
+	}
+
+	expected := [][]string{{"1", "false"}, {"7", "false"}, {"7", "true"}}
+	if len(ts.Rows) != len(expected) {
+		t.Fatalf("wrong number of rounds. want=%d got=%d", len(expected), len(ts.Rows))
+	}
+	for r, row := range expected {
+		for i, v := range row {
+			if ts.Rows[r][i] != v {
+				t.Fatalf("round %d %s not correct. want=%s got=%s", r, ts.Columns[i], v, ts.Rows[r][i])
+			}
+		}
+	}
+}
+
+func TestCSV(t *testing.T) {
+	mc, f := prepTimeSeries()
+
+	var out bytes.Buffer
+	if err := mc.CSV(&out, []*Failure{f}); err != nil {
+		t.Fatalf("CSV export failed. got=%s", err)
+	}
+
+	expected := "round,a_value,b_flag\n0,1,false\n1,7,false\n2,7,true\n"
+	if out.String() != expected {
+		t.Fatalf("CSV export not correct. want=%s got=%s", expected, out.String())
+	}
+}
+
+func TestVCD(t *testing.T) {
+	mc, f := prepTimeSeries()
+
+	var out bytes.Buffer
+	if err := mc.VCD(&out, "test", []*Failure{f}); err != nil {
+		t.Fatalf("VCD export failed. got=%s", err)
+	}
+
+	expected := []string{
+		"$scope module test $end",
+		"$var real 64 ! a_value $end",
+		"$var wire 1 \" b_flag $end",
+		"$enddefinitions $end",
+		"#0\nr1 !\n0\"\n#1\nr7 !\n#2\n1\"\n#3\n",
+	}
+	for _, e := range expected {
+		if !strings.Contains(out.String(), e) {
+			t.Fatalf("VCD export missing %q. got=%s", e, out.String())
+		}
+	}
+}
+
+func TestVCDIdentifier(t *testing.T) {
+	if vcdIdentifier(0) != "!" || vcdIdentifier(93) != "~" || vcdIdentifier(94) != "!!" {
+		t.Fatalf("VCD identifiers not correct. got=%s %s %s", vcdIdentifier(0), vcdIdentifier(93), vcdIdentifier(94))
+	}
+
+	if vcdValue("INT", "-1", "!") != "b1111111111111111111111111111111111111111111111111111111111111111 !\n" {
+		t.Fatalf("negative integer not in two's complement. got=%s", vcdValue("INT", "-1", "!"))
+	}
+}
@@ -0,0 +1,135 @@
+package fault
+
+import (
+	"errors"
+	"fault/ast"
+	"fault/listener"
+	"fmt"
+)
+
+type Stage string
+
+const (
+	StageParse        Stage = "parse"
+	StagePreprocess   Stage = "preprocess"
+	StageTypes        Stage = "types"
+	StageSwaps        Stage = "swaps"
+	StageReachability Stage = "reachability"
+	StageLLVM         Stage = "llvm"
+	StageSMT          Stage = "smt"
+	StageExecute      Stage = "execute"
+)
+
+// Error is returned by every stage of the pipeline. Line and
+// Col are zero when the stage could not place the problem
+// in the spec.
+type Error struct {
+	Stage   Stage
+	File    string
+	Line    int
+	Col     int
+	Msg     string
+	Details string // Every syntax error found, with excerpts
+	Err     error
+}
+
+func (e *Error) Error() string {
+	var msg string
+	if e.Line == 0 {
+		msg = fmt.Sprintf("%s: %s", e.Stage, e.Msg)
+	} else {
+		msg = fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Col, e.Stage, e.Msg)
+	}
+
+	if e.Details != "" {
+		msg = fmt.Sprintf("%s\n%s", msg, e.Details)
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(stage Stage, file string, err error) *Error {
+	e := &Error{Stage: stage, File: file, Msg: err.Error(), Err: err}
+
+	var all listener.SyntaxErrors
+	if errors.As(err, &all) && len(all) > 0 {
+		e.File, e.Line, e.Col = all[0].Filename, all[0].Line, all[0].Column
+		e.Msg = fmt.Sprintf("%d syntax error(s)", len(all))
+		e.Details = all.Error()
+		return e
+	}
+
+	var syntax *listener.SyntaxError
+	if errors.As(err, &syntax) {
+		e.Line, e.Col = syntax.Line, syntax.Column
+		return e
+	}
+
+	var pos *ast.Error
+	if errors.As(err, &pos) {
+		e.Line, e.Col, e.Msg = pos.Line(), pos.Col(), pos.Msg
+	}
+	return e
+}
+
+// Diagnostic is the machine readable form of an error
+type Diagnostic struct {
+	File      string   `json:"file"`
+	Line      int      `json:"line"`
+	Column    int      `json:"column"`
+	EndColumn int      `json:"end_column"`
+	Stage     Stage    `json:"stage"`
+	Severity  string   `json:"severity"`
+	Message   string   `json:"message"`
+	Expected  []string `json:"expected,omitempty"`
+	Excerpt   string   `json:"excerpt,omitempty"`
+}
+
+// Diagnostics flattens an error from Compile, one entry
+// per syntax error or a single entry for other failures
+func Diagnostics(err error) []*Diagnostic {
+	var diags []*Diagnostic
+	if err == nil {
+		return diags
+	}
+
+	var all listener.SyntaxErrors
+	if errors.As(err, &all) {
+		for _, s := range all {
+			diags = append(diags, syntaxDiagnostic(s))
+		}
+		return diags
+	}
+
+	var syntax *listener.SyntaxError
+	if errors.As(err, &syntax) {
+		return append(diags, syntaxDiagnostic(syntax))
+	}
+
+	d := &Diagnostic{Severity: "error", Message: err.Error()}
+	var e *Error
+	if errors.As(err, &e) {
+		d.File, d.Line, d.Column, d.Stage, d.Message = e.File, e.Line, e.Col, e.Stage, e.Msg
+		if e.Line != 0 {
+			d.EndColumn = e.Col + 1
+		}
+	}
+	return append(diags, d)
+}
+
+func syntaxDiagnostic(s *listener.SyntaxError) *Diagnostic {
+	return &Diagnostic{
+		File:      s.Filename,
+		Line:      s.Line,
+		Column:    s.Column,
+		EndColumn: s.EndColumn,
+		Stage:     StageParse,
+		Severity:  "error",
+		Message:   s.Msg,
+		Expected:  s.Expected,
+		Excerpt:   s.Excerpt,
+	}
+}
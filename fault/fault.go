@@ -0,0 +1,439 @@
+// Package fault runs the whole Fault pipeline (parse, preprocess,
+// types, swaps, llvm, smt and optionally the model checker) for
+// programs that embed Fault. Nothing here exits the process,
+// every stage reports problems as an *Error.
+package fault
+
+import (
+	"context"
+	"fault/ast"
+	"fault/execute"
+	"fault/listener"
+	"fault/llvm"
+	"fault/preprocess"
+	"fault/reachability"
+	"fault/smt"
+	"fault/swaps"
+	"fault/types"
+	"fault/visualize"
+	"fmt"
+	gopath "path"
+	"strings"
+)
+
+type Options struct {
+	Filename  string // Used to resolve imports and in errors
+	Type      string // fspec or fsystem, detected from the source if empty
+	Reach     bool   // Check that every state is reachable
+	Visualize bool   // Render the spec as a diagram
+	Stop      Stage  // Last stage to run, empty runs through smt
+	Check     bool   // Run the model checker on the generated SMT
+	Solver    string // Solver backend, see execute.SolverNames
+	Scenarios int    // Distinct failure scenarios to search for
+	Explain   bool   // Explain with an unsat core when Check finds no failures
+	Likely    bool   // Search for the most probable failures given the uncertain values
+	Deepen    bool   // Check one round at a time and stop at the shortest failure
+
+	// Values swapped into the spec before it's compiled
+	Overrides []*Override
+
+	// Times the run block runs, the number in the spec if 0
+	Rounds int
+
+	// Called with each round that holds when Deepen is set,
+	// before the next round is checked
+	Checked func(round int)
+
+	// Search for the unknown values where every assert holds
+	// instead of a failure, in [-1000, 1000] if Synthesis is nil
+	Synthesize bool
+	Synthesis  *execute.SynthesisOptions
+
+	// Samples drawn for prob() asserts when Check is set, 1000
+	// runs with seed 1 if nil
+	Sampling *execute.SimulationOptions
+
+	// Encode parallel steps with scheduler variables the solver
+	// sets instead of a branch for every ordering
+	SymbolicInterleaving bool
+}
+
+type Result struct {
+	Listener     *listener.FaultListener
+	AST          *ast.Spec // As parsed
+	Spec         *ast.Spec // Type checked with swaps applied
+	Checker      *types.Checker
+	Alias        map[string]string
+	Visual       string
+	Compiler     *llvm.Compiler
+	Generator    *smt.Generator
+	ModelChecker *execute.ModelChecker
+	Failures     []*execute.Failure
+	Explanation  *execute.Explanation // Why there are no failures, if Explain is set
+	Verdicts     []*execute.Verdict   // prob() asserts, in spec order
+	Optima       []*execute.Optimum   // maximize and minimize queries, in spec order
+	Deepening    *execute.Deepening   // Rounds checked, if Deepen is set
+	Envelope     *execute.Envelope    // Safe unknown values, if Synthesize is set
+}
+
+// IsValid is false if the spec has nothing to run
+// (no run block or start block)
+func (r *Result) IsValid() bool {
+	return r.Compiler != nil && r.Compiler.IsValid
+}
+
+func (r *Result) IR() string {
+	if r.Compiler == nil {
+		return ""
+	}
+	return r.Compiler.GetIR()
+}
+
+func (r *Result) SMT() string {
+	if r.Generator == nil {
+		return ""
+	}
+	return r.Generator.SMT()
+}
+
+func Compile(ctx context.Context, source string, opts *Options) (*Result, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	c := &compilation{ctx: ctx, opts: opts, source: source, result: &Result{}}
+	return c.run()
+}
+
+func (c *compilation) run() (*Result, error) {
+	opts := c.opts
+	stages := []struct {
+		name Stage
+		run  func() error
+	}{
+		{StageParse, c.parse},
+		{StagePreprocess, c.preprocess},
+		{StageTypes, c.types},
+		{StageSwaps, c.swaps},
+		{StageReachability, c.reachability},
+		{StageLLVM, c.llvm},
+		{StageSMT, c.smt},
+		{StageExecute, c.execute},
+	}
+
+	for _, s := range stages {
+		if err := c.stage(s.name, s.run); err != nil {
+			return c.result, err
+		}
+
+		if s.name == opts.Stop || (s.name == StageLLVM && !c.result.IsValid()) {
+			break
+		}
+	}
+	return c.result, nil
+}
+
+type compilation struct {
+	ctx    context.Context
+	opts   *Options
+	result *Result
+	source string
+	pre    *preprocess.Processor
+
+	rounds    int  // Overrides the number of run rounds if set
+	induction bool // Generate the inductive step instead of a bounded run
+}
+
+// stage runs one step of the pipeline and tags its error
+// with the stage. The stages return errors for what they check
+// for, anything else they panic on is turned into an error too
+// so a bad spec can't take down the program embedding Compile.
+func (c *compilation) stage(s Stage, run func() error) (err error) {
+	if err := c.ctx.Err(); err != nil {
+		return &Error{Stage: s, File: c.opts.Filename, Msg: err.Error(), Err: err}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				e = fmt.Errorf("%v", r)
+			}
+			err = newError(s, c.opts.Filename, e)
+		}
+	}()
+
+	if err := run(); err != nil {
+		return newError(s, c.opts.Filename, err)
+	}
+	return nil
+}
+
+func (c *compilation) parse() error {
+	specType, err := c.specType()
+	if err != nil {
+		return err
+	}
+
+	flags := make(map[string]bool)
+	flags["specType"] = specType
+	flags["testing"] = false
+	flags["skipRun"] = false
+
+	var path string
+	if c.opts.Filename != "" {
+		path = gopath.Dir(c.opts.Filename)
+	}
+
+	l, err := listener.Parse(c.source, path, c.opts.Filename, flags)
+	if err != nil {
+		return err
+	}
+	c.result.Listener = l
+	c.result.AST = l.AST
+	return c.override()
+}
+
+func (c *compilation) preprocess() error {
+	l := c.result.Listener
+	c.pre = preprocess.NewProcesser()
+	c.pre.StructsPropertyOrder = l.StructsPropertyOrder
+	if _, err := c.pre.Run(l.AST); err != nil {
+		return err
+	}
+	return c.checkOverrides()
+}
+
+func (c *compilation) types() error {
+	ty := types.NewTypeChecker(c.pre)
+	tree, err := ty.Check(c.pre.Processed)
+	if err != nil {
+		return err
+	}
+	ty.Checked = tree
+	c.result.Checker = ty
+	return nil
+}
+
+func (c *compilation) swaps() error {
+	ty := c.result.Checker
+	sw := swaps.NewPrecompiler(ty)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		return err
+	}
+	c.result.Spec = tree
+	c.result.Alias = sw.Alias
+
+	if c.opts.Visualize {
+		vis := visualize.NewVisual(ty.Checked)
+		vis.Build()
+		c.result.Visual = vis.Render()
+	}
+	return nil
+}
+
+func (c *compilation) reachability() error {
+	if !c.opts.Reach {
+		return nil
+	}
+	r := reachability.NewTracer()
+	return r.Scan(c.result.Checker.Checked)
+}
+
+func (c *compilation) llvm() error {
+	l := c.result.Listener
+	compiler := llvm.NewCompiler()
+	compiler.LoadMeta(c.result.Checker.SpecStructs, l.Uncertains, l.Unknowns, c.result.Alias, false)
+	c.result.Compiler = compiler
+	if c.rounds > 0 {
+		setRounds(c.result.Spec, c.rounds)
+	} else if c.opts.Rounds > 0 {
+		setRounds(c.result.Spec, c.opts.Rounds)
+	}
+	return compiler.Compile(c.result.Spec)
+}
+
+func (c *compilation) smt() error {
+	g := smt.NewGenerator()
+	g.SymbolicInterleaving = c.opts.SymbolicInterleaving
+	g.Induction = c.induction
+	g.Deepening = c.opts.Deepen && !c.induction
+	g.NamedTerms = c.opts.Explain
+	if err := g.Generate(c.result.Compiler); err != nil {
+		return err
+	}
+	c.result.Generator = g
+	return nil
+}
+
+func (c *compilation) execute() error {
+	if !c.opts.Check {
+		return nil
+	}
+	if c.opts.Synthesize {
+		return c.synthesize()
+	}
+
+	mc := execute.NewModelChecker()
+	mc.SetContext(c.ctx)
+	if err := mc.UseSolver(c.opts.Solver); err != nil {
+		return err
+	}
+	defer mc.Close()
+	mc.Likely = c.opts.Likely
+
+	g := c.result.Generator
+	model := g.SMT()
+	if g.Deepening { // The rounds are added as they're checked
+		model = g.Prefix()
+	}
+	mc.LoadModel(model, c.result.Compiler.Uncertains, c.result.Compiler.Unknowns, g.Results, g.Log)
+	mc.LoadMeta(g.Forks)
+	c.result.ModelChecker = mc
+
+	// Like the CLI, a spec whose only asserts are prob() bounds
+	// and optimization queries has nothing for the solver to
+	// violate, every run would come back as a failure
+	if len(g.Log.ProcessedAsserts) == 0 && (len(g.Log.Probabilities) > 0 || len(g.Log.Objectives) > 0) {
+		if err := c.probabilities(); err != nil {
+			return err
+		}
+		return c.objectives()
+	}
+
+	if g.Deepening {
+		return c.deepen()
+	}
+
+	n := c.opts.Scenarios
+	if n < 1 {
+		n = 1
+	}
+
+	failures, err := mc.Scenarios(n)
+	c.result.Failures = failures
+	if err != nil {
+		return err
+	}
+
+	if len(failures) == 0 && c.opts.Explain {
+		if c.result.Explanation, err = mc.Explain(g.Model()); err != nil {
+			return err
+		}
+	}
+	if err := c.probabilities(); err != nil {
+		return err
+	}
+	return c.objectives()
+}
+
+// deepen checks the rounds in order, the failure it finds
+// (if any) is the only one in Failures
+func (c *compilation) deepen() error {
+	d, err := c.result.ModelChecker.Deepen(c.opts.Checked)
+	c.result.Deepening = d
+	if d != nil && d.Failure != nil {
+		c.result.Failures = []*execute.Failure{d.Failure}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := c.probabilities(); err != nil {
+		return err
+	}
+	return c.objectives()
+}
+
+// probabilities checks the prob() asserts on a model checker of
+// their own, the model without the other asserts
+func (c *compilation) probabilities() error {
+	g := c.result.Generator
+	if len(g.Log.Probabilities) == 0 {
+		return nil
+	}
+
+	mc := execute.NewModelChecker()
+	mc.SetContext(c.ctx)
+	if err := mc.UseSolver(c.opts.Solver); err != nil {
+		return err
+	}
+	defer mc.Close()
+
+	opts := c.opts.Sampling
+	if opts == nil {
+		opts = &execute.SimulationOptions{Samples: 1000, Seed: 1}
+	}
+
+	mc.LoadModel(g.Model(), c.result.Compiler.Uncertains, c.result.Compiler.Unknowns, g.Results, g.Log)
+	verdicts, err := mc.CheckProbabilities(opts)
+	c.result.Verdicts = verdicts
+	return err
+}
+
+// objectives answers the maximize and minimize queries on the
+// model without the asserts
+func (c *compilation) objectives() error {
+	g := c.result.Generator
+	if len(g.Log.Objectives) == 0 {
+		return nil
+	}
+
+	mc := execute.NewModelChecker()
+	mc.SetContext(c.ctx)
+	if err := mc.UseSolver(c.opts.Solver); err != nil {
+		return err
+	}
+	defer mc.Close()
+
+	mc.LoadModel(g.Model(), c.result.Compiler.Uncertains, c.result.Compiler.Unknowns, g.Results, g.Log)
+	mc.LoadMeta(g.Forks)
+	optima, err := mc.OptimizeAll()
+	c.result.Optima = optima
+	return err
+}
+
+// synthesize looks for the unknown values where the asserts
+// hold, on the model without the asserts
+func (c *compilation) synthesize() error {
+	g := c.result.Generator
+	mc := execute.NewModelChecker()
+	mc.SetContext(c.ctx)
+	if err := mc.UseSolver(c.opts.Solver); err != nil {
+		return err
+	}
+	defer mc.Close()
+
+	opts := c.opts.Synthesis
+	if opts == nil {
+		opts = &execute.SynthesisOptions{Low: -1000, High: 1000}
+	}
+
+	mc.LoadModel(g.Model(), c.result.Compiler.Uncertains, c.result.Compiler.Unknowns, g.Results, g.Log)
+	mc.LoadMeta(g.Forks)
+	c.result.ModelChecker = mc
+	env, err := mc.Synthesize(opts)
+	c.result.Envelope = env
+	return err
+}
+
+// specType confirms the declaration matches the type asked
+// for, returns true for fspec and false for fsystem
+func (c *compilation) specType() (bool, error) {
+	source := strings.TrimLeft(c.source, " \t\r\n")
+	var declared string
+	switch {
+	case strings.HasPrefix(source, "spec"):
+		declared = "fspec"
+	case strings.HasPrefix(source, "system"):
+		declared = "fsystem"
+	default:
+		return false, fmt.Errorf("malformatted file: missing spec or system declaration")
+	}
+
+	if c.opts.Type != "" && c.opts.Type != declared {
+		return false, fmt.Errorf("malformatted file: declaration does not match filetype")
+	}
+	return declared == "fspec", nil
+}
@@ -0,0 +1,553 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fault/ast"
+	"fault/execute"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const simple = `spec simple;
+
+def st = stock{
+    value: 30,
+};
+
+def fl = flow{
+    vault: new st,
+    fn: func{
+        if vault.value > 4 {
+           vault.value <- vault.value - 2;
+        }
+    },
+};
+
+for 1 init{l = new fl;} run {
+    l.fn;
+}`
+
+func TestCompile(t *testing.T) {
+	res, err := Compile(context.Background(), simple, &Options{Filename: "simple.fspec", Type: "fspec"})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	if !res.IsValid() {
+		t.Fatal("compiled spec not valid")
+	}
+
+	if !strings.Contains(res.SMT(), "(declare-fun simple_l_vault_value_0 () Real)") {
+		t.Fatalf("compiled SMT not correct. got=%s", res.SMT())
+	}
+
+	if res.ModelChecker != nil {
+		t.Fatal("model checker ran without Check")
+	}
+}
+
+func TestCompileStop(t *testing.T) {
+	res, err := Compile(context.Background(), simple, &Options{Stop: StageParse})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	if res.AST == nil || res.Checker != nil || res.Compiler != nil {
+		t.Fatalf("compile did not stop after parsing. got=%+v", res)
+	}
+}
+
+func TestSyntaxError(t *testing.T) {
+	test := `spec test1;
+	const a = ;
+	`
+	_, err := Compile(context.Background(), test, &Options{Filename: "test1.fspec"})
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("syntax error not returned as *Error. got=%v", err)
+	}
+
+	if e.Stage != StageParse || e.Line != 2 || e.Col != 11 || e.File != "test1.fspec" {
+		t.Fatalf("syntax error not correct. got=%+v", e)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	_, err := Compile(context.Background(), "spec test1;", nil)
+
+	var e *Error
+	if !errors.As(err, &e) || e.Stage != StageParse {
+		t.Fatalf("malformed spec did not return a parse error. got=%v", err)
+	}
+}
+
+func TestTypeMismatch(t *testing.T) {
+	_, err := Compile(context.Background(), simple, &Options{Type: "fsystem"})
+
+	var e *Error
+	if !errors.As(err, &e) || !strings.Contains(e.Msg, "declaration does not match") {
+		t.Fatalf("declaration mismatch not caught. got=%v", err)
+	}
+}
+
+func TestStageError(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.x <- 2;
+		},
+	};
+	for 1 init{l = new f;} run {
+		l.fn;
+	}`
+	_, err := Compile(context.Background(), test, nil)
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("invalid spec did not return *Error. got=%v", err)
+	}
+
+	if e.Stage == StageParse {
+		t.Fatalf("error reported at the wrong stage. got=%+v", e)
+	}
+}
+
+func TestStagePanic(t *testing.T) {
+	c := &compilation{ctx: context.Background(), opts: &Options{Filename: "test1.fspec"}, result: &Result{}}
+	err := c.stage(StageSMT, func() error {
+		panic("illegal node *ast.Nil in temporal assert or assume line: 4, col: 2")
+	})
+
+	var e *Error
+	if !errors.As(err, &e) || e.Stage != StageSMT || !strings.Contains(e.Msg, "illegal node") {
+		t.Fatalf("panic in a stage not returned as *Error. got=%v", err)
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Compile(ctx, simple, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled context not returned. got=%v", err)
+	}
+}
+
+func TestErrorPosition(t *testing.T) {
+	e := newError(StageLLVM, "test.fspec", fmt.Errorf("compiling: %w", ast.Errorf([]int{12, 4, 8}, "unknown value type")))
+	if e.Line != 12 || e.Col != 4 || e.Msg != "unknown value type" {
+		t.Fatalf("position not taken from error. got=%d:%d %s", e.Line, e.Col, e.Msg)
+	}
+
+	e = newError(StageSMT, "test.fspec", errors.New("no position here"))
+	if e.Line != 0 || e.Error() != "smt: no position here" {
+		t.Fatalf("error without position not correct. got=%s", e)
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	test := `spec test1;
+	const a = ;
+	const b = 2 +;
+	`
+	_, err := Compile(context.Background(), test, &Options{Filename: "test1.fspec"})
+
+	diags := Diagnostics(err)
+	if len(diags) != 2 {
+		t.Fatalf("wrong number of diagnostics. want=2 got=%d", len(diags))
+	}
+
+	if diags[1].Line != 3 || diags[1].Stage != StageParse || diags[1].Excerpt == "" {
+		t.Fatalf("diagnostic not correct. got=%+v", diags[1])
+	}
+
+	diags = Diagnostics(newError(StageLLVM, "test.fspec", ast.Errorf([]int{12, 4, 8}, "unknown value type")))
+	if len(diags) != 1 || diags[0].Line != 12 || diags[0].Stage != StageLLVM {
+		t.Fatalf("diagnostic from stage error not correct. got=%+v", diags[0])
+	}
+}
+
+func TestStageErrorPosition(t *testing.T) {
+	for _, test := range []struct {
+		spec      string
+		stage     Stage
+		line, col int
+		msg       string
+	}{
+		{strings.Replace(simple, "l.fn;", "l.fn2;", 1), StagePreprocess, 17, 4, "no property named fn2 in flow l"},
+		{strings.Replace(simple, "for 1", "assert st.value[9] > 2;\nfor 1", 1), StageSMT, 16, 7, "state 9 of variable simple_l_vault_value is missing"},
+	} {
+		_, err := Compile(context.Background(), test.spec, &Options{Filename: "simple.fspec"})
+
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Fatalf("invalid spec did not return *Error. got=%v", err)
+		}
+
+		if e.Stage != test.stage || e.Line != test.line || e.Col != test.col || e.Msg != test.msg {
+			t.Fatalf("error not placed in the spec. got=%+v", e)
+		}
+	}
+}
+
+const invariant = `spec test1;
+def s = stock{
+	a: 30,
+};
+def f = flow{
+	data: new s,
+	fn: func{
+		if data.a > 4 {
+			data.a <- data.a - 2;
+		}
+	},
+};
+assert s.a >= 0;
+for 1 init{l = new f;} run {
+	l.fn;
+}`
+
+const scheduled = `spec test1;
+def s = stock{
+	a: 0,
+	b: 0,
+};
+def f = flow{
+	data: new s,
+	copy: func{
+		data.b <- data.a;
+	},
+	inc: func{
+		data.a <- data.a + 10;
+	},
+};
+assert s.b < 10;
+for 1 init{x = new f;} run {
+	x.copy | x.inc;
+}`
+
+func TestScheduledCounterexample(t *testing.T) {
+	res, err := Compile(context.Background(), scheduled, &Options{Check: true, SymbolicInterleaving: true})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	// Only running inc before copy moves 10 into b
+	if len(res.Failures) == 0 {
+		t.Fatalf("counterexample not found. got=%s", res.SMT())
+	}
+
+	b, err := strconv.ParseFloat(res.Failures[0].Values["test1_x_data_b_2"], 64)
+	if err != nil || b != 10 {
+		t.Fatalf("counterexample not correct. got=%v", res.Failures[0].Values)
+	}
+}
+
+// stubSolver registers script as the env solver. Each check
+// gets a fresh process.
+func stubSolver(t *testing.T, script string) {
+	path := filepath.Join(t.TempDir(), "solver")
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SOLVERCMD", path)
+	t.Setenv("SOLVERARG", "")
+}
+
+func TestCompileExplain(t *testing.T) {
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*produce-unsat-cores*) unsat=1 ;;
+*check-sat*) if [ -n "$unsat" ]; then echo unsat; else echo sat; fi ;;
+*get-unsat-core*) echo "(__init_0 __assert_3)" ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	res, err := Compile(context.Background(), invariant, &Options{Check: true, Explain: true, Solver: "env"})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	if !strings.Contains(res.SMT(), ":named __init_0") {
+		t.Fatalf("terms not named with Explain. got=%s", res.SMT())
+	}
+
+	ex := res.Explanation
+	if ex == nil || ex.Vacuous || len(ex.Causes) != 1 || ex.Causes[0].Desc != "initial value of test1_l_data_a" || ex.Causes[0].Line != 3 {
+		t.Fatalf("explanation not correct. got=%+v", ex)
+	}
+}
+
+func TestProve(t *testing.T) {
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*check-sat*) echo unsat ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	proof, err := Prove(context.Background(), invariant, &Options{Solver: "env"}, 3)
+	if err != nil {
+		t.Fatalf("prove failed on valid spec. got=%s", err)
+	}
+
+	if proof.Outcome != Proved || proof.K != 1 || proof.String() != "proved for all rounds (k=1)" {
+		t.Fatalf("proof not correct. got=%s", proof)
+	}
+}
+
+func TestProveInconclusive(t *testing.T) {
+	// Only the inductive step assumes the asserts held
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*"(assert (not"*) step=1 ;;
+esac
+case "$line" in
+*check-sat*) if [ -n "$step" ]; then echo sat; else echo unsat; fi ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	proof, err := Prove(context.Background(), invariant, &Options{Solver: "env"}, 2)
+	if err != nil {
+		t.Fatalf("prove failed on valid spec. got=%s", err)
+	}
+
+	if proof.Outcome != Inconclusive || proof.K != 2 || proof.String() != "inconclusive at k=2" {
+		t.Fatalf("proof not correct. got=%s", proof)
+	}
+}
+
+func TestProofString(t *testing.T) {
+	proof := &Proof{Outcome: Counterexample, K: 3}
+	if proof.String() != "counterexample within k=3 rounds" {
+		t.Fatalf("counterexample not described correctly. got=%s", proof)
+	}
+}
+
+func TestProveInvalid(t *testing.T) {
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*check-sat*) echo unsat ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	if _, err := Prove(context.Background(), simple, &Options{Solver: "env"}, 1); err == nil {
+		t.Fatal("spec without asserts did not fail")
+	}
+
+	temporal := strings.Replace(invariant, "assert s.a >= 0;", "assert s.a >= 0 eventually;", 1)
+	if _, err := Prove(context.Background(), temporal, &Options{Solver: "env"}, 1); err == nil {
+		t.Fatal("temporal assert did not fail")
+	}
+
+	if _, err := Prove(context.Background(), invariant, nil, 0); err == nil {
+		t.Fatal("k of 0 did not fail")
+	}
+}
+
+const ltl = `spec test1;
+def s = stock{
+	a: 30,
+	b: 2,
+};
+def f = flow{
+	data: new s,
+	fn: func{
+		data.a <- data.a - data.b;
+	},
+};
+assert s.a > 25 || s.b < 27;
+for 3 init{l = new f;} run {
+	l.fn;
+}`
+
+func TestCompileLTL(t *testing.T) {
+	for _, test := range []struct {
+		assert string
+		smt    []string
+	}{
+		{"s.a > 25 until s.b < 27", []string{
+			"(assert (= __ltl_0_2 (< test1_l_data_b_0 27)))",
+			"(assert (= __ltl_0_1 (or (< test1_l_data_b_0 27) (and (> test1_l_data_a_2 25) __ltl_0_2))))",
+			"(assert (not __ltl_0_0))",
+		}},
+		{"(next s.a > 25) && s.b < 27", []string{
+			"(assert (= __ltl_0_0 (> test1_l_data_a_2 25)))",
+			"(assert (= __ltl_0_2 false))",
+			"(assert (not (and __ltl_0_0 (< test1_l_data_b_0 27))))",
+		}},
+		{"when s.a > 25 then s.a > 25 release s.b < 27", []string{
+			"(assert (= __ltl_1_2 (< test1_l_data_b_0 27)))",
+			"(assert (= __ltl_0_2 (=> (> test1_l_data_a_3 25) __ltl_1_2)))",
+			"(assert (= __ltl_0_0 (and (=> (> test1_l_data_a_1 25) __ltl_1_0) __ltl_0_1)))",
+		}},
+		{"s.a > 25 weak-until (always s.b < 27)", []string{
+			"(assert (= __ltl_1_2 (< test1_l_data_b_0 27)))",
+			"(assert (= __ltl_0_2 (or __ltl_1_2 (> test1_l_data_a_3 25))))",
+			"(assert (not __ltl_0_0))",
+		}},
+	} {
+		spec := strings.Replace(ltl, "s.a > 25 || s.b < 27", test.assert, 1)
+		res, err := Compile(context.Background(), spec, &Options{Filename: "ltl.fspec"})
+		if err != nil {
+			t.Fatalf("compile failed on %s. got=%s", test.assert, err)
+		}
+
+		for _, e := range test.smt {
+			if !strings.Contains(res.SMT(), e) {
+				t.Fatalf("%s not encoded correctly, missing %s. got=%s", test.assert, e, res.SMT())
+			}
+		}
+	}
+}
+
+func TestParseLTL(t *testing.T) {
+	spec := strings.Replace(ltl, "s.a > 25 || s.b < 27", "(next s.a > 25 until s.b < 27) || eventually s.b > 2", 1)
+	res, err := Compile(context.Background(), spec, &Options{Stop: StageParse})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	var assert *ast.AssertionStatement
+	for _, s := range res.AST.Statements {
+		if a, ok := s.(*ast.AssertionStatement); ok {
+			assert = a
+		}
+	}
+
+	// next binds tighter than until, both looser than the comparisons
+	l, r := assert.Constraint.Left.String(), assert.Constraint.Right.String()
+	if l != "((next (s.a > 25)) until (s.b < 27))" || r != "(eventually (s.b > 2))" || !assert.IsLTL() {
+		t.Fatalf("temporal operators not nested correctly. got=%s", assert.Constraint)
+	}
+}
+
+func TestCompileWindow(t *testing.T) {
+	for _, test := range []struct {
+		assert string
+		smt    string
+	}{
+		{"s.a > 25 within 2", "(assert (not (= (or (> test1_l_data_a_1 25) (> test1_l_data_a_2 25) (> test1_l_data_a_3 25)) true)))"},
+		{"s.a > 25 after 1", "(assert (not (= (and (> test1_l_data_a_2 25) (> test1_l_data_a_3 25)) true)))"},
+		{"when s.a > 25 then s.b < 27 between 1 and 2", "(assert (= __ltl_0_0 (and (=> (> test1_l_data_a_1 25) (or (< test1_l_data_b_0 27) (< test1_l_data_b_0 27))) __ltl_0_1)))"},
+	} {
+		spec := strings.Replace(ltl, "s.a > 25 || s.b < 27", test.assert, 1)
+		res, err := Compile(context.Background(), spec, &Options{Filename: "window.fspec"})
+		if err != nil {
+			t.Fatalf("compile failed on %s. got=%s", test.assert, err)
+		}
+
+		if !strings.Contains(res.SMT(), test.smt) {
+			t.Fatalf("%s not encoded correctly, missing %s. got=%s", test.assert, test.smt, res.SMT())
+		}
+	}
+
+	for _, test := range []struct {
+		assert string
+		err    string
+	}{
+		{"s.a > 25 between 2 and 1", "window between 2 and 1 closes before it opens"},
+		{"s.a > 25 within 10", "within window needs round 10 but the last round is 2"},
+		{"s.a > 25 after 3", "after window needs round 3 but the last round is 2"},
+	} {
+		spec := strings.Replace(ltl, "s.a > 25 || s.b < 27", test.assert, 1)
+		_, err := Compile(context.Background(), spec, &Options{Filename: "window.fspec"})
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Fatalf("%s not rejected. got=%v", test.assert, err)
+		}
+	}
+}
+
+func TestCompileProbability(t *testing.T) {
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*check-sat*) echo sat ;;
+*get-model*) echo "(model (define-fun fault_probe () Real 2.0))" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	spec := strings.Replace(ltl, "s.a > 25 || s.b < 27", "prob(s.a > 25) < 0.01", 1)
+	res, err := Compile(context.Background(), spec, &Options{Filename: "prob.fspec", Check: true, Solver: "env"})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	if strings.Contains(res.SMT(), "(assert (not") {
+		t.Fatalf("prob() assert should not be checked by the solver. got=%s", res.SMT())
+	}
+
+	// Nothing else to check, the prob() bound is the only result
+	if len(res.Failures) != 0 {
+		t.Fatalf("spec with only a prob() assert should have no failures. got=%d", len(res.Failures))
+	}
+
+	if len(res.Verdicts) != 1 {
+		t.Fatalf("wrong number of verdicts. want=1 got=%d", len(res.Verdicts))
+	}
+
+	v := res.Verdicts[0]
+	if v.Outcome != execute.NotMet || v.Samples != 1 || v.Line != 12 {
+		t.Fatalf("verdict not correct. got=%+v", v)
+	}
+}
+
+func TestCompileOptimize(t *testing.T) {
+	stubSolver(t, `while IFS= read -r line || [ -n "$line" ]; do
+case "$line" in
+*fault_probe*Real*) probe=1 ;;
+*check-sat*) echo sat ;;
+*get-objectives*) echo "(objectives"; echo " (__objective_0 30.0)"; echo ")" ;;
+*get-model*)
+	if [ -n "$probe" ]; then echo "(model (define-fun fault_probe () Real 2.0))"; continue; fi
+	echo "(model"
+	echo "  (define-fun test1_l_data_a_1 () Real 28.0)"
+	echo "  (define-fun __objective_0 () Real 28.0)"
+	echo ")" ;;
+*echo*) echo "$line" | sed 's/.*(echo \(.*\))/\1/' ;;
+*"(exit)"*) exit 0 ;;
+esac
+done`)
+
+	spec := strings.Replace(ltl, "assert s.a > 25 || s.b < 27;", "minimize s.a at 0;", 1)
+	res, err := Compile(context.Background(), spec, &Options{Filename: "optimize.fspec", Check: true, Solver: "env"})
+	if err != nil {
+		t.Fatalf("compile failed on valid spec. got=%s", err)
+	}
+
+	expected := "(assert (= __objective_0 test1_l_data_a_1))"
+	o := res.Generator.Log.Objectives
+	if len(o) != 1 || o[0].Query != "minimize s.a at 0;" || o[0].Direction != "minimize" || o[0].Rules[1] != expected {
+		t.Fatalf("query not parsed correctly. got=%+v", o)
+	}
+
+	if len(res.Failures) != 0 {
+		t.Fatalf("spec with only a query should have no failures. got=%d", len(res.Failures))
+	}
+
+	if len(res.Optima) != 1 || res.Optima[0].Value != "28.0" || res.Optima[0].Unbounded {
+		t.Fatalf("query not answered. got=%+v", res.Optima)
+	}
+
+	spec = strings.Replace(ltl, "assert s.a > 25 || s.b < 27;", "maximize s.a at 9;", 1)
+	_, err = Compile(context.Background(), spec, &Options{Filename: "optimize.fspec"})
+	if err == nil || !strings.Contains(err.Error(), "maximize asks for round 9 but the last round is 2") {
+		t.Fatalf("query past the last round not rejected. got=%v", err)
+	}
+}
@@ -0,0 +1,256 @@
+package fault
+
+import (
+	"fault/ast"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Overrides change a number in the spec before it's compiled,
+// so the same spec can be checked with different values without
+// editing it. A constant has its value replaced, a property of
+// an instance in the run block gets a swap, the same as setting
+// it in the init block. Names without a spec belong to the spec
+// being compiled.
+
+type Override struct {
+	Name  string // constant, instance.property, spec.constant or spec.instance.property
+	Value float64
+}
+
+func (o *Override) String() string {
+	return fmt.Sprintf("%s=%v", o.Name, o.Value)
+}
+
+func (c *compilation) override() error {
+	if c.opts.Rounds < 0 {
+		return fmt.Errorf("cannot run %d rounds, rounds must not be negative", c.opts.Rounds)
+	}
+	if c.opts.Rounds > 0 && !hasRounds(c.result.AST) {
+		return fmt.Errorf("cannot set rounds, spec has no run block")
+	}
+
+	for _, o := range c.opts.Overrides {
+		if err := override(c.result.AST, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOverrides makes sure every override landed on something
+// the preprocessor recorded, anything it missed would be silently
+// left out of the model
+func (c *compilation) checkOverrides() error {
+	for _, o := range c.opts.Overrides {
+		spec, parts := qualify(c.result.AST, o.Name)
+		sr, ok := c.pre.Specs[spec]
+		if !ok {
+			return fmt.Errorf("cannot override %s, no spec named %s", o.Name, spec)
+		}
+
+		if len(parts) == 1 {
+			if _, err := sr.FetchConstant(parts[0]); err != nil {
+				return fmt.Errorf("cannot override %s, %s", o.Name, err)
+			}
+			continue
+		}
+
+		var found bool
+		for _, structs := range []map[string]map[string]ast.Node{sr.Stocks, sr.Flows, sr.Components} {
+			if _, ok := structs[parts[0]][parts[1]]; ok {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("cannot override %s, no instance %s with property %s in spec %s", o.Name, parts[0], parts[1], spec)
+		}
+	}
+	return nil
+}
+
+func override(tree *ast.Spec, o *Override) error {
+	specName, parts := qualify(tree, o.Name)
+	if len(parts) != 1 && len(parts) != 2 {
+		return fmt.Errorf("cannot override %s, expected a constant or instance.property", o.Name)
+	}
+
+	spec := findSpec(tree, specName)
+	if spec == nil {
+		return fmt.Errorf("cannot override %s, no spec named %s", o.Name, specName)
+	}
+
+	if len(parts) == 1 {
+		return overrideConstant(spec, parts[0], o)
+	}
+	return overrideInstance(tree, spec, specName, parts[0], parts[1], o)
+}
+
+// qualify splits the name into the spec and the rest of the
+// name, a name that doesn't start with a spec belongs to the
+// spec being compiled
+func qualify(tree *ast.Spec, name string) (string, []string) {
+	parts := strings.Split(name, ".")
+	if len(parts) > 1 && findSpec(tree, parts[0]) != nil {
+		return parts[0], parts[1:]
+	}
+	return specName(tree), parts
+}
+
+// specName is the name the spec declares for itself
+func specName(tree *ast.Spec) string {
+	for _, s := range tree.Statements {
+		switch st := s.(type) {
+		case *ast.SpecDeclStatement:
+			return st.Name.Value
+		case *ast.SysDeclStatement:
+			return st.Name.Value
+		}
+	}
+	return ""
+}
+
+func hasRounds(tree *ast.Spec) bool {
+	for _, s := range tree.Statements {
+		if _, ok := s.(*ast.ForStatement); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// findSpec looks for the spec in the tree and the specs it imports
+func findSpec(tree *ast.Spec, name string) *ast.Spec {
+	for _, s := range tree.Statements {
+		switch st := s.(type) {
+		case *ast.SpecDeclStatement:
+			if st.Name.Value == name {
+				return tree
+			}
+		case *ast.SysDeclStatement:
+			if st.Name.Value == name {
+				return tree
+			}
+		}
+	}
+
+	for _, s := range tree.Statements {
+		if imp, ok := s.(*ast.ImportStatement); ok && imp.Tree != nil {
+			if found := findSpec(imp.Tree, name); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func overrideConstant(spec *ast.Spec, name string, o *Override) error {
+	for _, s := range spec.Statements {
+		cs, ok := s.(*ast.ConstantStatement)
+		if !ok || cs.Name.Value != name {
+			continue
+		}
+
+		switch cs.Value.(type) {
+		case *ast.IntegerLiteral, *ast.FloatLiteral:
+			cs.Value = number(cs.Value.GetToken(), o.Value, cs.Value)
+			return nil
+		default:
+			return fmt.Errorf("cannot override %s, its value is not a number", o.Name)
+		}
+	}
+	return fmt.Errorf("cannot override %s, no constant named %s", o.Name, name)
+}
+
+// overrideInstance adds a swap after the ones in the init
+// block, so it's the one that sticks
+func overrideInstance(tree *ast.Spec, spec *ast.Spec, specName string, name string, property string, o *Override) error {
+	for _, inst := range instances(spec) {
+		if inst.Name != name {
+			continue
+		}
+
+		old := declared(tree, inst.Value, property)
+		switch old.(type) {
+		case *ast.IntegerLiteral, *ast.FloatLiteral:
+		default:
+			return fmt.Errorf("cannot override %s, %s has no number %s", o.Name, inst.Value.Value, property)
+		}
+
+		token := inst.GetToken()
+		inst.Swaps = append(inst.Swaps, &ast.InfixExpression{
+			Token: ast.Token{Type: "SWAP", Literal: "SWAP", Position: token.Position},
+			Left: &ast.ParameterCall{
+				Token: ast.Token{Type: "IDENT", Literal: "IDENT", Position: token.Position},
+				Spec:  specName,
+				Value: []string{name, property},
+			},
+			Operator: "=",
+			Right:    number(token, o.Value, old),
+		})
+		return nil
+	}
+	return fmt.Errorf("cannot override %s, no instance named %s in the run block", o.Name, name)
+}
+
+// instances are the instances in the init block of the run
+// block, swaps aren't applied to the globals of a system
+func instances(spec *ast.Spec) []*ast.Instance {
+	var found []*ast.Instance
+	for _, s := range spec.Statements {
+		fs, ok := s.(*ast.ForStatement)
+		if !ok || fs.Inits == nil {
+			continue
+		}
+		for _, init := range fs.Inits.Statements {
+			if es, ok := init.(*ast.ExpressionStatement); ok {
+				if inst, ok := es.Expression.(*ast.Instance); ok {
+					found = append(found, inst)
+				}
+			}
+		}
+	}
+	return found
+}
+
+// declared is the default value of the property in the
+// definition of the struct, nil if it doesn't have one
+func declared(tree *ast.Spec, def *ast.Identifier, property string) ast.Node {
+	spec := findSpec(tree, def.Spec)
+	if spec == nil {
+		return nil
+	}
+
+	for _, s := range spec.Statements {
+		ds, ok := s.(*ast.DefStatement)
+		if !ok || ds.Name.Value != def.Value {
+			continue
+		}
+
+		var pairs map[*ast.Identifier]ast.Expression
+		switch v := ds.Value.(type) {
+		case *ast.StockLiteral:
+			pairs = v.Pairs
+		case *ast.FlowLiteral:
+			pairs = v.Pairs
+		case *ast.ComponentLiteral:
+			pairs = v.Pairs
+		}
+		for k, v := range pairs {
+			if k.Value == property {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// number is the value as a literal, a float if the old value
+// was one or it has a fraction
+func number(token ast.Token, v float64, old ast.Node) ast.Expression {
+	if _, ok := old.(*ast.FloatLiteral); !ok && v == math.Trunc(v) {
+		return &ast.IntegerLiteral{Token: ast.Token{Type: "INT", Literal: "INT", Position: token.Position}, Value: int64(v)}
+	}
+	return &ast.FloatLiteral{Token: ast.Token{Type: "FLOAT", Literal: "FLOAT", Position: token.Position}, Value: v}
+}
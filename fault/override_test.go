@@ -0,0 +1,71 @@
+package fault
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestOverride(t *testing.T) {
+	res, err := Compile(context.Background(), drain, &Options{Overrides: []*Override{
+		{Name: "test1.drain", Value: 7},
+		{Name: "test1.t.a", Value: 12},
+	}})
+	if err != nil {
+		t.Fatalf("compile failed with overrides. got=%s", err)
+	}
+
+	for _, e := range []string{"(assert (= test1_drain_0 7.0))", "(assert (= test1_t_a_0 12.0))"} {
+		if !strings.Contains(res.SMT(), e) {
+			t.Fatalf("override missing %s. got=%s", e, res.SMT())
+		}
+	}
+
+	res, err = Compile(context.Background(), drain, &Options{Overrides: []*Override{
+		{Name: "drain", Value: 0.5},
+		{Name: "t.a", Value: 12},
+		{Name: "t.a", Value: 14},
+	}})
+	if err != nil {
+		t.Fatalf("compile failed with overrides in the spec being compiled. got=%s", err)
+	}
+
+	for _, e := range []string{"(assert (= test1_drain_0 0.5))", "(assert (= test1_t_a_0 14.0))"} {
+		if !strings.Contains(res.SMT(), e) {
+			t.Fatalf("override missing %s. got=%s", e, res.SMT())
+		}
+	}
+
+	for _, o := range []string{"test1.nope", "test2.drain", "test1.t.nope", "test1.t.a.b", "test1.x.a", "t.a.b"} {
+		if _, err := Compile(context.Background(), drain, &Options{Overrides: []*Override{{Name: o, Value: 1}}}); err == nil {
+			t.Fatalf("invalid override %s compiled", o)
+		}
+	}
+}
+
+func TestOverrideType(t *testing.T) {
+	_, err := Compile(context.Background(), drain, &Options{Overrides: []*Override{{Name: "t.a", Value: 1.5}}})
+	if err == nil {
+		t.Fatal("float compiled into an int property")
+	}
+
+	e, ok := err.(*Error)
+	if !ok || e.Stage != StageTypes {
+		t.Fatalf("override not type checked. got=%s", err)
+	}
+}
+
+func TestRounds(t *testing.T) {
+	res, err := Compile(context.Background(), drain, &Options{Rounds: 3})
+	if err != nil {
+		t.Fatalf("compile failed with rounds. got=%s", err)
+	}
+
+	if !strings.Contains(res.SMT(), "(declare-fun test1_t_a_3 () Real)") || strings.Contains(res.SMT(), "test1_t_a_4") {
+		t.Fatalf("spec not run for 3 rounds. got=%s", res.SMT())
+	}
+
+	if _, err := Compile(context.Background(), drain, &Options{Rounds: -1}); err == nil || !strings.Contains(err.Error(), "rounds must not be negative") {
+		t.Fatalf("negative rounds compiled. got=%v", err)
+	}
+}
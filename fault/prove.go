@@ -0,0 +1,120 @@
+package fault
+
+import (
+	"context"
+	"fault/ast"
+	"fault/execute"
+	"fmt"
+)
+
+// k-induction. The base case is the normal bounded check for
+// k rounds, the inductive step starts the run from any state
+// where the asserts held for k rounds in a row and asks the
+// solver for a round k+1 that breaks them. If there isn't one
+// the asserts hold no matter how many rounds the model runs.
+
+type Outcome string
+
+const (
+	Proved         Outcome = "proved"
+	Counterexample Outcome = "counterexample"
+	Inconclusive   Outcome = "inconclusive"
+)
+
+type Proof struct {
+	Outcome Outcome
+	K       int     // Rounds it took to reach the outcome
+	Result  *Result // The last base case, holds the counterexample
+}
+
+func (p *Proof) String() string {
+	switch p.Outcome {
+	case Proved:
+		return fmt.Sprintf("proved for all rounds (k=%d)", p.K)
+	case Counterexample:
+		// The failure is somewhere in the first k rounds, the
+		// event log of the Result says which
+		return fmt.Sprintf("counterexample within k=%d rounds", p.K)
+	default:
+		return fmt.Sprintf("inconclusive at k=%d", p.K)
+	}
+}
+
+// Prove tries k = 1, 2, ... up to max until the asserts are
+// either proved or broken. Only invariants can be proved,
+// temporal asserts are an error.
+func Prove(ctx context.Context, source string, opts *Options, max int) (*Proof, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if max < 1 {
+		return nil, fmt.Errorf("k-induction needs at least one round, got %d", max)
+	}
+
+	base := *opts
+	base.Stop = ""
+	base.Check = true
+	base.Scenarios = 1
+	base.Explain = false
+
+	step := base
+	step.Stop = StageSMT
+	step.Check = false
+	step.Visualize = false
+
+	var res *Result
+	for k := 1; k <= max; k++ {
+		c := &compilation{ctx: ctx, opts: &base, source: source, result: &Result{}, rounds: k}
+		r, err := c.run()
+		if err != nil {
+			return nil, err
+		}
+		res = r
+
+		if !res.IsValid() {
+			return nil, fmt.Errorf("nothing to prove, missing run block or start block")
+		}
+		if len(res.Compiler.Asserts) == 0 {
+			return nil, fmt.Errorf("nothing to prove, spec has no asserts")
+		}
+		if len(res.Failures) > 0 {
+			return &Proof{Outcome: Counterexample, K: k, Result: res}, nil
+		}
+
+		c = &compilation{ctx: ctx, opts: &step, source: source, result: &Result{}, rounds: k + 1, induction: true}
+		ind, err := c.run()
+		if err != nil {
+			return nil, err
+		}
+
+		breaks, err := satisfiable(ctx, opts.Solver, ind.SMT())
+		if err != nil {
+			return nil, newError(StageExecute, opts.Filename, err)
+		}
+		if !breaks {
+			return &Proof{Outcome: Proved, K: k, Result: res}, nil
+		}
+	}
+	return &Proof{Outcome: Inconclusive, K: max, Result: res}, nil
+}
+
+func satisfiable(ctx context.Context, solver string, smt string) (bool, error) {
+	mc := execute.NewModelChecker()
+	mc.SetContext(ctx)
+	if err := mc.UseSolver(solver); err != nil {
+		return false, err
+	}
+	defer mc.Close()
+
+	mc.LoadModel(smt, nil, nil, nil, nil)
+	return mc.Check()
+}
+
+// setRounds changes how many times the run block runs
+func setRounds(spec *ast.Spec, n int) {
+	for _, s := range spec.Statements {
+		if fs, ok := s.(*ast.ForStatement); ok {
+			fs.Rounds = &ast.IntegerLiteral{Token: fs.Rounds.Token, Value: int64(n)}
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package fault
+
+import (
+	"context"
+	"fault/execute"
+	"fault/util"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Parameter sweeps. The spec is compiled and checked once for
+// every combination of parameter values, each swapped in with
+// an Override, and the report lists the verdict and the last
+// value of every stock at each point. Points don't depend on
+// each other so they can be split between workers.
+
+// Most points a sweep will run
+const maxSweepPoints = 10000
+
+type Param struct {
+	Name string  `json:"name"` // Same as an Override
+	From float64 `json:"from"`
+	To   float64 `json:"to"`
+	Step float64 `json:"step"`
+}
+
+// ParseParam reads name=from..to:step, the step is 1 if it's
+// left out
+func ParseParam(s string) (*Param, error) {
+	name, r, found := strings.Cut(s, "=")
+	if !found || name == "" {
+		return nil, fmt.Errorf("expected name=from..to:step, got %s", s)
+	}
+
+	r, step, found := strings.Cut(r, ":")
+	if !found {
+		step = "1"
+	}
+	from, to, found := strings.Cut(r, "..")
+	if !found {
+		return nil, fmt.Errorf("range of %s is not from..to: %s", name, r)
+	}
+
+	p := &Param{Name: name}
+	var err error
+	for _, v := range []struct {
+		text string
+		into *float64
+	}{{from, &p.From}, {to, &p.To}, {step, &p.Step}} {
+		if *v.into, err = strconv.ParseFloat(v.text, 64); err != nil {
+			return nil, fmt.Errorf("range of %s has %s, which is not a number", name, v.text)
+		}
+	}
+
+	if p.Step <= 0 {
+		return nil, fmt.Errorf("step of %s must be more than 0, got %v", name, p.Step)
+	}
+	if p.To < p.From {
+		return nil, fmt.Errorf("range of %s ends before it starts: %v..%v", name, p.From, p.To)
+	}
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// points is how many values the range has. It's a float so a
+// huge range can be rejected before anything is allocated.
+func (p *Param) points() float64 {
+	return math.Floor((p.To-p.From)/p.Step+1e-9) + 1
+}
+
+func (p *Param) check() error {
+	n := p.points()
+	if math.IsNaN(n) || math.IsInf(p.From, 0) || math.IsInf(p.To, 0) || p.Step <= 0 || n < 1 {
+		return fmt.Errorf("range of %s is not a finite range: %v..%v:%v", p.Name, p.From, p.To, p.Step)
+	}
+	if n > maxSweepPoints {
+		return fmt.Errorf("range of %s has more than %d points, use a bigger step", p.Name, maxSweepPoints)
+	}
+	return nil
+}
+
+// Values runs from From to To, including To if a step lands on it
+func (p *Param) Values() []float64 {
+	values := make([]float64, int(p.points()))
+	for i := range values {
+		v := p.From + float64(i)*p.Step
+		values[i], _ = strconv.ParseFloat(strconv.FormatFloat(v, 'g', 12, 64), 64) // 0.1*3 is 0.30000000000000004
+	}
+	return values
+}
+
+type SweepPoint struct {
+	Values  []float64         `json:"values"`  // In the order of the params
+	Verdict string            `json:"verdict"` // holds, fails, inconclusive or error
+	Stocks  map[string]string `json:"stocks"`  // Last value of each stock in the failure, or a run that holds
+	Error   string            `json:"error,omitempty"`
+}
+
+type SweepReport struct {
+	Params []*Param      `json:"params"`
+	Points []*SweepPoint `json:"points"`
+}
+
+// Sweep checks the spec at every combination of the params on
+// up to workers points at a time. A point that doesn't compile
+// is reported as an error, the rest of the sweep carries on.
+func Sweep(ctx context.Context, source string, opts *Options, params []*Param, workers int) (*SweepReport, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if len(params) == 0 {
+		return nil, fmt.Errorf("nothing to sweep, no params given")
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	// Count the points first, the grid is only built if it fits
+	total := 1.0
+	for _, p := range params {
+		if err := p.check(); err != nil {
+			return nil, err
+		}
+		total *= p.points()
+	}
+	if total > maxSweepPoints {
+		return nil, fmt.Errorf("sweep has more than %d points, use bigger steps", maxSweepPoints)
+	}
+
+	grid := [][]float64{{}}
+	for _, p := range params {
+		var next [][]float64
+		for _, g := range grid {
+			for _, v := range p.Values() {
+				next = append(next, append(append([]float64{}, g...), v))
+			}
+		}
+		grid = next
+	}
+
+	report := &SweepReport{Params: params, Points: make([]*SweepPoint, len(grid))}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				report.Points[i] = sweepPoint(ctx, source, opts, params, grid[i])
+			}
+		}()
+	}
+	for i := range grid {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return report, ctx.Err()
+}
+
+func sweepPoint(ctx context.Context, source string, opts *Options, params []*Param, values []float64) *SweepPoint {
+	point := &SweepPoint{Values: values, Stocks: make(map[string]string)}
+
+	o := *opts
+	o.Stop = ""
+	o.Check = true
+	o.Scenarios = 1
+	o.Explain = false
+	o.Overrides = append([]*Override{}, opts.Overrides...)
+	for i, p := range params {
+		o.Overrides = append(o.Overrides, &Override{Name: p.Name, Value: values[i]})
+	}
+
+	c := &compilation{ctx: ctx, opts: &o, source: source, result: &Result{}}
+	res, err := c.run()
+	if err == nil && !res.IsValid() {
+		err = fmt.Errorf("nothing to run, missing run block or start block")
+	}
+	if err != nil {
+		point.Verdict, point.Error = "error", err.Error()
+		return point
+	}
+
+	point.Verdict = "holds"
+	for _, v := range res.Verdicts {
+		if v.Outcome == execute.Inconclusive {
+			point.Verdict = "inconclusive"
+		}
+	}
+
+	var run map[string]string
+	if len(res.Failures) > 0 {
+		point.Verdict, run = "fails", res.Failures[0].Values
+	} else if run, err = anyRun(ctx, o.Solver, res); err != nil {
+		point.Verdict, point.Error = "error", err.Error()
+		return point
+	}
+	for _, v := range res.Verdicts {
+		if v.Outcome == execute.NotMet {
+			point.Verdict = "fails"
+		}
+	}
+
+	last := lastValues(run)
+	for spec, sr := range res.Checker.SpecStructs {
+		for name, props := range sr.Stocks {
+			for prop := range props {
+				if v, ok := last[fmt.Sprintf("%s_%s_%s", spec, name, prop)]; ok {
+					point.Stocks[fmt.Sprintf("%s.%s", name, prop)] = v
+				}
+			}
+		}
+	}
+	return point
+}
+
+// anyRun solves the model without the asserts, the values of a
+// run that holds
+func anyRun(ctx context.Context, solver string, res *Result) (map[string]string, error) {
+	mc := execute.NewModelChecker()
+	mc.SetContext(ctx)
+	if err := mc.UseSolver(solver); err != nil {
+		return nil, err
+	}
+	defer mc.Close()
+
+	g := res.Generator
+	mc.LoadModel(g.Model(), res.Compiler.Uncertains, res.Compiler.Unknowns, g.Results, g.Log)
+	ok, err := mc.Check()
+	if err != nil || !ok { // The assumes rule out every run
+		return nil, err
+	}
+	if _, err := mc.Solve(); err != nil {
+		return nil, err
+	}
+	return mc.ResultValues, nil
+}
+
+// lastValues is the value of each variable at its last SSA
+// index, by base name
+func lastValues(values map[string]string) map[string]string {
+	last := make(map[string]string)
+	index := make(map[string]int)
+	for k, v := range values {
+		i := strings.LastIndex(k, "_")
+		if i < 0 {
+			continue
+		}
+		if _, err := strconv.Atoi(k[i+1:]); err != nil { // Not a variable in the run
+			continue
+		}
+
+		base, n := util.GetVarBase(k)
+		if prev, ok := index[base]; !ok || n > prev {
+			index[base], last[base] = n, v
+		}
+	}
+	return last
+}
+
+// String is the report as a table, one row per point
+func (r *SweepReport) String() string {
+	header := []string{}
+	for _, p := range r.Params {
+		header = append(header, p.Name)
+	}
+	header = append(header, "verdict")
+
+	seen := make(map[string]bool)
+	var stocks []string
+	for _, p := range r.Points {
+		for s := range p.Stocks {
+			if !seen[s] {
+				seen[s] = true
+				stocks = append(stocks, s)
+			}
+		}
+	}
+	sort.Strings(stocks)
+	header = append(header, stocks...)
+
+	rows := [][]string{header}
+	for _, p := range r.Points {
+		var row []string
+		for _, v := range p.Values {
+			row = append(row, strconv.FormatFloat(v, 'g', -1, 64))
+		}
+		row = append(row, p.Verdict)
+		for _, s := range stocks {
+			row = append(row, p.Stocks[s])
+		}
+		rows = append(rows, row)
+	}
+
+	widths := make([]int, len(header))
+	for _, row := range rows {
+		for i, c := range row {
+			if len(c) > widths[i] {
+				widths[i] = len(c)
+			}
+		}
+	}
+
+	var out strings.Builder
+	for _, row := range rows {
+		var cells []string
+		for i, c := range row {
+			cells = append(cells, fmt.Sprintf("%-*s", widths[i], c))
+		}
+		out.WriteString(strings.TrimRight(strings.Join(cells, "  "), " "))
+		out.WriteString("\n")
+	}
+
+	for i, p := range r.Points {
+		if p.Error != "" {
+			fmt.Fprintf(&out, "point %d: %s\n", i+1, p.Error)
+		}
+	}
+	return out.String()
+}
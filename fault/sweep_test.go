@@ -0,0 +1,126 @@
+package fault
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const drain = `spec test1;
+const drain = 2;
+def s = stock{
+	a: 30,
+};
+def f = flow{
+	data: new s,
+	fn: func{
+		data.a <- data.a - drain;
+	},
+};
+assert s.a >= 0;
+for 1 init{t = new s; l = new f; l.data = t;} run {
+	l.fn;
+}`
+
+// Fails when drain is 40, anything else holds. A run of the
+// model without the asserts is always there.
+const drainStub = `in=$(cat)
+case "$in" in
+*fault_probe*) echo sat; echo "(model (define-fun fault_probe () Real 2.0))"; exit 0 ;;
+esac
+case "$in" in
+*"(assert (or"*|*"(assert (<"*)
+	case "$in" in
+	*"(= test1_drain_0 40.0)"*) ;;
+	*) echo unsat; exit 0 ;;
+	esac
+	a=-10.0 ;;
+*) a=28.0 ;;
+esac
+echo sat
+case "$in" in
+*get-model*) echo "(model (define-fun test1_t_a_0 () Real 30.0) (define-fun test1_t_a_1 () Real $a))" ;;
+esac`
+
+func TestParseParam(t *testing.T) {
+	p, err := ParseParam("test1.drain=0.5..1.5:0.5")
+	if err != nil {
+		t.Fatalf("valid param not parsed. got=%s", err)
+	}
+
+	values := p.Values()
+	if p.Name != "test1.drain" || len(values) != 3 || values[2] != 1.5 {
+		t.Fatalf("param not parsed correctly. got=%+v %v", p, values)
+	}
+
+	if p, err := ParseParam("test1.drain=1..3"); err != nil || p.Step != 1 {
+		t.Fatalf("step does not default to 1. got=%+v %s", p, err)
+	}
+
+	for _, s := range []string{"test1.drain", "test1.drain=1", "test1.drain=3..1", "test1.drain=1..3:0", "test1.drain=a..3"} {
+		if _, err := ParseParam(s); err == nil {
+			t.Fatalf("invalid param %s parsed", s)
+		}
+	}
+
+	p, _ = ParseParam("x=0..0.3:0.1")
+	if values := p.Values(); len(values) != 4 || values[3] != 0.3 {
+		t.Fatalf("steps drifted. got=%v", values)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	stubSolver(t, drainStub)
+
+	p, _ := ParseParam("test1.drain=0..60:20")
+	report, err := Sweep(context.Background(), drain, &Options{Solver: "env"}, []*Param{p}, 2)
+	if err != nil {
+		t.Fatalf("sweep failed. got=%s", err)
+	}
+
+	var verdicts []string
+	for _, pt := range report.Points {
+		verdicts = append(verdicts, pt.Verdict)
+	}
+	if strings.Join(verdicts, ",") != "holds,holds,fails,holds" {
+		t.Fatalf("verdicts not correct. got=%v", verdicts)
+	}
+
+	expected := `test1.drain  verdict  t.a
+0            holds    28.0
+20           holds    28.0
+40           fails    -10.0
+60           holds    28.0
+`
+	if report.String() != expected {
+		t.Fatalf("report not correct. want=%s got=%s", expected, report)
+	}
+
+	p, _ = ParseParam("test1.t.nope=1..2")
+	report, err = Sweep(context.Background(), drain, &Options{Solver: "env"}, []*Param{p}, 1)
+	if err != nil {
+		t.Fatalf("sweep failed. got=%s", err)
+	}
+	if report.Points[0].Verdict != "error" || !strings.Contains(report.Points[0].Error, "has no number nope") {
+		t.Fatalf("bad param not reported at its point. got=%+v", report.Points[0])
+	}
+}
+
+func TestSweepTooBig(t *testing.T) {
+	for _, s := range []string{"x=0..1e15:1", "x=0..1e9:1", "x=0..Inf:1", "x=NaN..1:1"} {
+		if _, err := ParseParam(s); err == nil {
+			t.Fatalf("range %s should be rejected", s)
+		}
+	}
+
+	// Params built directly are counted before the grid is made
+	for _, params := range [][]*Param{
+		{{Name: "x", From: 0, To: 1e15, Step: 1}},
+		{{Name: "x", From: 0, To: 999, Step: 1}, {Name: "y", From: 0, To: 999, Step: 1}},
+	} {
+		_, err := Sweep(context.Background(), drain, &Options{Solver: "env"}, params, 1)
+		if err == nil || !strings.Contains(err.Error(), "more than 10000 points") {
+			t.Fatalf("sweep over %d params too big not rejected. got=%v", len(params), err)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+// Package format prints Fault specs in a canonical layout. It
+// works off the parse tree and the token stream rather than the
+// AST so that comments (which the lexer sends to the hidden
+// channel) and the spec as written survive. Only whitespace is
+// ever changed.
+package format
+
+import (
+	"fault/listener"
+	"fault/parser"
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+const indentation = "    "
+
+// Keywords that sit right up against their block (stock{, func{)
+var tight = map[int]bool{
+	parser.FaultLexerFUNC:  true,
+	parser.FaultLexerFLOW:  true,
+	parser.FaultLexerSTOCK: true,
+	parser.FaultLexerSTATE: true,
+	parser.FaultLexerINIT:  true,
+	parser.FaultLexerELSE:  true,
+}
+
+type terminal struct {
+	token     antlr.Token
+	parent    antlr.ParserRuleContext
+	ancestors []antlr.ParserRuleContext // Outermost first
+}
+
+// collector flattens the parse tree into its tokens. The
+// contexts are tracked on the way down because GetParent only
+// hands back the embedded base context, not the rule's type.
+type collector struct {
+	*parser.BaseFaultParserListener
+	stack     []antlr.ParserRuleContext
+	terminals []*terminal
+}
+
+func (c *collector) EnterEveryRule(ctx antlr.ParserRuleContext) {
+	c.stack = append(c.stack, ctx)
+}
+
+func (c *collector) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	c.stack = c.stack[:len(c.stack)-1]
+}
+
+func (c *collector) VisitTerminal(node antlr.TerminalNode) {
+	if node.GetSymbol().GetTokenType() == antlr.TokenEOF || len(c.stack) == 0 {
+		return
+	}
+	ancestors := make([]antlr.ParserRuleContext, len(c.stack))
+	copy(ancestors, c.stack)
+	c.terminals = append(c.terminals, &terminal{
+		token:     node.GetSymbol(),
+		parent:    ancestors[len(ancestors)-1],
+		ancestors: ancestors,
+	})
+}
+
+// Format returns the spec in canonical form. Specs that don't
+// parse are returned as listener.SyntaxErrors.
+func Format(filename string, src string) (string, error) {
+	is := antlr.NewInputStream(src)
+	lexer := parser.NewFaultLexer(is)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	p := parser.NewFaultParser(stream)
+	el := listener.NewFaultErrorListener(filename, src)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(el)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(el)
+
+	var tree antlr.ParseTree
+	if isSystem(stream) {
+		tree = p.SysSpec()
+	} else {
+		tree = p.Spec()
+	}
+
+	if len(el.Errors) > 0 {
+		return "", listener.SyntaxErrors(el.Errors)
+	}
+
+	c := &collector{}
+	antlr.ParseTreeWalkerDefault.Walk(c, tree)
+
+	tokens := stream.GetAllTokens()
+	if t := leftover(tokens, c.terminals); t != nil {
+		el.SyntaxError(p, t, t.GetLine(), t.GetColumn(), fmt.Sprintf("unexpected %s", t.GetText()), nil)
+		return "", listener.SyntaxErrors(el.Errors)
+	}
+
+	pr := &printer{bol: true}
+	pr.print(tokens, c.terminals)
+	return pr.String(), nil
+}
+
+func isSystem(stream *antlr.CommonTokenStream) bool {
+	stream.Fill()
+	for _, t := range stream.GetAllTokens() {
+		if t.GetChannel() == antlr.TokenDefaultChannel {
+			return t.GetTokenType() == parser.FaultLexerSYSTEM
+		}
+	}
+	return false
+}
+
+// leftover is the first token the parser never reached. The
+// grammar doesn't anchor specs to EOF so anything it can't place
+// is silently left behind, and dropping it would change the spec.
+func leftover(tokens []antlr.Token, terminals []*terminal) antlr.Token {
+	last := -1
+	if len(terminals) > 0 {
+		last = terminals[len(terminals)-1].token.GetTokenIndex()
+	}
+
+	for _, t := range tokens[last+1:] {
+		if t.GetChannel() == antlr.TokenDefaultChannel && t.GetTokenType() != antlr.TokenEOF {
+			return t
+		}
+	}
+	return nil
+}
+
+type printer struct {
+	strings.Builder
+	indent int
+	bol    bool // At the beginning of a line
+	groups []bool
+}
+
+func (pr *printer) print(tokens []antlr.Token, terminals []*terminal) {
+	var prev *terminal
+	next := 0
+	for _, t := range terminals {
+		lines := pr.hidden(tokens[next:t.token.GetTokenIndex()], prev != nil)
+		next = t.token.GetTokenIndex() + 1
+
+		sep := separator(prev, t)
+		if t.token.GetTokenType() == parser.FaultLexerRPAREN && pr.inGroup() {
+			sep = newline
+		}
+		if prev != nil && prev.token.GetTokenType() == parser.FaultLexerLPAREN && pr.inGroup() {
+			sep = newline
+		}
+		if prev != nil && startsGroupItem(t) {
+			sep = newline
+		}
+
+		switch sep {
+		case newline:
+			pr.newline(lines > 1)
+		case space:
+			pr.space()
+		}
+
+		switch t.token.GetTokenType() {
+		case parser.FaultLexerRCURLY:
+			pr.indent--
+		case parser.FaultLexerRPAREN:
+			if pr.popGroup() {
+				pr.indent--
+			}
+		}
+
+		pr.write(t.token.GetText())
+
+		switch t.token.GetTokenType() {
+		case parser.FaultLexerLCURLY:
+			pr.indent++
+		case parser.FaultLexerLPAREN:
+			if pr.pushGroup(t) {
+				pr.indent++
+			}
+		}
+		prev = t
+	}
+
+	// Comments at the end of the file
+	pr.hidden(tokens[next:], prev != nil)
+	pr.newline(false)
+}
+
+// hidden writes out the comments between two tokens and
+// returns how many line breaks came after the last one
+func (pr *printer) hidden(tokens []antlr.Token, started bool) int {
+	var lines int
+	for _, h := range tokens {
+		switch h.GetTokenType() {
+		case parser.FaultLexerTERMINATOR:
+			lines += strings.Count(h.GetText(), "\n")
+		case parser.FaultLexerCOMMENT, parser.FaultLexerLINE_COMMENT:
+			text := strings.TrimRight(h.GetText(), " \t\r")
+			if lines == 0 && started {
+				// Trailing comment, stays on the same line
+				pr.space()
+				pr.write(text)
+			} else {
+				if started {
+					pr.newline(lines > 1)
+				}
+				pr.write(text)
+			}
+
+			if h.GetTokenType() == parser.FaultLexerLINE_COMMENT {
+				pr.newline(false)
+			}
+			lines = 0
+			started = true
+		}
+	}
+	return lines
+}
+
+func (pr *printer) write(s string) {
+	if pr.bol {
+		pr.WriteString(strings.Repeat(indentation, pr.indent))
+	}
+	pr.WriteString(s)
+	pr.bol = false
+}
+
+func (pr *printer) space() {
+	if !pr.bol {
+		pr.WriteString(" ")
+	}
+}
+
+// newline ends the current line, keeping at most one blank
+// line where the source had them
+func (pr *printer) newline(blank bool) {
+	if !pr.bol {
+		pr.WriteString("\n")
+		pr.bol = true
+	}
+	if blank && pr.Len() > 0 && !strings.HasSuffix(pr.String(), "\n\n") {
+		pr.WriteString("\n")
+	}
+}
+
+// Parenthesized imports and constants get a line each when
+// there is more than one of them
+func (pr *printer) pushGroup(t *terminal) bool {
+	var n int
+	switch ctx := t.parent.(type) {
+	case *parser.ImportDeclContext:
+		n = len(ctx.AllImportSpec())
+	case *parser.ConstDeclContext:
+		n = len(ctx.AllConstSpec())
+	default:
+		pr.groups = append(pr.groups, false)
+		return false
+	}
+	pr.groups = append(pr.groups, n > 1)
+	return n > 1
+}
+
+func (pr *printer) popGroup() bool {
+	if len(pr.groups) == 0 {
+		return false
+	}
+	g := pr.groups[len(pr.groups)-1]
+	pr.groups = pr.groups[:len(pr.groups)-1]
+	return g
+}
+
+func (pr *printer) inGroup() bool {
+	return len(pr.groups) > 0 && pr.groups[len(pr.groups)-1]
+}
+
+type spacing int
+
+const (
+	none spacing = iota
+	space
+	newline
+)
+
+func separator(prev *terminal, t *terminal) spacing {
+	if prev == nil {
+		return none
+	}
+
+	pt, tt := prev.token.GetTokenType(), t.token.GetTokenType()
+	switch {
+	case tt == parser.FaultLexerRCURLY && pt == parser.FaultLexerLCURLY:
+		return none
+	case tt == parser.FaultLexerRCURLY || pt == parser.FaultLexerLCURLY:
+		return newline
+	case pt == parser.FaultLexerSEMI:
+		if isIf(prev.parent) {
+			return space
+		}
+		return newline
+	case pt == parser.FaultLexerCOMMA && isList(prev.parent):
+		return newline
+	case pt == parser.FaultLexerRCURLY:
+		switch tt {
+		case parser.FaultLexerSEMI, parser.FaultLexerCOMMA, parser.FaultLexerRPAREN, parser.FaultLexerELSE:
+			return none
+		case parser.FaultLexerRUN:
+			return space
+		}
+		return newline
+	}
+
+	switch tt {
+	case parser.FaultLexerSEMI, parser.FaultLexerCOMMA, parser.FaultLexerRPAREN, parser.FaultLexerRBRACE,
+		parser.FaultLexerDOT, parser.FaultLexerCOLON, parser.FaultLexerLBRACE,
+		parser.FaultLexerPLUS_PLUS, parser.FaultLexerMINUS_MINUS:
+		return none
+	case parser.FaultLexerLPAREN:
+		if pt == parser.FaultLexerADVANCE || pt == parser.FaultLexerSTAY || pt == parser.FaultLexerPROB || isType(t.parent) {
+			return none
+		}
+	case parser.FaultLexerLCURLY:
+		if tight[pt] {
+			return none
+		}
+	}
+
+	switch pt {
+	case parser.FaultLexerLPAREN, parser.FaultLexerLBRACE, parser.FaultLexerDOT:
+		return none
+	}
+
+	if isPrefix(prev) {
+		return none
+	}
+	return space
+}
+
+// startsGroupItem is true for the first token of each import
+// or constant in a parenthesized group
+func startsGroupItem(t *terminal) bool {
+	for i := len(t.ancestors) - 1; i > 0; i-- {
+		switch t.ancestors[i].(type) {
+		case *parser.ImportSpecContext, *parser.ConstSpecContext:
+			if t.ancestors[i].GetStart().GetTokenIndex() != t.token.GetTokenIndex() {
+				return false
+			}
+			return grouped(t.ancestors[i-1])
+		}
+	}
+	return false
+}
+
+func grouped(n antlr.ParserRuleContext) bool {
+	switch decl := n.(type) {
+	case *parser.ImportDeclContext:
+		return decl.LPAREN() != nil && len(decl.AllImportSpec()) > 1
+	case *parser.ConstDeclContext:
+		return decl.LPAREN() != nil && len(decl.AllConstSpec()) > 1
+	}
+	return false
+}
+
+func isIf(n antlr.Tree) bool {
+	switch n.(type) {
+	case *parser.IfStmtContext, *parser.IfStmtRunContext, *parser.IfStmtStateContext:
+		return true
+	}
+	return false
+}
+
+// isList is true for the commas that separate properties,
+// states and start pairs, each of which gets its own line
+func isList(n antlr.Tree) bool {
+	switch n.(type) {
+	case *parser.FlowContext, *parser.StockContext, *parser.ComponentDeclContext, *parser.StartBlockContext:
+		return true
+	}
+	return false
+}
+
+func isType(n antlr.Tree) bool {
+	_, ok := n.(*parser.SolvableContext)
+	return ok
+}
+
+// isPrefix is true for unary operators (!x, -1)
+func isPrefix(t *terminal) bool {
+	var first antlr.Token
+	switch ctx := t.parent.(type) {
+	case *parser.PrefixContext:
+		first = ctx.GetStart()
+	case *parser.NegativeContext:
+		first = ctx.GetStart()
+	case *parser.CompoundStringContext:
+		if t.token.GetTokenType() != parser.FaultLexerBANG {
+			return false
+		}
+		first = ctx.GetStart()
+	default:
+		return false
+	}
+	return first.GetTokenIndex() == t.token.GetTokenIndex()
+}
@@ -0,0 +1,194 @@
+package format
+
+import (
+	"fault/listener"
+	"fault/parser"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+func TestFormat(t *testing.T) {
+	test := `spec test1;
+const (a=2
+b = -3
+);
+
+/* stocks */
+def s=stock{x:1,y:a,   // why
+z : unknown(),};
+
+
+
+def f = flow{ d: new s, fn: func{ if d.x>0 && !(d.y<2) { d.x <- 1; }else{ d.y -> 2; } }, };
+assert s.x > 1 eventually;
+assert prob (s.x>1)<0.1;
+for 2 init{l = new f;} run { l.fn | l.fn; } // end
+`
+	expected := `spec test1;
+const (
+    a = 2
+    b = -3
+);
+
+/* stocks */
+def s = stock{
+    x: 1,
+    y: a, // why
+    z: unknown(),
+};
+
+def f = flow{
+    d: new s,
+    fn: func{
+        if d.x > 0 && !(d.y < 2) {
+            d.x <- 1;
+        }else{
+            d.y -> 2;
+        }
+    },
+};
+assert s.x > 1 eventually;
+assert prob(s.x > 1) < 0.1;
+for 2 init{
+    l = new f;
+} run {
+    l.fn | l.fn;
+} // end
+`
+	got, err := Format("test.fspec", test)
+	if err != nil {
+		t.Fatalf("Format() failed: %s", err)
+	}
+	if got != expected {
+		t.Fatalf("Format() returned wrong layout. \nwant=%s\ngot=%s", expected, got)
+	}
+}
+
+func TestFormatSystem(t *testing.T) {
+	test := `system test1;
+import "test.fspec";
+component c = states{ idle: func{ if !t.active { advance(this.idle); } },
+    done: func{ stay(); },
+};
+start { c: idle, };
+for 2 run { c.idle; };`
+
+	expected := `system test1;
+import "test.fspec";
+component c = states{
+    idle: func{
+        if !t.active {
+            advance(this.idle);
+        }
+    },
+    done: func{
+        stay();
+    },
+};
+start {
+    c: idle,
+};
+for 2 run {
+    c.idle;
+};
+`
+	got, err := Format("test.fsystem", test)
+	if err != nil {
+		t.Fatalf("Format() failed: %s", err)
+	}
+	if got != expected {
+		t.Fatalf("Format() returned wrong layout. \nwant=%s\ngot=%s", expected, got)
+	}
+}
+
+func TestFormatTestdata(t *testing.T) {
+	var files []string
+	for _, pattern := range []string{"../smt/testdata/*.fspec", "../smt/testdata/*/*.fspec", "../smt/testdata/*/*.fsystem"} {
+		m, _ := filepath.Glob(pattern)
+		files = append(files, m...)
+	}
+	if len(files) == 0 {
+		t.Fatal("no specs found in testdata")
+	}
+
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		src := string(data)
+
+		got, err := Format(f, src)
+		if err != nil {
+			// Specs the grammar can't place everything in are
+			// refused rather than cut short
+			if _, ok := err.(listener.SyntaxErrors); ok {
+				continue
+			}
+			t.Fatalf("Format() failed on %s: %s", f, err)
+		}
+
+		if !same(tokens(src), tokens(got)) {
+			t.Fatalf("Format() changed the tokens of %s. got=%s", f, got)
+		}
+
+		again, err := Format(f, got)
+		if err != nil {
+			t.Fatalf("Format() failed on formatted %s: %s", f, err)
+		}
+		if again != got {
+			t.Fatalf("Format() not stable on %s. \nfirst=%s\nsecond=%s", f, got, again)
+		}
+	}
+}
+
+func TestFormatLeftover(t *testing.T) {
+	test := `spec test1;
+import "other.fspec";
+def s = stock{x: 1,};
+`
+	_, err := Format("test.fspec", test)
+	errs, ok := err.(listener.SyntaxErrors)
+	if !ok {
+		t.Fatalf("Format() did not return syntax errors. got=%v", err)
+	}
+	if errs[0].Line != 2 || errs[0].Symbol != "import" {
+		t.Fatalf("Format() reported the wrong token. got=%s", errs[0])
+	}
+}
+
+func TestFormatSyntaxError(t *testing.T) {
+	_, err := Format("test.fspec", "spec test1;\ndef s = stock{x: 1,\n")
+	if _, ok := err.(listener.SyntaxErrors); !ok {
+		t.Fatalf("Format() did not return syntax errors. got=%v", err)
+	}
+}
+
+// tokens is every token but whitespace, comments included
+func tokens(src string) []string {
+	lexer := parser.NewFaultLexer(antlr.NewInputStream(src))
+	var ret []string
+	for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+		if tok.GetTokenType() == parser.FaultLexerTERMINATOR {
+			continue
+		}
+		ret = append(ret, strings.TrimSpace(tok.GetText()))
+	}
+	return ret
+}
+
+func same(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -38,6 +38,18 @@ EVENTUALLYALWAYS: 'eventually-always';
 ALWAYS: 'always';
 NMT: 'nmt';
 NFT: 'nft';
+NEXT: 'next';
+UNTIL: 'until';
+WEAK_UNTIL: 'weak-until';
+RELEASE: 'release';
+WITHIN: 'within';
+AFTER: 'after';
+BETWEEN: 'between';
+WINDOW_AND: 'and';
+PROB: 'prob';
+MAXIMIZE: 'maximize';
+MINIMIZE: 'minimize';
+AT: 'at';
 
 NIL: 'nil';
 TRUE: 'true';
@@ -33,7 +33,7 @@ startBlock
     ;
 
 startPair
-    : IDENT ':' IDENT
+    : IDENT ':' (IDENT | NEXT)
     ;
 /*
     Individual specs of state changes
@@ -64,6 +64,7 @@ declaration
     | structDecl
     | assertion
     | assumption
+    | optimize
     | stringDecl
     ;
 
@@ -134,7 +135,7 @@ sfProperties
     ;
 
 comProperties
-    : IDENT ':' stateLit #StateFunc
+    : (IDENT | NEXT) ':' stateLit #StateFunc
     | structProperties   #compMisc
     ;
 
@@ -198,14 +199,24 @@ assumption
     : 'assume' invariant temporal? eos
     ;
 
+optimize
+    : ('maximize' | 'minimize') expression ('at' integer)? eos
+    ;
+
 temporal
     : ('eventually' | 'always' | 'eventually-always' )
     | ('nmt' | 'nft') integer
     ;
 
 invariant
-    : expression                            # invar
-    | 'when' expression 'then' expression   # stageInvariant
+    : expression window?                            # invar
+    | 'when' expression 'then' expression window?   # stageInvariant
+    ;
+
+window
+    : 'within' integer
+    | 'after' integer
+    | 'between' integer 'and' integer
     ;
 
 assignment
@@ -238,7 +249,7 @@ rounds
     ;
 
 paramCall
-    : (IDENT|THIS) '.' IDENT ('.' IDENT)*
+    : (IDENT|THIS) '.' (IDENT|NEXT) ('.' (IDENT|NEXT))*
     ;
 
 stateBlock
@@ -293,6 +304,8 @@ expression
     | expression ('==' | '!=' | '<' | '<=' | '>' | '>=') expression      #lrExpr
     | expression '&&' expression                                         #lrExpr
     | expression '||' expression                                         #lrExpr
+    | ('next' | 'always' | 'eventually') expression                      #temporalPrefix
+    | expression ('until' | 'weak-until' | 'release') expression         #temporalInfix
     ;
 
 operand
@@ -302,9 +315,14 @@ operand
     | bool_
     | operandName
     | accessHistory
+    | probability
     | '(' expression ')'
     ;
 
+probability
+    : 'prob' '(' expression ')'
+    ;
+
 operandName
     : IDENT                     #OpName
     | paramCall                 #OpParam
@@ -7,7 +7,6 @@ import (
 	"fault/parser"
 	"fault/util"
 	"fmt"
-	"log"
 	"os"
 	gopath "path"
 	"strconv"
@@ -28,11 +27,13 @@ type FaultListener struct {
 	skipRun              bool
 	Path                 string // The location of the main spec
 	testing              bool   // bypass imports when we're running unit tests
-	Uncertains           map[string][]float64
+	Uncertains           map[string]*ast.Distribution
 	Unknowns             []string
 	StructsPropertyOrder map[string][]string
 	instances            map[string]*ast.Instance
 	swaps                map[string][]ast.Node
+	syntaxErrors         SyntaxErrors // Found in the spec and its imports
+	err                  error        // First problem found building the AST
 }
 
 func NewListener(path string, testing bool, skipRun bool) *FaultListener {
@@ -40,7 +41,7 @@ func NewListener(path string, testing bool, skipRun bool) *FaultListener {
 		Path:                 path,
 		testing:              testing,
 		skipRun:              skipRun,
-		Uncertains:           make(map[string][]float64),
+		Uncertains:           make(map[string]*ast.Distribution),
 		StructsPropertyOrder: make(map[string][]string),
 		instances:            make(map[string]*ast.Instance),
 		swaps:                make(map[string][]ast.Node),
@@ -48,45 +49,145 @@ func NewListener(path string, testing bool, skipRun bool) *FaultListener {
 }
 
 func Execute(spec string, path string, flags map[string]bool /*specType bool, testing bool*/) *FaultListener {
-	is := antlr.NewInputStream(spec)
-	lexer := parser.NewFaultLexer(is)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
-
-	p := parser.NewFaultParser(stream)
+	p, el := newParser(spec, "")
 	l := NewListener(path, flags["testing"], flags["skipRun"])
 
+	var tree antlr.ParseTree
 	if flags["specType"] {
-		antlr.ParseTreeWalkerDefault.Walk(l, p.Spec())
+		tree = p.Spec()
 	} else {
-		antlr.ParseTreeWalkerDefault.Walk(l, p.SysSpec())
+		tree = p.SysSpec()
+	}
+
+	// Syntax errors are left for the caller to report
+	if err := l.build(tree, el); err != nil && len(l.syntaxErrors) == 0 {
+		panic(err)
 	}
 	return l
 }
 
-func (l *FaultListener) validate() {
+// SyntaxErrors found by Execute in the spec and everything
+// it imports, for the caller to report
+func (l *FaultListener) SyntaxErrors() SyntaxErrors {
+	return l.syntaxErrors
+}
+
+// Parse is Execute for callers that can't have the process
+// stop on them. Syntax errors in the spec and everything it
+// imports are collected and returned together, otherwise the
+// first problem the listener finds is returned as an
+// *ast.Error.
+func Parse(spec string, path string, filename string, flags map[string]bool) (*FaultListener, error) {
+	p, el := newParser(spec, filename)
+
+	var tree antlr.ParseTree
+	if flags["specType"] {
+		tree = p.Spec()
+	} else {
+		tree = p.SysSpec()
+	}
+
+	// Syntax errors trump anything a broken import
+	// leads the listener to
+	l := NewListener(path, flags["testing"], flags["skipRun"])
+	err := l.build(tree, el)
+	if len(l.syntaxErrors) > 0 {
+		return nil, l.syntaxErrors
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// build walks the tree into the AST. A tree with syntax
+// errors can't be built, but the specs it imports are still
+// checked so every syntax error is reported at once.
+func (l *FaultListener) build(tree antlr.ParseTree, el *FaultErrorListener) error {
+	if len(el.Errors) > 0 {
+		l.syntaxErrors = append(l.syntaxErrors, el.Errors...)
+		l.scanImports(tree)
+		return nil
+	}
+	return l.walk(tree)
+}
+
+// walk is antlr's ParseTreeWalker, but it stops at the first
+// problem the listener finds
+func (l *FaultListener) walk(t antlr.Tree) error {
+	switch n := t.(type) {
+	case antlr.ErrorNode:
+		l.VisitErrorNode(n)
+	case antlr.TerminalNode:
+		l.VisitTerminal(n)
+	default:
+		ctx := t.(antlr.RuleNode).GetRuleContext().(antlr.ParserRuleContext)
+		l.EnterEveryRule(ctx)
+		ctx.EnterRule(l)
+		for i := 0; i < t.GetChildCount() && l.err == nil; i++ {
+			l.walk(t.GetChild(i))
+		}
+		if l.err == nil {
+			ctx.ExitRule(l)
+			l.ExitEveryRule(ctx)
+		}
+	}
+	return l.err
+}
+
+// newParser collects syntax errors instead of letting antlr
+// print them
+func newParser(spec string, filename string) (*parser.FaultParser, *FaultErrorListener) {
+	is := antlr.NewInputStream(spec)
+	lexer := parser.NewFaultLexer(is)
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	p := parser.NewFaultParser(stream)
+	el := NewFaultErrorListener(filename, spec)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(el)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(el)
+	return p, el
+}
+
+const malformed = "Malformed fspec or fsystem file. No model possible."
+
+func (l *FaultListener) validate() error {
 	if l.testing { //will allow invalid specs during testing
-		return
+		return nil
 	}
 
 	if len(l.stack) < 2 {
-		fmt.Println("Malformed fspec or fsystem file. No model possible.")
-		os.Exit(1)
+		return &ast.Error{Msg: malformed}
 	}
 
 	for _, v := range l.stack {
 		if _, ok := v.(*ast.DefStatement); ok {
-			return
+			return nil
 		}
 
 		if forS, ok := v.(*ast.ForStatement); ok {
 			if len(forS.Inits.Statements) > 0 {
-				return
+				return nil
 			}
 		}
 	}
 
-	fmt.Println("Malformed fspec or fsystem file. No model possible.")
-	os.Exit(1)
+	return &ast.Error{Msg: malformed}
+}
+
+// fail keeps the first problem found in the spec, walk
+// stops there
+func (l *FaultListener) fail(pos []int, format string, a ...interface{}) {
+	if l.err == nil {
+		l.err = ast.Errorf(pos, format, a...)
+	}
+}
+
+func position(c antlr.ParserRuleContext) []int {
+	return []int{c.GetStart().GetLine(), c.GetStart().GetColumn()}
 }
 
 func (l *FaultListener) push(n ast.Node) {
@@ -110,11 +211,15 @@ func (l *FaultListener) peek() ast.Node {
 func (l *FaultListener) ExitSpec(c *parser.SpecContext) {
 	var spec = &ast.Spec{}
 	spec.Ext = "fspec"
-	l.validate()
+	if l.err = l.validate(); l.err != nil {
+		return
+	}
 	for _, v := range l.stack {
 		spec.Statements = append(spec.Statements, v.(ast.Statement))
 	}
-	l.addSwaps()
+	if l.err = l.addSwaps(); l.err != nil {
+		return
+	}
 	l.AST = spec
 }
 
@@ -165,12 +270,14 @@ func (l *FaultListener) ExitImportSpec(c *parser.ImportSpecContext) {
 
 	val := l.pop()
 	if val == nil {
-		panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), val))
+		l.fail(position(c), "top of stack not an expression got=%T", val)
+		return
 	}
 
 	fpath, ok := val.(*ast.StringLiteral)
 	if !ok {
-		panic(fmt.Sprintf("import path not a string: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), val))
+		l.fail(position(c), "import path not a string got=%T", val)
+		return
 	}
 
 	// If no ident, create one from import path
@@ -193,9 +300,10 @@ func (l *FaultListener) ExitImportSpec(c *parser.ImportSpecContext) {
 		fp = util.Filepath(fp)
 		importFile, err := os.ReadFile(fp)
 		if err != nil {
-			panic(fmt.Sprintf("spec file %s not found\n", fpath))
+			l.fail(position(c), "spec file %s not found", fpath)
+			return
 		}
-		tree = l.parseImport(importId, string(importFile))
+		tree = l.parseImport(importId, string(importFile), fp)
 	}
 
 	ident := &ast.Identifier{
@@ -220,7 +328,8 @@ func (l *FaultListener) ExitConstSpec(c *parser.ConstSpecContext) {
 	var items int
 	identlist, ok := c.GetChild(0).(*parser.IdentListContext)
 	if !ok {
-		panic(fmt.Sprintf("can't find ident list: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), c.GetChild(0)))
+		l.fail(position(c), "can't find ident list got=%T", c.GetChild(0))
+		return
 	}
 	items = len(identlist.AllOperandName())
 
@@ -228,7 +337,8 @@ func (l *FaultListener) ExitConstSpec(c *parser.ConstSpecContext) {
 	if (c.GetChildCount() - items) > 0 {
 		val = l.pop()
 		if val == nil {
-			panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), val))
+			l.fail(position(c), "top of stack not an expression got=%T", val)
+			return
 		}
 
 	} else {
@@ -241,7 +351,8 @@ func (l *FaultListener) ExitConstSpec(c *parser.ConstSpecContext) {
 		left := l.pop()
 		ident, ok := left.(*ast.Identifier)
 		if !ok {
-			panic(fmt.Sprintf("top of stack not an identifier: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), left))
+			l.fail(position(c), "top of stack not an identifier got=%T", left)
+			return
 		}
 
 		switch inst := val.(type) {
@@ -250,7 +361,7 @@ func (l *FaultListener) ExitConstSpec(c *parser.ConstSpecContext) {
 			val = inst
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, ident.Value}, "_"))
 		case *ast.Uncertain:
-			l.Uncertains[strings.Join([]string{l.currSpec, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Uncertains[strings.Join([]string{l.currSpec, ident.Value}, "_")] = inst.Distribution()
 		}
 		var temp []ast.Node
 		temp = append(temp, &ast.ConstantStatement{
@@ -299,9 +410,11 @@ func (l *FaultListener) ExitStructDecl(c *parser.StructDeclContext) {
 		val = right.(ast.Expression)
 	default:
 		if right == nil {
-			panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), right))
+			l.fail(position(c), "top of stack not an expression got=%T", right)
+			return
 		}
-		panic(fmt.Sprintf("def can only be used to define a valid stock or flow: line %d col %d", c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "def can only be used to define a valid stock or flow")
+		return
 	}
 
 	l.push(
@@ -405,7 +518,7 @@ func (l *FaultListener) ExitPropVar(c *parser.PropVarContext) {
 	case *ast.PrefixExpression:
 		l.push(v)
 	default:
-		panic(fmt.Sprintf("top of stack not an identifier: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), f))
+		l.fail(position(c), "top of stack not an identifier got=%T", f)
 	}
 }
 
@@ -443,7 +556,7 @@ func (l *FaultListener) ExitPropSolvable(c *parser.PropSolvableContext) {
 }
 
 func (l *FaultListener) EnterStateFunc(c *parser.StateFuncContext) {
-	l.scope = fmt.Sprint(l.scope, ".", c.IDENT().GetText())
+	l.scope = fmt.Sprint(l.scope, ".", c.GetStart().GetText())
 }
 
 func (l *FaultListener) ExitStateFunc(c *parser.StateFuncContext) {
@@ -452,7 +565,7 @@ func (l *FaultListener) ExitStateFunc(c *parser.StateFuncContext) {
 
 	l.push(&ast.Identifier{
 		Token: token,
-		Value: c.IDENT().GetText(),
+		Value: c.GetStart().GetText(),
 		Spec:  l.currSpec,
 	},
 	)
@@ -526,7 +639,8 @@ func (l *FaultListener) ExitStatementList(c *parser.StatementListContext) {
 			}
 			sl.Statements = append([]ast.Statement{s}, sl.Statements...)
 		default:
-			panic(fmt.Sprintf("Neither statement nor expression got=%T", v))
+			l.fail(position(c), "neither statement nor expression got=%T", v)
+			return
 		}
 	}
 	l.push(sl)
@@ -556,7 +670,8 @@ func (l *FaultListener) ExitFaultAssign(c *parser.FaultAssignContext) {
 			Operator: "+",
 			Right:    right.(ast.Expression)}
 	} else {
-		panic(fmt.Sprintf("Invalid operator %s in expression", operator))
+		l.fail(position(c), "invalid operator %s in expression", operator)
+		return
 	}
 
 	l.push(
@@ -574,7 +689,8 @@ func (l *FaultListener) ExitMiscAssign(c *parser.MiscAssignContext) {
 
 	right := l.pop()
 	if right == nil {
-		panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), right))
+		l.fail(position(c), "top of stack not an expression got=%T", right)
+		return
 	}
 
 	var assign *ast.InfixExpression
@@ -595,7 +711,7 @@ func (l *FaultListener) ExitMiscAssign(c *parser.MiscAssignContext) {
 			}
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_"))
 		case *ast.Uncertain:
-			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst.Distribution()
 		}
 
 		assign = &ast.InfixExpression{
@@ -619,7 +735,8 @@ func (l *FaultListener) ExitMiscAssign(c *parser.MiscAssignContext) {
 		}
 
 	default:
-		panic(fmt.Sprintf("left side of expression should be an identifier: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), left))
+		l.fail(position(c), "left side of expression should be an identifier got=%T", left)
+		return
 	}
 
 	l.push(assign)
@@ -649,6 +766,42 @@ func (l *FaultListener) ExitLrExpr(c *parser.LrExprContext) {
 	l.push(e)
 }
 
+func (l *FaultListener) ExitTemporalPrefix(c *parser.TemporalPrefixContext) {
+	operator := c.GetChild(0).(antlr.TerminalNode).GetText()
+	token := ast.GenerateToken("TEMPORAL", operator, c.GetStart(), c.GetStop())
+
+	rght := l.pop()
+	l.push(&ast.TemporalPrefix{
+		Token:    token,
+		Operator: operator,
+		Right:    rght.(ast.Expression),
+	})
+}
+
+func (l *FaultListener) ExitTemporalInfix(c *parser.TemporalInfixContext) {
+	operator := c.GetChild(1).(antlr.TerminalNode).GetText()
+	token := ast.GenerateToken("TEMPORAL", operator, c.GetStart(), c.GetStop())
+
+	rght := l.pop()
+	lft := l.pop()
+	l.push(&ast.TemporalInfix{
+		Token:    token,
+		Left:     lft.(ast.Expression),
+		Operator: operator,
+		Right:    rght.(ast.Expression),
+	})
+}
+
+func (l *FaultListener) ExitProbability(c *parser.ProbabilityContext) {
+	token := ast.GenerateToken("PROB", "prob", c.GetStart(), c.GetStop())
+
+	event := l.pop()
+	l.push(&ast.ProbabilityExpression{
+		Token: token,
+		Event: event.(ast.Expression),
+	})
+}
+
 func (l *FaultListener) ExitParamCall(c *parser.ParamCallContext) {
 	token := ast.GenerateToken("IDENT", "IDENT", c.GetStart(), c.GetStop())
 
@@ -747,7 +900,8 @@ func (l *FaultListener) ExitRunBlock(c *parser.RunBlockContext) {
 			t = n.(*ast.ExpressionStatement)
 			sl.Statements = append([]ast.Statement{t}, sl.Statements...)
 		default:
-			panic(fmt.Sprintf("Neither statement nor expression got=%T", ex))
+			l.fail(position(c), "neither statement nor expression got=%T", ex)
+			return
 		}
 	}
 	l.push(sl)
@@ -779,7 +933,8 @@ func (l *FaultListener) ExitStateBlock(c *parser.StateBlockContext) {
 			sl.Statements = append([]ast.Statement{&ast.ExpressionStatement{Expression: t}}, sl.Statements...)
 
 		default:
-			panic(fmt.Sprintf("Neither statement nor expression got=%T", ex))
+			l.fail(position(c), "neither statement nor expression got=%T", ex)
+			return
 		}
 	}
 	l.push(sl)
@@ -809,7 +964,8 @@ func (l *FaultListener) ExitRunInit(c *parser.RunInitContext) {
 			ident.Value = r.Value[1]
 			right = txt[0].GetText()
 		default:
-			panic(fmt.Sprintf("%s is an invalid identifier line: %d col:%d", txt, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			l.fail(position(c), "%s is an invalid identifier", txt)
+			return
 		}
 
 	case 2:
@@ -821,7 +977,8 @@ func (l *FaultListener) ExitRunInit(c *parser.RunInitContext) {
 		ident.Value = txt[2].GetText() // Not sure why the parser flips the order
 		right = txt[0].GetText()
 	default:
-		panic(fmt.Sprintf("%s is an invalid identifier line: %d col:%d", txt, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "%s is an invalid identifier", txt)
+		return
 	}
 
 	key := strings.Join([]string{ident.Spec, ident.Value}, "_")
@@ -893,7 +1050,8 @@ func (l *FaultListener) ExitRunExpr(c *parser.RunExprContext) {
 	x := l.pop()
 	exp, ok := x.(ast.Expression)
 	if !ok {
-		panic(fmt.Sprintf("top of stack is not a expression. got=%T", x))
+		l.fail(position(c), "top of stack is not a expression got=%T", x)
+		return
 	}
 
 	e := &ast.ExpressionStatement{
@@ -909,7 +1067,8 @@ func (l *FaultListener) ExitStateExpr(c *parser.StateExprContext) {
 	x := l.pop()
 	exp, ok := x.(ast.Expression)
 	if !ok {
-		panic(fmt.Sprintf("top of stack is not a expression. got=%T", x))
+		l.fail(position(c), "top of stack is not a expression got=%T", x)
+		return
 	}
 
 	e := &ast.ExpressionStatement{
@@ -947,7 +1106,8 @@ func (l *FaultListener) ExitSolvable(c *parser.SolvableContext) {
 		nat, ok := value.(*ast.IntegerLiteral)
 
 		if !ok {
-			panic(fmt.Sprintf("Invalid value cast to type natural. got=%T at line %d col %d", value, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+			l.fail(position(c), "invalid value cast to type natural got=%T", value)
+			return
 		}
 
 		l.push(&ast.Natural{
@@ -958,23 +1118,58 @@ func (l *FaultListener) ExitSolvable(c *parser.SolvableContext) {
 	case "uncertain":
 		token := ast.GenerateToken("UNCERTAIN", "UNCERTAIN", c.GetStart(), c.GetStop())
 
-		v1 := l.pop()
-		sigma, err := l.intOrFloatOk(v1)
-		if err != nil {
-			panic(fmt.Sprintf("Invalid value for sigma of type uncertain. got=%T at: line %d col %d", v1, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		// uncertain(mean, sigma) or uncertain(distribution, params...)
+		// with two more values to truncate it
+		args := make([]ast.Node, len(c.AllOperand()))
+		for i := len(args) - 1; i >= 0; i-- {
+			args[i] = l.pop()
 		}
 
-		v2 := l.pop()
-		mean, err := l.intOrFloatOk(v2)
-		if err != nil {
-			panic(fmt.Sprintf("Invalid value for mean of type uncertain. got=%T at: line %d col %d", v2, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		dist := "normal"
+		if len(args) > 0 {
+			if ident, ok := args[0].(*ast.Identifier); ok {
+				dist = ident.Value
+				args = args[1:]
+			}
 		}
 
-		l.push(&ast.Uncertain{
-			Token: token,
-			Mean:  mean,
-			Sigma: sigma,
-		})
+		names, ok := ast.DISTRIBUTIONS[dist]
+		if !ok {
+			l.fail(position(c), "unknown distribution %s for type uncertain", dist)
+			return
+		}
+		if len(args) != len(names) && len(args) != len(names)+2 {
+			l.fail(position(c), "wrong number of values for %s distribution, want %s (and optionally low, high) got=%d", dist, strings.Join(names, ", "), len(args))
+			return
+		}
+
+		var params []float64
+		for i, a := range args {
+			v, err := l.intOrFloatOk(a)
+			if err != nil {
+				name := "truncation bound"
+				if i < len(names) {
+					name = names[i]
+				}
+				l.fail(position(c), "invalid value for %s of type uncertain got=%T", name, a)
+				return
+			}
+			params = append(params, v)
+		}
+
+		u := &ast.Uncertain{
+			Token:  token,
+			Dist:   dist,
+			Params: params[:len(names)],
+		}
+		if dist == "normal" {
+			u.Mean, u.Sigma = params[0], params[1]
+		}
+		if len(params) > len(names) {
+			u.Truncated = true
+			u.Low, u.High = params[len(names)], params[len(names)+1]
+		}
+		l.push(u)
 	case "unknown":
 		token := ast.GenerateToken("UNKNOWN", "UNKNOWN", c.GetStart(), c.GetStop())
 
@@ -987,7 +1182,7 @@ func (l *FaultListener) ExitSolvable(c *parser.SolvableContext) {
 			Name:  ident,
 		})
 	default:
-		log.Fatalf("Unimplemented: %s", c.FaultType().GetText())
+		l.fail(position(c), "unimplemented solvable %s", c.FaultType().GetText())
 	}
 }
 
@@ -1001,7 +1196,8 @@ func (l *FaultListener) ExitIncDecStmt(c *parser.IncDecStmtContext) {
 		tType = "MINUS"
 		tLit = "-"
 	} else {
-		panic(fmt.Sprintf("Illegal operation: line %d col %d", c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "illegal operation")
+		return
 	}
 
 	token := ast.GenerateToken(string(tType), tLit, c.GetStart(), c.GetStop())
@@ -1034,7 +1230,8 @@ func (l *FaultListener) assembleIf(token ast.Token, children []antlr.Tree) *ast.
 				x,
 			}}
 		default:
-			panic(fmt.Sprintf("improper type in conditional got=%T", ra))
+			l.fail(token.GetPosition(), "improper type in conditional got=%T", ra)
+			return nil
 		}
 
 	}
@@ -1137,7 +1334,8 @@ func (l *FaultListener) ExitOpInstance(c *parser.OpInstanceContext) {
 		ident.Spec = id[0].GetText()
 		ident.Value = id[1].GetText()
 	default:
-		panic(fmt.Sprintf("%s is an invalid identifier line: %d col:%d", id, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "%s is an invalid identifier", id)
+		return
 	}
 
 	key := strings.Join([]string{ident.Spec, ident.Value}, "_")
@@ -1182,7 +1380,8 @@ func (l *FaultListener) ExitInteger(c *parser.IntegerContext) {
 
 	v, err := strconv.ParseInt(c.GetText(), 10, 64)
 	if err != nil {
-		panic(fmt.Sprintf("integer value detected but not parsable: line %d col %d got=%s", c.GetStart().GetLine(), c.GetStart().GetColumn(), c.GetText()))
+		l.fail(position(c), "integer value detected but not parsable got=%s", c.GetText())
+		return
 	}
 
 	l.push(&ast.IntegerLiteral{
@@ -1220,7 +1419,7 @@ func (l *FaultListener) ExitNegative(c *parser.NegativeContext) {
 		l.push(e)
 
 	default:
-		panic(fmt.Sprintf("top of stack not an integer or a float got=%T", base))
+		l.fail(position(c), "top of stack not an integer or a float got=%T", base)
 	}
 
 }
@@ -1230,7 +1429,8 @@ func (l *FaultListener) ExitFloat_(c *parser.Float_Context) {
 
 	v, err := strconv.ParseFloat(c.GetText(), 64)
 	if err != nil {
-		panic(fmt.Sprintf("float value detected but not parsable: line %d col %d got=%s", c.GetStart().GetLine(), c.GetStart().GetColumn(), c.GetText()))
+		l.fail(position(c), "float value detected but not parsable got=%s", c.GetText())
+		return
 	}
 
 	l.push(&ast.FloatLiteral{
@@ -1295,7 +1495,7 @@ func (l *FaultListener) ExitStringDecl(c *parser.StringDeclContext) {
 		val.(*ast.PrefixExpression).Token = token2
 		l.push(&ast.DefStatement{Token: token, Name: ident, Value: val.(ast.Expression)})
 	default:
-		panic(fmt.Sprintf("top of the stack is not a string got %T: line %d col %d", val, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "top of the stack is not a string got=%T", val)
 	}
 }
 
@@ -1315,7 +1515,8 @@ func (l *FaultListener) ExitBool_(c *parser.Bool_Context) {
 
 	v, err := strconv.ParseBool(c.GetText())
 	if err != nil {
-		panic(fmt.Sprintf("Detected boolean will not parse: line %d col %d", c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "detected boolean will not parse")
+		return
 	}
 
 	l.push(&ast.Boolean{
@@ -1339,7 +1540,8 @@ func (l *FaultListener) ExitInitDecl(c *parser.InitDeclContext) {
 
 	init := l.pop()
 	if init == nil {
-		panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), init))
+		l.fail(position(c), "top of stack not an expression got=%T", init)
+		return
 	}
 	l.push(&ast.InitExpression{
 		Token:      token,
@@ -1357,16 +1559,19 @@ func (l *FaultListener) ExitForStmt(c *parser.ForStmtContext) {
 	var block2 *ast.BlockStatement
 
 	if run == nil {
-		panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), run))
+		l.fail(position(c), "top of stack not an expression got=%T", run)
+		return
 	}
 
 	block, ok := run.(*ast.BlockStatement)
 	if !ok {
-		panic(fmt.Sprintf("top of stack not a block statement: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), run))
+		l.fail(position(c), "top of stack not a block statement got=%T", run)
+		return
 	}
 
 	if init == nil {
-		panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), init))
+		l.fail(position(c), "top of stack not an expression got=%T", init)
+		return
 	}
 
 	switch x := init.(type) {
@@ -1374,7 +1579,8 @@ func (l *FaultListener) ExitForStmt(c *parser.ForStmtContext) {
 		num := l.pop()
 		rounds, ok = num.(*ast.IntegerLiteral)
 		if !ok {
-			panic(fmt.Sprintf("top of stack not an integer literal: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), num))
+			l.fail(position(c), "top of stack not an integer literal got=%T", num)
+			return
 		}
 		block2 = x
 
@@ -1383,7 +1589,8 @@ func (l *FaultListener) ExitForStmt(c *parser.ForStmtContext) {
 		block2 = &ast.BlockStatement{}
 
 	default:
-		panic(fmt.Sprintf("top of stack not a block statement or integer: line %d col %d type %T", c.GetStart().GetLine(), c.GetStart().GetColumn(), init))
+		l.fail(position(c), "top of stack not a block statement or integer got=%T", init)
+		return
 	}
 
 	forSt := &ast.ForStatement{
@@ -1410,6 +1617,32 @@ func (l *FaultListener) ExitStageInvariant(c *parser.StageInvariantContext) {
 	})
 }
 
+func (l *FaultListener) ExitWindow(c *parser.WindowContext) {
+	operator := c.GetStart().GetText()
+	token := ast.GenerateToken("TEMPORAL", operator, c.GetStart(), c.GetStop())
+
+	window := &ast.TemporalWindow{Token: token, Operator: operator}
+	switch operator {
+	case "within":
+		window.To = int(l.pop().(*ast.IntegerLiteral).Value)
+	case "after":
+		window.From = int(l.pop().(*ast.IntegerLiteral).Value)
+		window.To = -1
+	case "between":
+		window.To = int(l.pop().(*ast.IntegerLiteral).Value)
+		window.From = int(l.pop().(*ast.IntegerLiteral).Value)
+		if window.From > window.To {
+			l.fail(position(c), "window between %d and %d closes before it opens", window.From, window.To)
+		}
+	}
+
+	// The window bounds the expression before it, the then
+	// side of a when/then
+	rght := l.pop()
+	window.Right = rght.(ast.Expression)
+	l.push(window)
+}
+
 func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 	token := ast.GenerateToken("ASSERT", "assert", c.GetStart(), c.GetStop())
 
@@ -1432,7 +1665,8 @@ func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 	var con *ast.InvariantClause
 	switch e := expr.(type) {
 	default:
-		panic(fmt.Sprintf("invariant unusable. Must be expression not %T line: %d, col: %d", e, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "invariant unusable. Must be expression not %T", e)
+		return
 	case *ast.IntegerLiteral:
 		// Disregard, this is part of the temporal filter
 	case *ast.ParameterCall:
@@ -1459,7 +1693,8 @@ func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 				Right:    &ast.Boolean{Value: true},
 			}
 		} else {
-			panic("illegal prefix operator in assertion")
+			l.fail(position(c), "illegal prefix operator %s in assertion", e.Operator)
+			return
 		}
 	case *ast.InfixExpression:
 
@@ -1469,6 +1704,27 @@ func (l *FaultListener) ExitAssertion(c *parser.AssertionContext) {
 			Operator: e.Operator,
 			Right:    e.Right,
 		}
+	case *ast.TemporalPrefix:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e,
+			Operator: "==",
+			Right:    &ast.Boolean{Value: true},
+		}
+	case *ast.TemporalInfix:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e.Left,
+			Operator: e.Operator,
+			Right:    e.Right,
+		}
+	case *ast.TemporalWindow:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e,
+			Operator: "==",
+			Right:    &ast.Boolean{Value: true},
+		}
 	case *ast.InvariantClause:
 		con = e
 	case *ast.IndexExpression:
@@ -1511,7 +1767,8 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 	var con *ast.InvariantClause
 	switch e := expr.(type) {
 	default:
-		panic(fmt.Sprintf("invariant unusable. Must be expression not %T line: %d, col: %d", e, c.GetStart().GetLine(), c.GetStart().GetColumn()))
+		l.fail(position(c), "invariant unusable. Must be expression not %T", e)
+		return
 	case *ast.ParameterCall:
 		con = &ast.InvariantClause{
 			Token:    e.Token,
@@ -1536,7 +1793,8 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 				Right:    &ast.Boolean{Value: true},
 			}
 		} else {
-			panic("illegal prefix operator in assumption")
+			l.fail(position(c), "illegal prefix operator %s in assumption", e.Operator)
+			return
 		}
 	case *ast.InfixExpression:
 		if e.Operator == "!=" {
@@ -1554,6 +1812,27 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 				Right:    e.Right,
 			}
 		}
+	case *ast.TemporalPrefix:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e,
+			Operator: "==",
+			Right:    &ast.Boolean{Value: true},
+		}
+	case *ast.TemporalInfix:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e.Left,
+			Operator: e.Operator,
+			Right:    e.Right,
+		}
+	case *ast.TemporalWindow:
+		con = &ast.InvariantClause{
+			Token:    e.Token,
+			Left:     e,
+			Operator: "==",
+			Right:    &ast.Boolean{Value: true},
+		}
 	case *ast.InvariantClause:
 		con = e
 	}
@@ -1568,21 +1847,80 @@ func (l *FaultListener) ExitAssumption(c *parser.AssumptionContext) {
 	})
 }
 
-func (l *FaultListener) parseImport(id string, spec string) *ast.Spec {
-	is := antlr.NewInputStream(spec)
-	lexer := parser.NewFaultLexer(is)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+func (l *FaultListener) ExitOptimize(c *parser.OptimizeContext) {
+	direction := c.GetStart().GetText()
+	token := ast.GenerateToken("OPTIMIZE", direction, c.GetStart(), c.GetStop())
+
+	var round int64 = -1
+	if c.Integer() != nil {
+		round = l.pop().(*ast.IntegerLiteral).Value
+	}
+
+	target := l.pop()
+	l.push(&ast.OptimizeStatement{
+		Token:     token,
+		Direction: direction,
+		Target:    target.(ast.Expression),
+		Round:     round,
+	})
+}
+
+func (l *FaultListener) parseImport(id string, spec string, filename string) *ast.Spec {
+	p, el := newParser(spec, filename)
+	tree := p.Spec()
 
-	p := parser.NewFaultParser(stream)
 	listener := NewListener("", false, true)
 	listener.currSpec = id
-	antlr.ParseTreeWalkerDefault.Walk(listener, p.Spec())
+	err := listener.build(tree, el)
+
+	// Errors in nested imports are reported by the spec
+	// that started the parse
+	l.syntaxErrors = append(l.syntaxErrors, listener.syntaxErrors...)
+	if err != nil {
+		l.err = err
+		return nil
+	}
 
 	l.Uncertains, l.Unknowns, l.StructsPropertyOrder = mergeListeners(l, listener)
 	return listener.AST
 }
 
-func mergeListeners(l1 *FaultListener, l2 *FaultListener) (map[string][]float64, []string, map[string][]string) {
+// scanImports checks the specs a tree with syntax errors
+// imports for syntax errors of their own
+func (l *FaultListener) scanImports(tree antlr.ParseTree) {
+	if l.testing {
+		return
+	}
+	antlr.ParseTreeWalkerDefault.Walk(&importScanner{l: l}, tree)
+}
+
+type importScanner struct {
+	*parser.BaseFaultParserListener
+	l *FaultListener
+}
+
+func (s *importScanner) ExitImportPath(c *parser.ImportPathContext) {
+	fpath := c.GetText()
+	if len(fpath) < 2 {
+		return
+	}
+
+	fp := util.Filepath(gopath.Join(s.l.Path, fpath[1:len(fpath)-1]))
+	importFile, err := os.ReadFile(fp)
+	if err != nil { // Reported once the spec itself parses
+		return
+	}
+
+	p, el := newParser(string(importFile), fp)
+	tree := p.Spec()
+
+	nested := NewListener("", false, true)
+	nested.scanImports(tree)
+	s.l.syntaxErrors = append(s.l.syntaxErrors, el.Errors...)
+	s.l.syntaxErrors = append(s.l.syntaxErrors, nested.syntaxErrors...)
+}
+
+func mergeListeners(l1 *FaultListener, l2 *FaultListener) (map[string]*ast.Distribution, []string, map[string][]string) {
 	for k, v := range l2.Uncertains {
 		l1.Uncertains[k] = v
 	}
@@ -1601,17 +1939,20 @@ func (l *FaultListener) getPairs(p int, pos []int) (map[*ast.Identifier]ast.Expr
 	for i := 0; i < p; i++ {
 		right := l.pop()
 		if right == nil {
-			panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", pos[0], pos[1], right))
+			l.fail(pos, "top of stack not an expression got=%T", right)
+			return nil, nil
 		}
 
 		left := l.pop()
 		if left == nil {
-			panic(fmt.Sprintf("top of stack not an expression: line %d col %d type %T", pos[0], pos[1], left))
+			l.fail(pos, "top of stack not an expression got=%T", left)
+			return nil, nil
 		}
 
 		ident, ok := left.(*ast.Identifier)
 		if !ok {
-			panic(fmt.Sprintf("top of stack not an identifier: line %d col %d type %T", pos[0], pos[1], left))
+			l.fail(pos, "top of stack not an identifier got=%T", left)
+			return nil, nil
 		}
 
 		switch inst := right.(type) {
@@ -1620,7 +1961,7 @@ func (l *FaultListener) getPairs(p int, pos []int) (map[*ast.Identifier]ast.Expr
 			right = inst
 			l.Unknowns = append(l.Unknowns, strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_"))
 		case *ast.Uncertain:
-			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = []float64{inst.Mean, inst.Sigma}
+			l.Uncertains[strings.Join([]string{l.currSpec, l.scope, ident.Value}, "_")] = inst.Distribution()
 		}
 		order = append([]string{ident.Value}, order...)
 		pairs[ident] = right.(ast.Expression)
@@ -1687,11 +2028,15 @@ func pathToIdent(path string) string {
 func (l *FaultListener) ExitSysSpec(c *parser.SysSpecContext) {
 	var spec = &ast.Spec{}
 	spec.Ext = "fsystem"
-	l.validate()
+	if l.err = l.validate(); l.err != nil {
+		return
+	}
 	for _, v := range l.stack {
 		spec.Statements = append(spec.Statements, v.(ast.Statement))
 	}
-	l.addSwaps()
+	if l.err = l.addSwaps(); l.err != nil {
+		return
+	}
 	l.AST = spec
 }
 
@@ -1741,7 +2086,8 @@ func (l *FaultListener) filterSwaps(id string, swaps []ast.Node) ([]ast.Node, []
 			case *ast.ParameterCall:
 				id2 = n.Value[0]
 			default:
-				panic(fmt.Sprintf("malformed swap got=%s", infx.String()))
+				l.fail(infx.Position(), "malformed swap got=%s", infx.String())
+				return nil, nil
 			}
 
 			if id == id2 {
@@ -1877,11 +2223,10 @@ func (l *FaultListener) ExitBuiltinInfix(c *parser.BuiltinInfixContext) {
 }
 
 func (l *FaultListener) ExitStartPair(c *parser.StartPairContext) {
-	idents := c.AllIDENT()
 	start := &ast.InfixExpression{
-		Left:     &ast.StringLiteral{Value: idents[0].GetText()},
+		Left:     &ast.StringLiteral{Value: c.IDENT(0).GetText()},
 		Operator: ":",
-		Right:    &ast.StringLiteral{Value: idents[1].GetText()},
+		Right:    &ast.StringLiteral{Value: c.GetStop().GetText()},
 	}
 	l.push(start)
 
@@ -1990,17 +2335,18 @@ func (l *FaultListener) sortSwaps(swaps []ast.Node) {
 	}
 }
 
-func (l *FaultListener) addSwaps() {
+func (l *FaultListener) addSwaps() error {
 	for key, inst := range l.instances {
 		if sw, ok := l.swaps[key]; ok {
 			c, err := deepcopy.Anything(sw)
 			if err != nil {
-				panic(err)
+				return err
 			}
 			inst.Swaps = c.([]ast.Node)
 			l.swaps[key] = []ast.Node{}
 		}
 	}
+	return nil
 }
 
 func (l *FaultListener) builtInType(b *ast.BuiltIn) string {
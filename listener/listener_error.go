@@ -3,6 +3,7 @@ package listener
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
@@ -11,16 +12,117 @@ import (
 type FaultErrorListener struct {
 	*antlr.DefaultErrorListener
 	Filename string
+	Errors   []*SyntaxError
+	source   []string
+}
+
+type SyntaxError struct {
+	Filename  string
+	Line      int
+	Column    int
+	EndColumn int // Column just past the offending token
+	Symbol    string
+	Msg       string
+	Expected  []string
+	Excerpt   string // Source line with a caret under the offending token
+}
+
+// Every syntax error found in a spec and its imports
+type SyntaxErrors []*SyntaxError
+
+func NewFaultErrorListener(filename string, source string) *FaultErrorListener {
+	return &FaultErrorListener{
+		DefaultErrorListener: antlr.NewDefaultErrorListener(),
+		Filename:             filename,
+		source:               strings.Split(source, "\n"),
+	}
+}
+
+func (e *SyntaxError) Error() string {
+	file := strings.Split(e.Filename, string(os.PathSeparator))
+	if e.Symbol == "" {
+		return fmt.Sprintf("Invalid spec syntax on line %d col %d in spec %s", e.Line, e.Column, file[len(file)-1])
+	}
+	return fmt.Sprintf("Invalid spec syntax %s on line %d col %d in spec %s", e.Symbol, e.Line, e.Column, file[len(file)-1])
+}
+
+// Detail is the full diagnostic: position, the parser's
+// message and the excerpt
+func (e *SyntaxError) Detail() string {
+	return fmt.Sprintf("%s:%d:%d: %s\n%s", e.Filename, e.Line, e.Column, e.Msg, e.Excerpt)
+}
+
+func (errs SyntaxErrors) Error() string {
+	var out []string
+	for _, e := range errs {
+		out = append(out, e.Detail())
+	}
+	return strings.Join(out, "\n")
 }
 
 func (f *FaultErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	file := strings.Split(f.Filename, string(os.PathSeparator))
+	err := &SyntaxError{
+		Filename:  f.Filename,
+		Line:      line,
+		Column:    column,
+		EndColumn: column + 1,
+		Msg:       msg,
+		Expected:  expectedTokens(msg),
+	}
 
-	sym, ok := offendingSymbol.(antlr.Token)
-	if !ok {
-		panic(fmt.Sprintf("Invalid spec syntax on line %d col %d in spec %s", line, column, file[len(file)-1]))
-	} else {
-		panic(fmt.Sprintf("Invalid spec syntax %s on line %d col %d in spec %s", sym.GetText(), line, column, file[len(file)-1]))
+	if sym, ok := offendingSymbol.(antlr.Token); ok {
+		err.Symbol = sym.GetText()
+		if sym.GetTokenType() != antlr.TokenEOF && sym.GetStop() >= sym.GetStart() {
+			err.EndColumn = column + sym.GetStop() - sym.GetStart() + 1
+		}
 	}
+	err.Excerpt = f.excerpt(line, column, err.EndColumn)
+	f.Errors = append(f.Errors, err)
+}
+
+func (f *FaultErrorListener) excerpt(line int, start int, end int) string {
+	if line < 1 || line > len(f.source) {
+		return ""
+	}
+
+	src := strings.TrimRight(f.source[line-1], "\r")
+	gutter := fmt.Sprintf("%4d | ", line)
+
+	// Keep tabs so the caret lines up with the source
+	var pad strings.Builder
+	for i, r := range []rune(src) {
+		if i >= start {
+			break
+		}
+		if r == '\t' {
+			pad.WriteRune('\t')
+		} else {
+			pad.WriteRune(' ')
+		}
+	}
+
+	if n := len([]rune(src)); start > n {
+		pad.WriteString(strings.Repeat(" ", start-n))
+	}
+
+	margin := strings.Repeat(" ", len(gutter)-2) + "| "
+	return fmt.Sprintf("%s%s\n%s%s%s", gutter, src, margin, pad.String(), strings.Repeat("^", end-start))
+}
+
+var expecting = regexp.MustCompile(`expecting (.*)$`)
+var missing = regexp.MustCompile(`^missing (.*) at `)
 
+func expectedTokens(msg string) []string {
+	if m := expecting.FindStringSubmatch(msg); m != nil {
+		set := m[1]
+		if strings.HasPrefix(set, "{") && strings.HasSuffix(set, "}") {
+			return strings.Split(set[1:len(set)-1], ", ")
+		}
+		return []string{set}
+	}
+
+	if m := missing.FindStringSubmatch(msg); m != nil {
+		return []string{m[1]}
+	}
+	return nil
 }
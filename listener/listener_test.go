@@ -1,7 +1,10 @@
 package listener
 
 import (
+	"errors"
 	"fault/ast"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -1519,6 +1522,55 @@ func TestDeclaredType(t *testing.T) {
 	}
 }
 
+func TestUncertainDistributions(t *testing.T) {
+	test := `spec test1;
+			 const a = uncertain(exponential, 0.5);
+			 const b = uncertain(poisson, 4, 0, 10);
+			 const c = uncertain(normal, 10, 2);
+			`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	l, spec := prepTest(test, flags)
+
+	tests := []struct {
+		dist      string
+		params    []float64
+		truncated bool
+	}{
+		{"exponential", []float64{0.5}, false},
+		{"poisson", []float64{4}, true},
+		{"normal", []float64{10, 2}, false},
+	}
+
+	for i, test := range tests {
+		con, ok := spec.Statements[i+1].(*ast.ConstantStatement)
+		if !ok {
+			t.Fatalf("spec.Statements[%d] is not a ConstantStatement. got=%T", i+1, spec.Statements[i+1])
+		}
+
+		u, ok := con.Value.(*ast.Uncertain)
+		if !ok {
+			t.Fatalf("Constant is not an Uncertain. got=%T", con.Value)
+		}
+
+		d := u.Distribution()
+		if d.Kind != test.dist || len(d.Params) != len(test.params) || d.Truncated != test.truncated {
+			t.Fatalf("Uncertain has the wrong distribution. want=%s got=%s", test.dist, d)
+		}
+
+		for j, p := range test.params {
+			if d.Params[j] != p {
+				t.Fatalf("%s parameter %d is wrong. want=%f got=%f", test.dist, j, p, d.Params[j])
+			}
+		}
+	}
+
+	b := l.Uncertains["test1_b"]
+	if b == nil || b.Low != 0 || b.High != 10 {
+		t.Fatalf("Truncated uncertain b not indexed. got=%v", b)
+	}
+}
+
 func TestInstanceOrder(t *testing.T) {
 	test := `spec test1;
 
@@ -1930,6 +1982,124 @@ func TestSysStart(t *testing.T) {
 
 }
 
+func TestSyntaxErrors(t *testing.T) {
+	test := `spec test1;
+	const a = ;
+	const b = 2 +;
+	`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	_, err := Parse(test, "", "test1.fspec", flags)
+
+	errs, ok := err.(SyntaxErrors)
+	if !ok {
+		t.Fatalf("syntax errors not returned. got=%T %s", err, err)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("wrong number of syntax errors. want=2 got=%d", len(errs))
+	}
+
+	if errs[0].Line != 2 || errs[0].Column != 11 || errs[0].EndColumn != 12 || errs[0].Filename != "test1.fspec" {
+		t.Fatalf("syntax error position not correct. got=%+v", errs[0])
+	}
+
+	if len(errs[1].Expected) != 1 || errs[1].Expected[0] != "';'" {
+		t.Fatalf("syntax error expected tokens not correct. got=%s", errs[1].Expected)
+	}
+
+	excerpt := "   3 | \tconst b = 2 +;\n     | \t            ^"
+	if errs[1].Excerpt != excerpt {
+		t.Fatalf("syntax error excerpt not correct. want=%q got=%q", excerpt, errs[1].Excerpt)
+	}
+}
+
+func TestImportSyntaxErrors(t *testing.T) {
+	dir := t.TempDir()
+	imported := `spec imported;
+	def s = stock{
+		a: 2 2,
+	};
+	`
+	if err := os.WriteFile(filepath.Join(dir, "imported.fspec"), []byte(imported), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	test := `system test1;
+	import ("imported.fspec");
+	`
+	flags := make(map[string]bool)
+	flags["specType"] = false
+	_, err := Parse(test, dir, filepath.Join(dir, "test1.fspec"), flags)
+
+	errs, ok := err.(SyntaxErrors)
+	if !ok {
+		t.Fatalf("syntax errors not returned. got=%T %s", err, err)
+	}
+
+	if len(errs) != 1 {
+		t.Fatalf("wrong number of syntax errors. want=1 got=%d %s", len(errs), errs)
+	}
+
+	if filepath.Base(errs[0].Filename) != "imported.fspec" {
+		t.Fatalf("syntax error not reported against the imported spec. got=%s", errs[0].Filename)
+	}
+
+	if errs[0].Line != 3 {
+		t.Fatalf("import syntax error line not correct. got=%d", errs[0].Line)
+	}
+}
+
+func TestExecuteCollectsSyntaxErrors(t *testing.T) {
+	test := `spec test1;
+	const a = 2 2;
+	`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	flags["testing"] = true
+	l := Execute(test, "", flags)
+
+	errs := l.SyntaxErrors()
+	if len(errs) != 1 {
+		t.Fatalf("wrong number of syntax errors. want=1 got=%d %s", len(errs), errs)
+	}
+
+	if errs[0].Line != 2 || errs[0].Column != 13 {
+		t.Fatalf("syntax error position not correct. got=%+v", errs[0])
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: uncertain(zeta, 1, 2),
+	};
+	`
+	flags := make(map[string]bool)
+	flags["specType"] = true
+	_, err := Parse(test, "", "test1.fspec", flags)
+
+	var e *ast.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("positioned error not returned. got=%T %s", err, err)
+	}
+
+	if e.Msg != "unknown distribution zeta for type uncertain" {
+		t.Fatalf("error message not correct. got=%s", e.Msg)
+	}
+
+	if e.Line() != 3 || e.Col() != 5 {
+		t.Fatalf("error position not correct. got=%v", e.Position)
+	}
+
+	test = `spec test1;
+	`
+	_, err = Parse(test, "", "test1.fspec", flags)
+	if !errors.As(err, &e) || e.Msg != malformed {
+		t.Fatalf("malformed spec not returned as an error. got=%T %s", err, err)
+	}
+}
+
 func prepTest(test string, flags map[string]bool) (*FaultListener, *ast.Spec) {
 	flags["testing"] = true
 	listener := Execute(test, "", flags)
@@ -1,6 +1,7 @@
 package llvm
 
 import (
+	"fault/ast"
 	"fmt"
 	"strings"
 
@@ -20,8 +21,18 @@ func (c *Compiler) updateVariableStateName(id []string) string {
 	return fmt.Sprint(strings.Join(id, "_"), incr+1)
 }
 
-func (c *Compiler) allocVariable(id []string, val value.Value, pos []int) {
+// locate records where a variable is declared or changed, the
+// first place seen wins
+func locate(positions map[string][]int, id []string, pos []int) {
 	name := strings.Join(id, "_")
+	if _, ok := positions[name]; !ok && len(pos) > 1 && pos[0] > 0 {
+		positions[name] = pos
+	}
+}
+
+func (c *Compiler) allocVariable(id []string, val value.Value, pos []int) error {
+	name := strings.Join(id, "_")
+	locate(c.Declared, id, pos)
 
 	var alloc *ir.InstAlloca
 	var store *ir.InstStore
@@ -41,7 +52,7 @@ func (c *Compiler) allocVariable(id []string, val value.Value, pos []int) {
 		alloc.SetName(name)
 		store = c.contextBlock.NewStore(v, alloc)
 	case *constant.Null:
-		return //Figure out what to do here
+		return nil //Figure out what to do here
 	case *ir.InstFAdd:
 		alloc = c.contextBlock.NewAlloca(irtypes.Double)
 		alloc.SetName(name)
@@ -85,9 +96,9 @@ func (c *Compiler) allocVariable(id []string, val value.Value, pos []int) {
 		}
 		store = c.contextBlock.NewStore(v, alloc)
 	case *ir.Func:
-		return
+		return nil
 	default:
-		panic(fmt.Sprintf("unknown variable type %T line: %d col: %d", v, pos[0], pos[1]))
+		return ast.Errorf(pos, "unknown variable type %T", v)
 	}
 
 	//Other metadata
@@ -96,10 +107,12 @@ func (c *Compiler) allocVariable(id []string, val value.Value, pos []int) {
 	}
 
 	c.storeAllocation(name, id, alloc)
+	return nil
 }
 
-func (c *Compiler) globalVariable(id []string, val value.Value, pos []int) {
+func (c *Compiler) globalVariable(id []string, val value.Value, pos []int) error {
 	name := c.updateVariableStateName(id)
+	locate(c.Declared, id, pos)
 
 	switch v := val.(type) {
 	case *constant.CharArray:
@@ -115,19 +128,19 @@ func (c *Compiler) globalVariable(id []string, val value.Value, pos []int) {
 		alloc := c.module.NewGlobalDef(name, val.(constant.Constant))
 		c.storeGlobal(name, alloc)
 	case *ir.InstFAdd:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstFSub:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstFMul:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstFDiv:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstFRem:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstICmp:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.InstFCmp:
-		c.allocVariable(id, val, pos)
+		return c.allocVariable(id, val, pos)
 	case *ir.Func:
 	case *ir.InstAnd:
 		placeholder := constant.NewAnd(v.X.(constant.Expression), v.Y.(constant.Expression))
@@ -138,9 +151,9 @@ func (c *Compiler) globalVariable(id []string, val value.Value, pos []int) {
 		alloc := c.module.NewGlobalDef(name, placeholder)
 		c.storeGlobal(name, alloc)
 	default:
-		panic(fmt.Sprintf("unknown variable type %T line: %d col: %d", v, pos[0], pos[1]))
+		return ast.Errorf(pos, "unknown variable type %T", v)
 	}
-
+	return nil
 }
 
 func (c *Compiler) storeAllocation(name string, id []string, alloc *ir.InstAlloca) {
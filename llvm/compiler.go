@@ -52,22 +52,28 @@ type Compiler struct {
 	// Where a condition should jump when done
 	contextCondAfter []*ir.Block
 
-	builtIns       map[string]*ir.Func
-	specStructs    map[string]*preprocess.SpecRecord
-	specFunctions  map[string]value.Value
-	specGlobals    map[string]*ir.Global
-	sysGlobals     []*ir.Param
-	RawAsserts     []*ast.AssertionStatement
-	RawAssumes     []*ast.AssertionStatement
-	Asserts        []*ast.AssertionStatement
-	Assumes        []*ast.AssertionStatement
-	Uncertains     map[string][]float64
-	Unknowns       []string
-	Components     map[string]*StateFunc
-	ComponentOrder []string
-	States         map[string]bool
-	Alias          map[string]string
-	StringRules    map[string]string
+	builtIns         map[string]*ir.Func
+	specStructs      map[string]*preprocess.SpecRecord
+	specFunctions    map[string]value.Value
+	specGlobals      map[string]*ir.Global
+	sysGlobals       []*ir.Param
+	RawAsserts       []*ast.AssertionStatement
+	RawAssumes       []*ast.AssertionStatement
+	Asserts          []*ast.AssertionStatement
+	Assumes          []*ast.AssertionStatement
+	RawProbabilities []*ast.AssertionStatement // prob() asserts, kept out of the SMT
+	Probabilities    []*ast.AssertionStatement
+	RawObjectives    []*ast.OptimizeStatement // maximize and minimize queries
+	Objectives       []*ast.OptimizeStatement
+	Uncertains       map[string]*ast.Distribution
+	Unknowns         []string
+	Components       map[string]*StateFunc
+	ComponentOrder   []string
+	States           map[string]bool
+	Alias            map[string]string
+	Declared         map[string][]int // Where each variable gets its starting value, by name
+	Changed          map[string][]int // First place each variable is changed in, by name
+	StringRules      map[string]string
 }
 
 func NewCompiler() *Compiler {
@@ -90,16 +96,18 @@ func NewCompiler() *Compiler {
 		specStructs:   make(map[string]*preprocess.SpecRecord),
 		specFunctions: make(map[string]value.Value),
 		specGlobals:   make(map[string]*ir.Global),
-		Uncertains:    make(map[string][]float64),
+		Uncertains:    make(map[string]*ast.Distribution),
 		Components:    make(map[string]*StateFunc),
 		States:        make(map[string]bool),
 		StringRules:   make(map[string]string),
+		Declared:      make(map[string][]int),
+		Changed:       make(map[string][]int),
 	}
 	c.setup()
 	return c
 }
 
-func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertains map[string][]float64, unknowns []string, aliases map[string]string, testing bool) *Compiler {
+func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertains map[string]*ast.Distribution, unknowns []string, aliases map[string]string, testing bool) *Compiler {
 	compiler := NewCompiler()
 	compiler.LoadMeta(specRec, uncertains, unknowns, aliases, testing)
 
@@ -110,7 +118,7 @@ func Execute(tree *ast.Spec, specRec map[string]*preprocess.SpecRecord, uncertai
 	return compiler
 }
 
-func (c *Compiler) LoadMeta(structs map[string]*preprocess.SpecRecord, uncertains map[string][]float64, unknowns []string, aliases map[string]string, test bool) {
+func (c *Compiler) LoadMeta(structs map[string]*preprocess.SpecRecord, uncertains map[string]*ast.Distribution, unknowns []string, aliases map[string]string, test bool) {
 
 	c.specStructs = structs
 	c.Unknowns = unknowns
@@ -136,8 +144,7 @@ func (c *Compiler) Compile(root ast.Node) (err error) {
 		}
 	}()
 
-	c.processSpec(root)
-	return
+	return c.processSpec(root)
 }
 
 func (c *Compiler) validate(specfile *ast.Spec) {
@@ -167,10 +174,10 @@ func (c *Compiler) validate(specfile *ast.Spec) {
 	}
 }
 
-func (c *Compiler) processSpec(root ast.Node) ([]*ast.AssertionStatement, []*ast.AssertionStatement) {
+func (c *Compiler) processSpec(root ast.Node) error {
 	specfile, ok := root.(*ast.Spec)
 	if !ok {
-		panic(fmt.Sprintf("spec file improperly formatted. Root node is %T", root))
+		return fmt.Errorf("spec file improperly formatted. Root node is %T", root)
 	}
 
 	c.validate(specfile)
@@ -184,28 +191,43 @@ func (c *Compiler) processSpec(root ast.Node) ([]*ast.AssertionStatement, []*ast
 			for _, v := range specfile.Statements {
 				switch n := v.(type) {
 				case *ast.ConstantStatement:
-					c.compileConstant(n)
+					if err := c.compileConstant(n); err != nil {
+						return err
+					}
 				case *ast.AssertionStatement:
-					c.compile(n)
+					if err := c.compile(n); err != nil {
+						return err
+					}
 				case *ast.DefStatement:
 					switch d := n.Value.(type) {
 					case *ast.StringLiteral:
-						value := c.compileValue(d)
+						value, err := c.compileValue(d)
+						if err != nil {
+							return err
+						}
 						rawid := d.RawId()
 						s := c.specs[rawid[0]]
 						c.StringRules[d.IdString()] = d.Value
 						s.DefineSpecType(rawid, value.Type())
-						c.globalVariable(rawid, value, d.Position())
+						if err := c.globalVariable(rawid, value, d.Position()); err != nil {
+							return err
+						}
 					case *ast.InfixExpression:
 						if n.Value.TokenLiteral() == "COMPOUND_STRING" {
 							name := n.Name.IdString()
-							r := c.compileCompoundGlobal(name, n.Value.(*ast.InfixExpression))
+							r, err := c.compileCompoundGlobal(name, n.Value.(*ast.InfixExpression))
+							if err != nil {
+								return err
+							}
 							c.storeGlobal(name, r)
 						}
 					case *ast.PrefixExpression:
 						if n.Value.TokenLiteral() == "COMPOUND_STRING" {
 							name := n.Name.IdString()
-							r := c.compileCompoundGlobal(name, n.Value.(*ast.InfixExpression))
+							r, err := c.compileCompoundGlobal(name, n.Value.(*ast.InfixExpression))
+							if err != nil {
+								return err
+							}
 							c.storeGlobal(name, r)
 						}
 					}
@@ -236,7 +258,7 @@ func (c *Compiler) processSpec(root ast.Node) ([]*ast.AssertionStatement, []*ast
 					c.instances[parent] = append(c.instances[parent], key)
 					children, err := s.FetchInstanceStrMap(name, d.Parent[1], ty)
 					if err != nil {
-						panic(err)
+						return err
 					}
 					c.instanceChildren = util.MergeStringMaps(c.instanceChildren, children)
 				case *ast.ComponentLiteral:
@@ -245,21 +267,26 @@ func (c *Compiler) processSpec(root ast.Node) ([]*ast.AssertionStatement, []*ast
 					s := c.specStructs[id[0]]
 					branches, err := s.FetchComponent(id[1])
 					if err != nil {
-						panic(err)
+						return err
+					}
+					params, err := c.generateParameters(d.Id(), branches, true)
+					if err != nil {
+						return err
 					}
-					params := c.generateParameters(d.Id(), branches, true)
 					c.sysGlobals = append(c.sysGlobals, params...)
 				}
 
 			}
 		}
 	default:
-		panic(fmt.Sprintf("spec file improperly formatted. Missing spec declaration, got %T", specfile.Statements[0]))
+		return ast.Errorf(specfile.Statements[0].Position(), "spec file improperly formatted. Missing spec declaration, got %T", specfile.Statements[0])
 	}
 
 	if !c.isImport && c.IsValid { //Don't compile if the spec is being imported
 		for _, fileNode := range specfile.Statements {
-			c.compile(fileNode)
+			if err := c.compile(fileNode); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -267,23 +294,44 @@ func (c *Compiler) processSpec(root ast.Node) ([]*ast.AssertionStatement, []*ast
 		for _, assert := range c.RawAsserts {
 			a, err := deepcopy.Anything(assert)
 			if err != nil {
-				panic(err)
+				return err
+			}
+			if err := c.compileAssert(a.(*ast.AssertionStatement)); err != nil {
+				return err
 			}
-			c.compileAssert(a.(*ast.AssertionStatement))
 		}
 		for _, assert := range c.RawAssumes {
 			a, err := deepcopy.Anything(assert)
 			if err != nil {
-				panic(err)
+				return err
+			}
+			if err := c.compileAssert(a.(*ast.AssertionStatement)); err != nil {
+				return err
+			}
+		}
+		for _, assert := range c.RawProbabilities {
+			a, err := deepcopy.Anything(assert)
+			if err != nil {
+				return err
+			}
+			if err := c.compileProbability(a.(*ast.AssertionStatement)); err != nil {
+				return err
+			}
+		}
+		for _, obj := range c.RawObjectives {
+			o, err := deepcopy.Anything(obj)
+			if err != nil {
+				return err
+			}
+			if err := c.compileObjective(o.(*ast.OptimizeStatement)); err != nil {
+				return err
 			}
-			c.compileAssert(a.(*ast.AssertionStatement))
 		}
 	}
-
-	return c.Asserts, c.Assumes
+	return nil
 }
 
-func (c *Compiler) compile(node ast.Node) {
+func (c *Compiler) compile(node ast.Node) error {
 	switch v := node.(type) {
 	case *ast.SpecDeclStatement:
 		break
@@ -293,51 +341,69 @@ func (c *Compiler) compile(node ast.Node) {
 		parent := c.currentSpec
 		c.isImport = true
 		//asserts, assumes := c.processSpec(v.Tree) //Move all asserts to the end of the compilation process
-		c.processSpec(v.Tree)
+		if err := c.processSpec(v.Tree); err != nil {
+			return err
+		}
 		c.isImport = false
 		//c.Asserts = append(c.Asserts, asserts...)
 		//c.Assumes = append(c.Assumes, assumes...)
 		c.currentSpec = parent
 	case *ast.ConstantStatement:
-		c.compileConstant(v)
+		return c.compileConstant(v)
 	case *ast.DefStatement:
 		switch v.Value.(type) {
 		case *ast.FlowLiteral, *ast.StockLiteral, *ast.ComponentLiteral, *ast.StructInstance:
-			c.compileStruct(v)
+			return c.compileStruct(v)
 		case *ast.StringLiteral:
-			value := c.compileValue(v.Value)
+			value, err := c.compileValue(v.Value)
+			if err != nil {
+				return err
+			}
 			rawid := v.Name.RawId()
 			s := c.specs[rawid[0]]
 			c.StringRules[v.Name.IdString()] = v.Value.String()
 			s.DefineSpecVar(rawid, value)
 			s.DefineSpecType(rawid, value.Type())
-			c.globalVariable(rawid, value, v.Position())
+			return c.globalVariable(rawid, value, v.Position())
 		case *ast.InfixExpression:
 			if v.Value.TokenLiteral() == "COMPOUND_STRING" {
 				name := v.Name.IdString()
-				r := c.compileCompoundGlobal(name, v.Value.(*ast.InfixExpression))
+				r, err := c.compileCompoundGlobal(name, v.Value.(*ast.InfixExpression))
+				if err != nil {
+					return err
+				}
 				c.storeGlobal(name, r)
 			}
 		case *ast.PrefixExpression:
 			if v.Value.TokenLiteral() == "COMPOUND_STRING" {
 				name := v.Name.IdString()
-				r := c.compileCompoundGlobal(name, v.Value.(*ast.InfixExpression))
+				r, err := c.compileCompoundGlobal(name, v.Value.(*ast.InfixExpression))
+				if err != nil {
+					return err
+				}
 				c.storeGlobal(name, r)
 			}
 		}
 	case *ast.FunctionLiteral:
 
 	case *ast.ExpressionStatement:
-		c.compile(v.Expression)
+		return c.compile(v.Expression)
 
 	case *ast.InfixExpression:
-		c.compileInfix(v)
+		_, err := c.compileInfix(v)
+		return err
 
 	case *ast.PrefixExpression:
-		c.compilePrefix(v)
+		_, err := c.compilePrefix(v)
+		return err
+
+	case *ast.OptimizeStatement:
+		c.RawObjectives = append(c.RawObjectives, v)
 
 	case *ast.AssertionStatement:
-		if v.Assume {
+		if v.IsProbabilistic() {
+			c.RawProbabilities = append(c.RawProbabilities, v)
+		} else if v.Assume {
 			c.RawAssumes = append(c.RawAssumes, v)
 		} else {
 			c.RawAsserts = append(c.RawAsserts, v)
@@ -349,9 +415,13 @@ func (c *Compiler) compile(node ast.Node) {
 		for i := int64(0); i < v.Rounds.Value; i++ {
 			c.contextBlock.NewStore(constant.NewInt(irtypes.I16, int64(c.RunRound)), c.markers[0])
 			if i == 0 {
-				c.compileBlock(v.Inits)
+				if _, err := c.compileBlock(v.Inits); err != nil {
+					return err
+				}
+			}
+			if _, err := c.compileBlock(v.Body); err != nil {
+				return err
 			}
-			c.compileBlock(v.Body)
 			c.stateCheck()
 			c.RunRound = c.RunRound + 1
 		}
@@ -362,18 +432,21 @@ func (c *Compiler) compile(node ast.Node) {
 		for _, p := range v.Pairs {
 			branch, err := c.specStructs[c.currentSpec].FetchComponent(p[0])
 			if err != nil {
-				panic(err)
+				return ast.Errorf(v.Position(), "%s", err)
 			}
 
 			node, ok := branch[p[1]].(*ast.FunctionLiteral)
 			if !ok {
-				panic(fmt.Errorf("component state %s not valid", p))
+				return ast.Errorf(v.Position(), "component state %s not valid", p)
 			}
 
 			rawid := node.RawId()
 
 			if c.isVarSet(rawid) && c.alloc {
-				r := c.compileValue(&ast.Boolean{Value: true, ProcessedName: rawid})
+				r, err := c.compileValue(&ast.Boolean{Value: true, ProcessedName: rawid})
+				if err != nil {
+					return err
+				}
 				s := c.specs[c.currentSpec]
 				p := s.GetSpecVarPointer(rawid)
 				c.contextBlock.NewStore(r, p)
@@ -388,23 +461,26 @@ func (c *Compiler) compile(node ast.Node) {
 		}
 
 	default:
-		pos := node.Position()
-		panic(fmt.Sprintf("node type %T unimplemented line: %d col: %d", v, pos[0], pos[1]))
+		return ast.Errorf(node.Position(), "node type %T unimplemented", v)
 	}
+	return nil
 }
 
-func (c *Compiler) compileConstant(node *ast.ConstantStatement) {
-	value := c.compileValue(node.Value)
+func (c *Compiler) compileConstant(node *ast.ConstantStatement) error {
+	value, err := c.compileValue(node.Value)
+	if err != nil {
+		return err
+	}
 	id := []string{c.currentSpec, node.Name.Value}
 	c.setConst(id, value)
-	c.globalVariable(id, value, node.Position())
+	return c.globalVariable(id, value, node.Position())
 }
 
 func (c *Compiler) setConst(rawid []string, val value.Value) {
 	c.specs[c.currentSpec].DefineSpecType(rawid, val.Type())
 }
 
-func (c *Compiler) compileStruct(def *ast.DefStatement) {
+func (c *Compiler) compileStruct(def *ast.DefStatement) error {
 	id := def.Name.Id()
 	key := strings.Join(id, "_")
 	switch def.Type() {
@@ -420,7 +496,9 @@ func (c *Compiler) compileStruct(def *ast.DefStatement) {
 		instance, _ := def.Value.(*ast.StructInstance)
 		context := c.contextFuncName
 		c.contextFuncName = "__run"
-		c.compileInstance(instance)
+		if err := c.compileInstance(instance); err != nil {
+			return err
+		}
 		c.contextFuncName = context
 
 		rawid := c.AliasToBaseRaw(instance.RawId())
@@ -429,41 +507,48 @@ func (c *Compiler) compileStruct(def *ast.DefStatement) {
 		n := strings.Join(rawid[1:], "_")
 		branches, err := s.Fetch(n, ty)
 		if err != nil {
-			panic(err)
+			return ast.Errorf(def.Position(), "%s", err)
+		}
+		params, err := c.generateParameters(instance.Id(), branches, false)
+		if err != nil {
+			return err
 		}
-		params := c.generateParameters(instance.Id(), branches, false)
 		c.sysGlobals = append(c.sysGlobals, params...)
 	case "COMPONENT":
 		c.instances[key] = []string{key}
 		c.structPropOrder[key] = def.Value.(*ast.ComponentLiteral).Order
-		c.compileComponent(def.Value.(*ast.ComponentLiteral))
+		return c.compileComponent(def.Value.(*ast.ComponentLiteral))
 	}
+	return nil
 }
 
-func (c *Compiler) compileValue(node ast.Node) value.Value {
+func (c *Compiler) compileValue(node ast.Node) (value.Value, error) {
 	if node == nil {
-		panic("value received by compileValue is nil")
+		return nil, fmt.Errorf("value received by compileValue is nil")
 	}
 	switch v := node.(type) {
 	case *ast.IntegerLiteral:
-		return constant.NewFloat(irtypes.Double, float64(v.Value))
+		return constant.NewFloat(irtypes.Double, float64(v.Value)), nil
 	case *ast.FloatLiteral:
-		return constant.NewFloat(irtypes.Double, v.Value)
+		return constant.NewFloat(irtypes.Double, v.Value), nil
 	case *ast.StringLiteral:
-		return constant.NewBool(false)
+		return constant.NewBool(false), nil
 		//return constant.NewCharArrayFromString(v.Value)
 	case *ast.Boolean:
-		return constant.NewBool(v.Value)
+		return constant.NewBool(v.Value), nil
 	case *ast.Natural:
-		return constant.NewFloat(irtypes.Double, float64(v.Value))
+		return constant.NewFloat(irtypes.Double, float64(v.Value)), nil
 	case *ast.Uncertain: //Set to dummy value for LLVM IR, catch during SMT generation
-		return constant.NewFloat(irtypes.Double, float64(0.000000000009))
+		if v.Dist == "bernoulli" {
+			return constant.NewBool(false), nil
+		}
+		return constant.NewFloat(irtypes.Double, float64(0.000000000009)), nil
 	case *ast.Unknown:
-		return constant.NewFloat(irtypes.Double, float64(0.000000000009))
+		return constant.NewFloat(irtypes.Double, float64(0.000000000009)), nil
 	case *ast.Nil:
-		return constant.NewNull(&irtypes.PointerType{})
+		return constant.NewNull(&irtypes.PointerType{}), nil
 	case *ast.Identifier:
-		return c.compileIdent(v)
+		return c.compileIdent(v), nil
 	case *ast.InfixExpression:
 		return c.compileInfix(v)
 	case *ast.PrefixExpression:
@@ -471,27 +556,25 @@ func (c *Compiler) compileValue(node ast.Node) value.Value {
 	case *ast.FunctionLiteral:
 		return c.compileFunction(v)
 	case *ast.StructInstance:
-		c.compileInstance(v)
+		return nil, c.compileInstance(v)
 	case *ast.ParameterCall:
 		return c.compileParameterCall(v)
 	case *ast.BlockStatement:
 		return c.compileBlock(v)
 	case *ast.This:
-		return c.compileThis(v)
+		return c.compileThis(v), nil
 	case *ast.BuiltIn:
 		return c.compileFunction(v)
 	case *ast.IndexExpression:
 		return c.compileIndex(v)
 	default:
-		pos := v.Position()
-		panic(fmt.Sprintf("unknown value type %T line: %d col: %d", v, pos[0], pos[1]))
+		return nil, ast.Errorf(v.Position(), "unknown value type %T", v)
 	}
-	return nil
 }
 
-func (c *Compiler) compileInstance(node *ast.StructInstance) {
+func (c *Compiler) compileInstance(node *ast.StructInstance) error {
 	if c.RunRound > 0 { // Initialize things only once
-		return
+		return nil
 	}
 	if c.contextFuncName == "__run" {
 		c.alloc = false
@@ -501,16 +584,19 @@ func (c *Compiler) compileInstance(node *ast.StructInstance) {
 
 	id := node.Id()
 	parent := strings.Join(node.Parent, "_")
-	pos := node.Position()
 	children := make(map[string]string)
 
+	var err error
 	switch node.Type() {
 	case "STOCK":
-		children = c.processStruct(node)
+		children, err = c.processStruct(node)
 	case "FLOW":
-		children = c.processStruct(node)
+		children, err = c.processStruct(node)
 	default:
-		panic(fmt.Sprintf("no stock or flow named %s, line: %d, col %d", id, pos[0], pos[1]))
+		return ast.Errorf(node.Position(), "no stock or flow named %s", id)
+	}
+	if err != nil {
+		return err
 	}
 	key := strings.Join(id, "_")
 	c.structPropOrder[key] = node.Order
@@ -522,14 +608,15 @@ func (c *Compiler) compileInstance(node *ast.StructInstance) {
 	if c.contextFuncName == "__run" {
 		c.alloc = true
 	}
+	return nil
 }
 
-func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
+func (c *Compiler) compileComponent(node *ast.ComponentLiteral) error {
 	id := node.Id()
 	spec := c.specStructs[id[0]]
 	tree, err := spec.FetchComponent(id[1])
 	if err != nil {
-		panic(err)
+		return ast.Errorf(node.Position(), "%s", err)
 	}
 
 	for _, k := range node.Order {
@@ -547,7 +634,10 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 			//These functions are treated as booleans too
 			//initialize them as false first
 			b := &ast.Boolean{Value: false, ProcessedName: v.ProcessedName}
-			val := c.compileValue(b)
+			val, err := c.compileValue(b)
+			if err != nil {
+				return err
+			}
 
 			if val != nil {
 				rawid := b.RawId()
@@ -560,7 +650,9 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 				} else {
 					s.DefineSpecType(rawid, val.Type())
 					s.DefineSpecVar(rawid, val)
-					c.allocVariable(rawid, val, []int{0, 0, 0, 0})
+					if err := c.allocVariable(rawid, val, []int{0, 0, 0, 0}); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -582,14 +674,20 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 			c.States[v.IdString()] = true
 			c.Components[childId] = &StateFunc{Id: v.Id(), Func: f}
 			c.ComponentOrder = append(c.ComponentOrder, childId)
-			val2 := c.compileBlock(v.Body)
+			val2, err := c.compileBlock(v.Body)
+			if err != nil {
+				return err
+			}
 			c.contextBlock.NewRet(val2)
 			c.contextBlock = oldBlock
 			c.contextFuncName = "__run"
 			c.contextFunc = nil
 
 		default:
-			val := c.compileValue(v)
+			val, err := c.compileValue(v)
+			if err != nil {
+				return err
+			}
 
 			if val != nil {
 				rawid := v.(ast.Nameable).RawId()
@@ -602,14 +700,17 @@ func (c *Compiler) compileComponent(node *ast.ComponentLiteral) {
 				} else {
 					s.DefineSpecType(rawid, val.Type())
 					s.DefineSpecVar(rawid, val)
-					c.allocVariable(rawid, val, []int{0, 0, 0, 0})
+					if err := c.allocVariable(rawid, val, []int{0, 0, 0, 0}); err != nil {
+						return err
+					}
 				}
 			}
 		}
 	}
+	return nil
 }
 
-func (c *Compiler) compileParameterCall(pc *ast.ParameterCall) value.Value {
+func (c *Compiler) compileParameterCall(pc *ast.ParameterCall) (value.Value, error) {
 	var err error
 	id := c.AliasToBaseRaw(pc.RawId())
 	spec := c.specStructs[id[0]]
@@ -621,16 +722,13 @@ func (c *Compiler) compileParameterCall(pc *ast.ParameterCall) value.Value {
 	switch ty {
 	case "FLOW":
 		branches, err = spec.FetchFlow(st)
-		if err != nil {
-			panic(err)
-		}
 	case "STOCK":
 		branches, err = spec.FetchStock(st)
-		if err != nil {
-			panic(err)
-		}
 	default:
-		panic(fmt.Sprintf("struct %s not found", id))
+		return nil, ast.Errorf(pc.Position(), "struct %s not found", id)
+	}
+	if err != nil {
+		return nil, ast.Errorf(pc.Position(), "%s", err)
 	}
 
 	if c.contextFuncName == "__run" &&
@@ -640,7 +738,10 @@ func (c *Compiler) compileParameterCall(pc *ast.ParameterCall) value.Value {
 	parentFunction := c.contextFuncName
 	c.contextFuncName = pc.Value[0]
 
-	val := c.compileValue(branches[key])
+	val, err := c.compileValue(branches[key])
+	if err != nil {
+		return nil, err
+	}
 
 	// If there's no value, there's nothing to store
 	if val != nil || !c.isFunction(branches[key]) {
@@ -653,37 +754,46 @@ func (c *Compiler) compileParameterCall(pc *ast.ParameterCall) value.Value {
 		} else {
 			s.DefineSpecType(id, val.Type())
 			s.DefineSpecVar(id, val)
-			c.allocVariable(id, val, pc.Position())
+			if err := c.allocVariable(id, val, pc.Position()); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	c.contextFuncName = parentFunction
-	return val
+	return val, nil
 }
 
-func (c *Compiler) compileBlock(node *ast.BlockStatement) value.Value {
+func (c *Compiler) compileBlock(node *ast.BlockStatement) (value.Value, error) {
 	if !c.alloc {
-		return nil
+		return nil, nil
 	}
 	body := node.Statements
 	var ret value.Value
+	var err error
 	for i := 0; i < len(body); i++ {
 		switch exp := body[i].(type) {
 		case *ast.ParallelFunctions:
-			c.compileParallel(exp)
+			err = c.compileParallel(exp)
 		case ast.Expression:
-			ret = c.compileFunction(exp)
+			ret, err = c.compileFunction(exp)
 		case *ast.ExpressionStatement:
-			ret = c.compileFunction(exp.Expression)
+			ret, err = c.compileFunction(exp.Expression)
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
-	return ret
+	return ret, nil
 }
 
-func (c *Compiler) compileParallel(node *ast.ParallelFunctions) {
+func (c *Compiler) compileParallel(node *ast.ParallelFunctions) error {
 	gname := name.ParallelGroup(node.String())
 	for i := 0; i < len(node.Expressions); i++ {
-		l := c.compileValue(node.Expressions[i])
+		l, err := c.compileValue(node.Expressions[i])
+		if err != nil {
+			return err
+		}
 		md := &metadata.Attachment{
 			Name: gname,
 			Node: &metadata.DIBasicType{
@@ -712,37 +822,44 @@ func (c *Compiler) compileParallel(node *ast.ParallelFunctions) {
 		}
 	}
 	c.contextMetadata = nil
+	return nil
 }
 
-func (c *Compiler) compileFunction(node ast.Node) value.Value {
+func (c *Compiler) compileFunction(node ast.Node) (value.Value, error) {
 	if !c.alloc { //Short circuit this if just initializing
-		return nil
+		return nil, nil
 	}
 
 	switch v := node.(type) {
 	case *ast.FunctionLiteral:
 		body := v.Body.Statements
 		var ret value.Value
+		var err error
 		for i := 0; i < len(body); i++ {
-			ret = c.compileFunction(body[i])
+			ret, err = c.compileFunction(body[i])
+			if err != nil {
+				return nil, err
+			}
 		}
-		return ret
+		return ret, nil
 	case *ast.ExpressionStatement:
 		return c.compileFunction(v.Expression)
 	case *ast.InfixExpression:
 		return c.compileInfix(v)
 
 	case *ast.PrefixExpression:
-		c.compilePrefix(v)
+		_, err := c.compilePrefix(v)
+		return nil, err
 
 	case *ast.IfExpression:
-		c.compileIf(v)
+		return nil, c.compileIf(v)
 
 	case *ast.StructInstance:
-		c.compileInstance(v)
+		return nil, c.compileInstance(v)
 
 	case *ast.IndexExpression:
-		c.compileIndex(v)
+		_, err := c.compileIndex(v)
+		return nil, err
 
 	case *ast.ParameterCall:
 		return c.compileParameterCall(v)
@@ -773,16 +890,14 @@ func (c *Compiler) compileFunction(node ast.Node) value.Value {
 			params = append(params, cast)
 		}
 
-		return c.contextBlock.NewCall(c.builtIns[v.Function], params...)
+		return c.contextBlock.NewCall(c.builtIns[v.Function], params...), nil
 
 	default:
-		pos := node.Position()
-		panic(fmt.Sprintf("invalid expression %T in function body. line: %d, col:%d", node, pos[0], pos[1]))
+		return nil, ast.Errorf(node.Position(), "invalid expression %T in function body", node)
 	}
-	return nil
 }
 
-func (c *Compiler) compileIndex(node *ast.IndexExpression) *ir.InstLoad {
+func (c *Compiler) compileIndex(node *ast.IndexExpression) (*ir.InstLoad, error) {
 	var value value.Value
 	if node.Left.Type() == "BOOL" {
 		value = constant.NewBool(false)
@@ -790,23 +905,28 @@ func (c *Compiler) compileIndex(node *ast.IndexExpression) *ir.InstLoad {
 		value = constant.NewFloat(irtypes.Double, float64(0.000000000009))
 	}
 	c.setConst(node.RawId(), value)
-	c.globalVariable(node.Id(), value, node.Position())
-	return c.lookupIdent(node.Id(), node.Position())
+	if err := c.globalVariable(node.Id(), value, node.Position()); err != nil {
+		return nil, err
+	}
+	return c.lookupIdent(node.Id(), node.Position()), nil
 }
 
-func (c *Compiler) compilePrefix(node *ast.PrefixExpression) value.Value {
-	val := c.compileInfixNode(node.Right)
+func (c *Compiler) compilePrefix(node *ast.PrefixExpression) (value.Value, error) {
+	val, err := c.compileInfixNode(node.Right)
+	if err != nil {
+		return nil, err
+	}
 	switch node.Operator {
 	case "!":
-		return c.contextBlock.NewXor(val, constant.NewInt(irtypes.I1, 1))
+		return c.contextBlock.NewXor(val, constant.NewInt(irtypes.I1, 1)), nil
 	case "-":
-		return c.contextBlock.NewFNeg(val)
+		return c.contextBlock.NewFNeg(val), nil
 	default:
-		panic(fmt.Sprintf("unrecognized prefix operator %s", node.Operator))
+		return nil, ast.Errorf(node.Position(), "unrecognized prefix operator %s", node.Operator)
 	}
 }
 
-func (c *Compiler) compileInfix(node *ast.InfixExpression) value.Value {
+func (c *Compiler) compileInfix(node *ast.InfixExpression) (value.Value, error) {
 	var s *spec
 	var id []string
 	pos := node.Position()
@@ -818,16 +938,22 @@ func (c *Compiler) compileInfix(node *ast.InfixExpression) value.Value {
 	switch node.Operator {
 	case "=": // Used to store temporary local values
 		if !c.validOperator(node, true) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
 		if node.TokenLiteral() == "COMPOUND_STRING" {
-			r := c.compileCompoundGlobal(node.Left.(ast.Nameable).IdString(), node.Right.(*ast.InfixExpression))
+			r, err := c.compileCompoundGlobal(node.Left.(ast.Nameable).IdString(), node.Right.(*ast.InfixExpression))
+			if err != nil {
+				return nil, err
+			}
 			c.storeGlobal(node.Left.(ast.Nameable).IdString(), r)
-			return nil
+			return nil, nil
 		}
 
-		r := c.compileValue(node.Right)
+		r, err := c.compileValue(node.Right)
+		if err != nil {
+			return nil, err
+		}
 
 		if _, ok := node.Right.(*ast.Instance); !ok { // If declaring a new instance don't save
 			switch n := node.Left.(type) {
@@ -842,202 +968,244 @@ func (c *Compiler) compileInfix(node *ast.InfixExpression) value.Value {
 			if c.isVarSet(id) && c.alloc {
 				p := s.GetSpecVarPointer(id)
 				c.contextBlock.NewStore(r, p)
-				return nil
+				return nil, nil
 			}
 
 			if c.isConstant(id) {
-				panic(fmt.Sprintf("variable %s is a constant and cannot be modified", id[len(id)-1]))
+				return nil, ast.Errorf(pos, "variable %s is a constant and cannot be modified", id[len(id)-1])
 			}
 
 			s.DefineSpecVar(id, r)
 			s.DefineSpecType(id, r.Type())
 			if c.alloc {
-				c.allocVariable(id, r, node.Left.Position())
+				if err := c.allocVariable(id, r, node.Left.Position()); err != nil {
+					return nil, err
+				}
 			}
 		}
-		return nil
+		return nil, nil
 	case "<-":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		r := c.compileValue(node.Right)
+		r, err := c.compileValue(node.Right)
+		if err != nil {
+			return nil, err
+		}
 		n, ok := node.Left.(*ast.ParameterCall)
 		if !ok {
-			pos := node.Position()
-			panic(fmt.Sprintf("cannot use <- or -> operator on a non-stock value col: %d, line: %d", pos[0], pos[1]))
+			return nil, ast.Errorf(pos, "cannot use <- or -> operator on a non-stock value")
 		}
 
-		pos := n.Position()
 		id = c.AliasToBaseRaw(n.RawId())
 
 		s = c.specs[id[0]]
 
 		if !c.isVarSet(id) {
-			panic(fmt.Sprintf("cannot send value to variable %s. Variable not defined line: %d, col: %d", strings.Join(id, "_"), pos[0], pos[1]))
+			return nil, ast.Errorf(n.Position(), "cannot send value to variable %s. Variable not defined", strings.Join(id, "_"))
 		}
 
 		if c.isConstant(id) {
-			panic(fmt.Sprintf("variable %s is a constant and cannot be modified", id[len(id)-1]))
+			return nil, ast.Errorf(n.Position(), "variable %s is a constant and cannot be modified", id[len(id)-1])
 		}
 
 		pointer := s.GetSpecVarPointer(id)
 		c.contextBlock.NewStore(r, pointer)
-		return nil
+		locate(c.Changed, id, pos)
+		return nil, nil
 	case "+":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
-		return c.contextBlock.NewFAdd(l, r)
+		return c.contextBlock.NewFAdd(l, r), nil
 	case "-":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		sub := c.contextBlock.NewFSub(l, r)
-		return sub
+		return sub, nil
 	case "*":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		mul := c.contextBlock.NewFMul(l, r)
-		return mul
+		return mul, nil
 	case "/":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		div := c.contextBlock.NewFDiv(l, r)
-		return div
+		return div, nil
 	case "%":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		rem := c.contextBlock.NewFRem(l, r)
-		return rem
+		return rem, nil
 	case ">":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		ogt := c.contextBlock.NewFCmp(enum.FPredOGT, l, r)
-		return ogt
+		return ogt, nil
 	case ">=":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		oge := c.contextBlock.NewFCmp(enum.FPredOGE, l, r)
-		return oge
+		return oge, nil
 	case "<":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		olt := c.contextBlock.NewFCmp(enum.FPredOLT, l, r)
-		return olt
+		return olt, nil
 	case "<=":
 		if !c.validOperator(node, false) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		ole := c.contextBlock.NewFCmp(enum.FPredOLE, l, r)
-		return ole
+		return ole, nil
 	case "==":
 		if !c.validOperator(node, true) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		if node.Right.Type() == "BOOL" {
-			return c.contextBlock.NewICmp(enum.IPredEQ, l, r)
+			return c.contextBlock.NewICmp(enum.IPredEQ, l, r), nil
 		} else {
-			return c.contextBlock.NewFCmp(enum.FPredOEQ, l, r)
+			return c.contextBlock.NewFCmp(enum.FPredOEQ, l, r), nil
 		}
 	case "!=":
 		if !c.validOperator(node, true) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
-		l := c.compileInfixNode(node.Left)
-		r := c.compileInfixNode(node.Right)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 
 		if node.Right.Type() == "BOOL" {
-			return c.contextBlock.NewICmp(enum.IPredNE, l, r)
+			return c.contextBlock.NewICmp(enum.IPredNE, l, r), nil
 		} else {
-			return c.contextBlock.NewFCmp(enum.FPredONE, l, r)
+			return c.contextBlock.NewFCmp(enum.FPredONE, l, r), nil
 		}
 	case "&&":
 		if !c.validOperator(node, true) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
 		gname := name.ParallelGroup(node.String())
-		l := c.compileInfixNode(node.Left)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 		l = c.tagBuiltIns(l, gname)
-		r := c.compileInfixNode(node.Right)
 		r = c.tagBuiltIns(r, gname)
 
-		return c.contextBlock.NewAnd(l, r)
+		return c.contextBlock.NewAnd(l, r), nil
 
 	case "||":
 		if !c.validOperator(node, true) {
-			panic(fmt.Sprintf("operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type()))
+			return nil, ast.Errorf(pos, "operator %s cannot be used on variables of type %s and %s", node.Operator, node.Left.Type(), node.Right.Type())
 		}
 
 		gname := name.ParallelGroup(node.String())
-		l := c.compileInfixNode(node.Left)
+		l, r, err := c.compileOperands(node)
+		if err != nil {
+			return nil, err
+		}
 		l = c.tagBuiltIns(l, gname)
-		r := c.compileInfixNode(node.Right)
 		r = c.tagBuiltIns(r, gname)
 
-		return c.contextBlock.NewOr(l, r)
+		return c.contextBlock.NewOr(l, r), nil
 
 	default:
-		panic(fmt.Sprintf("unknown operator %s. line: %d, col: %d", node.Operator, pos[0], pos[1]))
+		return nil, ast.Errorf(pos, "unknown operator %s", node.Operator)
 	}
 }
 
-func (c *Compiler) compileInfixNode(node ast.Node) value.Value {
+func (c *Compiler) compileOperands(node *ast.InfixExpression) (value.Value, value.Value, error) {
+	l, err := c.compileInfixNode(node.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := c.compileInfixNode(node.Right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func (c *Compiler) compileInfixNode(node ast.Node) (value.Value, error) {
 	switch v := node.(type) {
 	case *ast.ParameterCall:
 		id := c.AliasToBaseRaw(v.Id())
-		return c.lookupIdent(id, node.Position())
+		return c.lookupIdent(id, node.Position()), nil
 	case *ast.This:
 		id := v.Id()
-		return c.lookupIdent(id, node.Position())
+		return c.lookupIdent(id, node.Position()), nil
 	default:
 		return c.compileValue(node)
 	}
@@ -1068,12 +1236,17 @@ func (c *Compiler) compileThis(node *ast.This) *ir.InstLoad {
 	return c.lookupIdent(node.Id(), node.Position())
 }
 
-func (c *Compiler) compileIf(n *ast.IfExpression) {
+func (c *Compiler) compileIf(n *ast.IfExpression) error {
 	if n.Elif != nil {
-		c.compileIf(n.Elif)
+		if err := c.compileIf(n.Elif); err != nil {
+			return err
+		}
 	}
 
-	cond := c.compileConditional(n.Condition)
+	cond, err := c.compileConditional(n.Condition)
+	if err != nil {
+		return err
+	}
 
 	afterBlock := c.contextBlock.Parent.NewBlock(name.Block() + "-after")
 	trueBlock := c.contextBlock.Parent.NewBlock(name.Block() + "-true")
@@ -1088,7 +1261,9 @@ func (c *Compiler) compileIf(n *ast.IfExpression) {
 	c.contextBlock.NewCondBr(cond, trueBlock, falseBlock)
 
 	c.contextBlock = trueBlock
-	c.compileBlock(n.Consequence)
+	if _, err := c.compileBlock(n.Consequence); err != nil {
+		return err
+	}
 
 	// Jump to after-block if no terminator has been set (such as a return statement)
 	if trueBlock.Term == nil {
@@ -1097,7 +1272,9 @@ func (c *Compiler) compileIf(n *ast.IfExpression) {
 
 	if n.Alternative != nil {
 		c.contextBlock = falseBlock
-		c.compileBlock(n.Alternative)
+		if _, err := c.compileBlock(n.Alternative); err != nil {
+			return err
+		}
 
 		// Jump to after-block if no terminator has been set (such as a return statement)
 		if falseBlock.Term == nil {
@@ -1116,11 +1293,18 @@ func (c *Compiler) compileIf(n *ast.IfExpression) {
 	} else {
 		afterBlock.NewRet(nil)
 	}
+	return nil
 }
 
-func (c *Compiler) compileCompoundGlobal(name string, n *ast.InfixExpression) *ir.Global {
-	r := c.compileCompoundNode(n.Right)
-	l := c.compileCompoundNode(n.Left)
+func (c *Compiler) compileCompoundGlobal(name string, n *ast.InfixExpression) (*ir.Global, error) {
+	r, err := c.compileCompoundNode(n.Right)
+	if err != nil {
+		return nil, err
+	}
+	l, err := c.compileCompoundNode(n.Left)
+	if err != nil {
+		return nil, err
+	}
 
 	var ret *ir.Global
 	switch n.Operator {
@@ -1131,23 +1315,32 @@ func (c *Compiler) compileCompoundGlobal(name string, n *ast.InfixExpression) *i
 		v := constant.NewOr(r, l)
 		ret = c.module.NewGlobalDef(name, v)
 	}
-	return ret
+	return ret, nil
 }
 
-func (c *Compiler) compileCompoundNode(n ast.Node) *ir.Global {
+func (c *Compiler) compileCompoundNode(n ast.Node) (*ir.Global, error) {
 	switch v := n.(type) {
 	case *ast.Identifier:
-		return c.specGlobals[v.IdString()]
+		return c.specGlobals[v.IdString()], nil
 	case *ast.ParameterCall:
-		return c.specGlobals[v.IdString()]
+		return c.specGlobals[v.IdString()], nil
 	case *ast.PrefixExpression:
-		r := c.compileCompoundNode(v.Right)
+		r, err := c.compileCompoundNode(v.Right)
+		if err != nil {
+			return nil, err
+		}
 		name := r.Ident()
 		name = fmt.Sprintf("%s_neg", util.FormatIdent(name))
-		return c.module.NewGlobalDef(name, constant.NewFNeg(r))
+		return c.module.NewGlobalDef(name, constant.NewFNeg(r)), nil
 	case *ast.InfixExpression:
-		r := c.compileCompoundNode(v.Right)
-		l := c.compileCompoundNode(v.Left)
+		r, err := c.compileCompoundNode(v.Right)
+		if err != nil {
+			return nil, err
+		}
+		l, err := c.compileCompoundNode(v.Left)
+		if err != nil {
+			return nil, err
+		}
 		rname := r.Ident()
 		lname := l.Ident()
 		name := fmt.Sprintf("%s_%s", util.FormatIdent(lname), util.FormatIdent(rname))
@@ -1161,15 +1354,18 @@ func (c *Compiler) compileCompoundNode(n ast.Node) *ir.Global {
 		case "||":
 			infix = constant.NewOr(l, r)
 		}
-		return c.module.NewGlobalDef(name, infix)
+		return c.module.NewGlobalDef(name, infix), nil
 	default:
-		r := c.compileValue(v)
+		r, err := c.compileValue(v)
+		if err != nil {
+			return nil, err
+		}
 		name := r.Ident()
-		return c.module.NewGlobalDef(util.FormatIdent(name), r.(constant.Constant))
+		return c.module.NewGlobalDef(util.FormatIdent(name), r.(constant.Constant)), nil
 	}
 }
 
-func (c *Compiler) compileConditional(n ast.Node) value.Value {
+func (c *Compiler) compileConditional(n ast.Node) (value.Value, error) {
 	// Reformat the conditional clause to accept
 	// things like if a {} or if !a {} and replace them
 	// with a == true or a == false
@@ -1206,16 +1402,27 @@ func (c *Compiler) compileConditional(n ast.Node) value.Value {
 	return c.compileValue(n)
 }
 
-func (c *Compiler) compileAssert(a *ast.AssertionStatement) {
+func (c *Compiler) compileAssert(a *ast.AssertionStatement) error {
 	var l, r ast.Expression
+	var err error
 	if a.Assume {
-		a.Constraint.Left = c.convertAssertVariables(a.Constraint.Left)
-		a.Constraint.Right = c.convertAssertVariables(a.Constraint.Right)
+		if a.Constraint.Left, err = c.convertAssertVariables(a.Constraint.Left); err != nil {
+			return err
+		}
+		if a.Constraint.Right, err = c.convertAssertVariables(a.Constraint.Right); err != nil {
+			return err
+		}
 		c.Assumes = append(c.Assumes, a)
-		return
+		return nil
 	}
 
-	if a.TemporalFilter == "" { //If there is a temporal filter this is negated instead
+	if a.IsLTL() { // The whole formula is negated when it's encoded
+		if a.TemporalFilter != "" {
+			return ast.Errorf(a.Position(), "temporal logic not valid, nmt and nft can't be combined with nested temporal operators")
+		}
+		l = a.Constraint.Left
+		r = a.Constraint.Right
+	} else if a.TemporalFilter == "" { //If there is a temporal filter this is negated instead
 		l = negate(a.Constraint.Left)
 		r = negate(a.Constraint.Right)
 		a.Constraint.Operator = util.OP_NEGATE[a.Constraint.Operator]
@@ -1224,90 +1431,136 @@ func (c *Compiler) compileAssert(a *ast.AssertionStatement) {
 		r = a.Constraint.Right
 		a.TemporalFilter, a.TemporalN = negateTemporal(a.TemporalFilter, a.TemporalN)
 		if a.TemporalN < 0 {
-			pos := a.Position()
-			panic(fmt.Sprintf("temporal logic not value, filter searching for fewer than 0 states: line %d col %d", pos[0], pos[1]))
+			return ast.Errorf(a.Position(), "temporal logic not value, filter searching for fewer than 0 states")
 		}
 	}
-	a.Constraint.Left = c.convertAssertVariables(l)
-	a.Constraint.Right = c.convertAssertVariables(r)
+	if a.Constraint.Left, err = c.convertAssertVariables(l); err != nil {
+		return err
+	}
+	if a.Constraint.Right, err = c.convertAssertVariables(r); err != nil {
+		return err
+	}
 	c.Asserts = append(c.Asserts, a)
-
+	return nil
 }
 
-func (c *Compiler) convertAssertVariables(ex ast.Expression) ast.Expression {
-	switch e := ex.(type) {
-	case *ast.InfixExpression:
+// compileProbability moves prob() to the left of the bound,
+// the event isn't negated since it's not a failure condition
+func (c *Compiler) compileProbability(a *ast.AssertionStatement) error {
+	if _, ok := a.Constraint.Right.(*ast.ProbabilityExpression); ok {
+		a.Constraint.Left, a.Constraint.Right = a.Constraint.Right, a.Constraint.Left
+		a.Constraint.Operator = util.OP_FLIP[a.Constraint.Operator]
+	}
 
-		e.Left = c.convertAssertVariables(e.Left)
-		e.Right = c.convertAssertVariables(e.Right)
-		return e
-	case *ast.Identifier:
-		id := c.AliasToBaseRaw(e.RawId())
-		pos := e.Position()
-		vname := strings.Join(id, "_")
+	left, err := c.convertAssertVariables(a.Constraint.Left)
+	if err != nil {
+		return err
+	}
+	a.Constraint.Left = left
+	c.Probabilities = append(c.Probabilities, a)
+	return nil
+}
 
-		if !c.isVarSetAssert(id) {
-			panic(fmt.Sprintf("cannot send value to variable %s. Variable not defined line: %d, col: %d", vname, pos[0], pos[1]))
-		}
+func (c *Compiler) compileObjective(o *ast.OptimizeStatement) error {
+	target, err := c.convertAssertVariables(o.Target)
+	if err != nil {
+		return err
+	}
+	o.Target = target
+	c.Objectives = append(c.Objectives, o)
+	return nil
+}
 
-		instas := c.fetchInstances(id)
-		if len(instas) == 0 {
-			instas = []string{vname}
+func (c *Compiler) convertAssertVariables(ex ast.Expression) (ast.Expression, error) {
+	var err error
+	switch e := ex.(type) {
+	case *ast.InfixExpression:
+		if e.Left, err = c.convertAssertVariables(e.Left); err != nil {
+			return nil, err
 		}
-		return &ast.AssertVar{
-			Token:        e.Token,
-			InferredType: e.InferredType,
-			Instances:    instas,
+		if e.Right, err = c.convertAssertVariables(e.Right); err != nil {
+			return nil, err
 		}
+		return e, nil
+	case *ast.Identifier:
+		return c.convertAssertVar(e.Token, e.InferredType, e.RawId(), e.Position())
 	case *ast.ParameterCall:
-		id := c.AliasToBaseRaw(e.RawId())
-		pos := e.Position()
-		vname := strings.Join(id, "_")
-
-		if !c.isVarSetAssert(id) {
-			panic(fmt.Sprintf("cannot send value to variable %s. Variable not defined line: %d, col: %d", vname, pos[0], pos[1]))
-		}
-
-		instas := c.fetchInstances(id)
-		if len(instas) == 0 {
-			instas = []string{vname}
-		}
-		return &ast.AssertVar{
-			Token:        e.Token,
-			InferredType: e.InferredType,
-			Instances:    instas,
-		}
-
+		return c.convertAssertVar(e.Token, e.InferredType, e.RawId(), e.Position())
 	case *ast.AssertVar:
-		return e
+		return e, nil
 	case *ast.IntegerLiteral:
-		return e
+		return e, nil
 	case *ast.FloatLiteral:
-		return e
+		return e, nil
 	case *ast.Boolean:
-		return e
+		return e, nil
 	case *ast.StringLiteral:
-		return e
+		return e, nil
 	case *ast.Natural:
-		return e
+		return e, nil
 	case *ast.Uncertain:
-		return e
+		return e, nil
 	case *ast.Unknown:
-		return e
+		return e, nil
 	case *ast.PrefixExpression:
-		e.Right = c.convertAssertVariables(e.Right)
-		return e
+		if e.Right, err = c.convertAssertVariables(e.Right); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *ast.TemporalPrefix:
+		if e.Right, err = c.convertAssertVariables(e.Right); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *ast.TemporalInfix:
+		if e.Left, err = c.convertAssertVariables(e.Left); err != nil {
+			return nil, err
+		}
+		if e.Right, err = c.convertAssertVariables(e.Right); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *ast.TemporalWindow:
+		if e.Right, err = c.convertAssertVariables(e.Right); err != nil {
+			return nil, err
+		}
+		return e, nil
+	case *ast.ProbabilityExpression:
+		if e.Event, err = c.convertAssertVariables(e.Event); err != nil {
+			return nil, err
+		}
+		return e, nil
 	case *ast.Nil:
-		return e
+		return e, nil
 	case *ast.IndexExpression:
-		e.Left = c.convertAssertVariables(e.Left)
-		return e
+		if e.Left, err = c.convertAssertVariables(e.Left); err != nil {
+			return nil, err
+		}
+		return e, nil
 	default:
-		pos := e.Position()
-		panic(fmt.Sprintf("illegal node %T in assert or assume line: %d, col: %d", e, pos[0], pos[1]))
+		return nil, ast.Errorf(e.Position(), "illegal node %T in assert or assume", e)
 	}
 }
 
+func (c *Compiler) convertAssertVar(token ast.Token, ty *ast.Type, rawid []string, pos []int) (ast.Expression, error) {
+	id := c.AliasToBaseRaw(rawid)
+	vname := strings.Join(id, "_")
+
+	if !c.isVarSetAssert(id) {
+		return nil, ast.Errorf(pos, "cannot send value to variable %s. Variable not defined", vname)
+	}
+
+	instas := c.fetchInstances(id)
+	if len(instas) == 0 {
+		instas = []string{vname}
+	}
+	return &ast.AssertVar{
+		Token:        token,
+		InferredType: ty,
+		Instances:    instas,
+	}, nil
+}
+
 func (c *Compiler) lookupIdent(id []string, pos []int) *ir.InstLoad {
 	var pointer *ir.InstAlloca
 	s := c.specs[id[0]]
@@ -1331,14 +1584,17 @@ func (c *Compiler) lookupIdent(id []string, pos []int) *ir.InstLoad {
 	return nil
 }
 
-func (c *Compiler) processFunc(rawId []string, branch map[string]ast.Node, component bool) value.Value {
+func (c *Compiler) processFunc(rawId []string, branch map[string]ast.Node, component bool) (value.Value, error) {
 	fname := strings.Join(rawId, "_")
 	if component {
 		fname = fname + "__state"
 	}
 
 	if c.RunRound == 0 { //initialize
-		params := c.generateParameters(rawId, branch, component)
+		params, err := c.generateParameters(rawId, branch, component)
+		if err != nil {
+			return nil, err
+		}
 		if component {
 			params = c.includeGlobalParams(params)
 		}
@@ -1350,7 +1606,10 @@ func (c *Compiler) processFunc(rawId []string, branch map[string]ast.Node, compo
 		c.contextFuncName = fname
 		c.contextBlock = f.NewBlock(name.Block())
 
-		val := c.compileValue(branch[rawId[len(rawId)-1]])
+		val, err := c.compileValue(branch[rawId[len(rawId)-1]])
+		if err != nil {
+			return nil, err
+		}
 		c.contextBlock.NewRet(val)
 
 		c.contextBlock = oldBlock
@@ -1360,7 +1619,7 @@ func (c *Compiler) processFunc(rawId []string, branch map[string]ast.Node, compo
 		c.resetParaState(params)
 	}
 
-	return c.specFunctions[fname]
+	return c.specFunctions[fname], nil
 }
 
 func (c *Compiler) includeGlobalParams(params []*ir.Param) []*ir.Param {
@@ -1368,7 +1627,7 @@ func (c *Compiler) includeGlobalParams(params []*ir.Param) []*ir.Param {
 	return params
 }
 
-func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
+func (c *Compiler) processStruct(node *ast.StructInstance) (map[string]string, error) {
 	keys := node.Order
 	tree := node.Properties
 	pos := node.Position()
@@ -1379,19 +1638,23 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 	var funcs [][]string
 
 	for _, k := range keys {
-		var isUncertain []float64
+		var isUncertain *ast.Distribution
 		var isUnknown bool
 		var id []string
 
 		switch pv := tree[k].Value.(type) {
 		case *ast.StructInstance:
-			c.compileInstance(pv)
+			if err := c.compileInstance(pv); err != nil {
+				return nil, err
+			}
 			id = pv.Id()
 			sInner := c.specs[id[0]]
 			pInner := sInner.GetParams(id)
 			params = append(params, pInner...)
 		case *ast.FunctionLiteral:
-			c.compileFunction(pv)
+			if _, err := c.compileFunction(pv); err != nil {
+				return nil, err
+			}
 			id = pv.Id()
 			funcs = append(funcs, id)
 		default:
@@ -1402,16 +1665,22 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 			if _, ok := pv.(*ast.Unknown); ok {
 				isUnknown = true
 			} else if uncertain, ok2 := pv.(*ast.Uncertain); ok2 {
-				isUncertain = []float64{uncertain.Mean, uncertain.Sigma}
+				isUncertain = uncertain.Distribution()
 			}
 
 			id = pv.(ast.Nameable).Id()
 
-			val := c.compileValue(pv)
+			val, err := c.compileValue(pv)
+			if err != nil {
+				return nil, err
+			}
 			s = c.specs[id[0]]
 			s.DefineSpecVar(id, val)
 			s.DefineSpecType(id, val.Type())
-			c.allocVariable(id, val, pos)
+			locate(c.Declared, id, pv.Position())
+			if err := c.allocVariable(id, val, pos); err != nil {
+				return nil, err
+			}
 			vname := strings.Join(id, "_")
 			s.vars.ResetState(vname)
 			ty := s.GetPointerType(vname)
@@ -1439,10 +1708,10 @@ func (c *Compiler) processStruct(node *ast.StructInstance) map[string]string {
 			s.AddParams(f, params)
 		}
 	}
-	return children
+	return children, nil
 }
 
-func (c *Compiler) generateParameters(id []string, data map[string]ast.Node, component bool) []*ir.Param {
+func (c *Compiler) generateParameters(id []string, data map[string]ast.Node, component bool) ([]*ir.Param, error) {
 	var p []*ir.Param
 	var s *spec
 
@@ -1460,13 +1729,16 @@ func (c *Compiler) generateParameters(id []string, data map[string]ast.Node, com
 			child := n.Id()
 			strInst, err := sr.Fetch(child[1], n.Type())
 			if err != nil {
-				panic(err)
+				return nil, ast.Errorf(n.Position(), "%s", err)
 			}
 
 			if n.Complex {
-				ip = c.generateParameters(child, strInst, false)
+				ip, err = c.generateParameters(child, strInst, false)
 			} else {
-				ip = c.generateParameters(n.Id(), strInst, false)
+				ip, err = c.generateParameters(n.Id(), strInst, false)
+			}
+			if err != nil {
+				return nil, err
 			}
 			p = append(p, ip...)
 		case *ast.StructProperty:
@@ -1503,7 +1775,7 @@ func (c *Compiler) generateParameters(id []string, data map[string]ast.Node, com
 			p = append(p, ir.NewParam(vname, ty))
 		}
 	}
-	return p
+	return p, nil
 }
 
 func (c *Compiler) stateCheck() {
@@ -1614,7 +1886,7 @@ func (c *Compiler) isStrVarSet(rawid []string) bool {
 	}
 
 	if err != nil {
-		panic(err)
+		return false
 	}
 
 	if st[rawid[len(rawid)-1]] != nil {
@@ -340,7 +340,7 @@ func TestUnknowns(t *testing.T) {
 func TestParamReset(t *testing.T) {
 	structs := make(map[string]*preprocess.SpecRecord)
 	c := NewCompiler()
-	c.LoadMeta(structs, make(map[string][]float64), []string{}, make(map[string]string), true)
+	c.LoadMeta(structs, make(map[string]*ast.Distribution), []string{}, make(map[string]string), true)
 	s := NewCompiledSpec("test")
 	c.currentSpec = "test"
 	c.specs["test"] = s
@@ -480,12 +480,13 @@ func TestUncertains(t *testing.T) {
 	test := &ast.StructInstance{Spec: "test", Name: "foo", Parent: []string{"test", "zoo"}, Order: []string{"bar"}, ProcessedName: []string{"test", "foo"}, Properties: map[string]*ast.StructProperty{"bar": {Spec: "test", Name: "bar", ProcessedName: []string{"test", "foo", "bar"}, Value: &ast.Uncertain{Mean: 2.0, Sigma: .3, ProcessedName: []string{"test", "foo", "bar"}}}}}
 	c.processStruct(test)
 
-	if len(c.Uncertains["test_foo_bar"]) == 0 {
+	if c.Uncertains["test_foo_bar"] == nil {
 		t.Fatal("uncertain value not stored")
 	}
 
-	if c.Uncertains["test_foo_bar"][0] != 2.0 || c.Uncertains["test_foo_bar"][1] != .3 {
-		t.Fatalf("uncertain stored value is incorrect, got=%f", c.Uncertains["test_foo_bar"])
+	d := c.Uncertains["test_foo_bar"]
+	if d.Kind != "normal" || d.Params[0] != 2.0 || d.Params[1] != .3 {
+		t.Fatalf("uncertain stored value is incorrect, got=%s", d)
 	}
 
 }
@@ -767,10 +768,13 @@ func prepTest(test string, specType bool) (string, error) {
 
 	ty := types.Execute(pre.Processed, pre)
 	sw := swaps.NewPrecompiler(ty)
-	tree := sw.Swap(ty.Checked)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		return "", err
+	}
 	compiler := NewCompiler()
 	compiler.LoadMeta(ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true)
-	err := compiler.Compile(tree)
+	err = compiler.Compile(tree)
 
 	if err != nil {
 		return "", err
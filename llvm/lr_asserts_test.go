@@ -198,10 +198,13 @@ func prepAssertTest(test string) (*Compiler, error) {
 	pre := preprocess.Execute(l)
 	ty := types.Execute(pre.Processed, pre)
 	sw := swaps.NewPrecompiler(ty)
-	tree := sw.Swap(ty.Checked)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		return nil, err
+	}
 	compiler := NewCompiler()
 	compiler.LoadMeta(ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true)
-	err := compiler.Compile(tree)
+	err = compiler.Compile(tree)
 	if err != nil {
 		return nil, err
 	}
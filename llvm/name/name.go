@@ -3,39 +3,33 @@ package name
 import (
 	"crypto/md5"
 	"fmt"
+	"sync/atomic"
 )
 
+// Counters are shared by every compiler, specs can be compiled
+// concurrently
 var blockIndex uint64
 var parallelIndex uint64
 var anonFuncIndex uint64
 var assertIndex uint64
 
 func Block() string {
-	name := fmt.Sprintf("block-%d", blockIndex)
-	blockIndex++
-	return name
+	return fmt.Sprintf("block-%d", atomic.AddUint64(&blockIndex, 1)-1)
 }
 
 func ParallelGroup(group string) string {
-	data := []byte(fmt.Sprint(group, parallelIndex))
-	parallelIndex++
+	data := []byte(fmt.Sprint(group, atomic.AddUint64(&parallelIndex, 1)-1))
 	return fmt.Sprintf("%x", md5.Sum(data))
 }
 
 func AnonFunc() string {
-	name := fmt.Sprintf("fn-%d", anonFuncIndex)
-	anonFuncIndex++
-	return name
+	return fmt.Sprintf("fn-%d", atomic.AddUint64(&anonFuncIndex, 1)-1)
 }
 
 func Assert() string {
-	name := fmt.Sprintf("__assert-%d", assertIndex)
-	assertIndex++
-	return name
+	return fmt.Sprintf("__assert-%d", atomic.AddUint64(&assertIndex, 1)-1)
 }
 
 func Var(prefix string) string {
-	name := fmt.Sprintf("%s-%d", prefix, blockIndex)
-	blockIndex++
-	return name
+	return fmt.Sprintf("%s-%d", prefix, atomic.AddUint64(&blockIndex, 1)-1)
 }
@@ -0,0 +1,167 @@
+package lsp
+
+import (
+	"context"
+	"fault/fault"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+type document struct {
+	uri         string
+	path        string
+	text        string
+	index       *index // From the last version that type checked
+	diagnostics []*Diagnostic
+}
+
+func newDocument(uri string, text string) *document {
+	d := &document{uri: uri, path: uriToPath(uri)}
+	d.update(text)
+	return d
+}
+
+// update runs the compiler as far as llvm, which is enough to
+// catch every error the user can fix in the spec itself
+func (d *document) update(text string) {
+	d.text = text
+
+	var filetype string
+	switch filepath.Ext(d.path) {
+	case ".fspec":
+		filetype = "fspec"
+	case ".fsystem":
+		filetype = "fsystem"
+	}
+
+	res, err := fault.Compile(context.Background(), text, &fault.Options{
+		Filename: d.path,
+		Type:     filetype,
+		Stop:     fault.StageLLVM,
+	})
+
+	d.diagnostics = d.diagnose(err)
+	if res != nil && res.Checker != nil && res.Checker.Checked != nil {
+		d.index = newIndex(d.path, text, res.Checker.Checked)
+	}
+}
+
+func (d *document) diagnose(err error) []*Diagnostic {
+	diags := []*Diagnostic{}
+	lines := strings.Split(d.text, "\n")
+	for _, e := range fault.Diagnostics(err) {
+		diag := &Diagnostic{Severity: 1, Source: "fault", Message: e.Message}
+
+		// Problems in imported specs are pinned to the top
+		// of the document
+		if e.File != "" && filepath.Clean(e.File) != filepath.Clean(d.path) {
+			diag.Message = fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+			diags = append(diags, diag)
+			continue
+		}
+
+		if e.Line > 0 && e.Line <= len(lines) {
+			end := e.EndColumn
+			if end <= e.Column {
+				end = e.Column + 1
+			}
+			diag.Range = Range{
+				Start: Position{Line: e.Line - 1, Character: toUTF16(lines[e.Line-1], e.Column)},
+				End:   Position{Line: e.Line - 1, Character: toUTF16(lines[e.Line-1], end)},
+			}
+		}
+		diags = append(diags, diag)
+	}
+	return diags
+}
+
+// position converts an LSP position to the parser's line and
+// column
+func (d *document) position(p Position) (int, int) {
+	lines := strings.Split(d.text, "\n")
+	if p.Line < 0 || p.Line >= len(lines) {
+		return p.Line + 1, p.Character
+	}
+	return p.Line + 1, fromUTF16(lines[p.Line], p.Character)
+}
+
+// prefix is the text of a line up to a position
+func (d *document) prefix(p Position) string {
+	lines := strings.Split(d.text, "\n")
+	if p.Line < 0 || p.Line >= len(lines) {
+		return ""
+	}
+	runes := []rune(lines[p.Line])
+	col := fromUTF16(lines[p.Line], p.Character)
+	if col > len(runes) {
+		col = len(runes)
+	}
+	return string(runes[:col])
+}
+
+func (ix *index) location(s span) *Location {
+	return &Location{URI: pathToURI(s.file), Range: ix.lspRange(s)}
+}
+
+func (ix *index) lspRange(s span) Range {
+	return Range{
+		Start: Position{Line: s.line - 1, Character: toUTF16(ix.line(s.file, s.line), s.col)},
+		End:   Position{Line: s.endLine - 1, Character: toUTF16(ix.line(s.file, s.endLine), s.endCol)},
+	}
+}
+
+// toUTF16 converts a column in runes to UTF-16 code units
+func toUTF16(line string, col int) int {
+	var n int
+	for i, r := range []rune(line) {
+		if i >= col {
+			break
+		}
+		n += utf16Len(r)
+	}
+	if extra := col - utf8.RuneCountInString(line); extra > 0 {
+		n += extra
+	}
+	return n
+}
+
+func fromUTF16(line string, char int) int {
+	var n, col int
+	for _, r := range line {
+		if n >= char {
+			break
+		}
+		n += utf16Len(r)
+		col++
+	}
+	if n < char {
+		col += char - n
+	}
+	return col
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+func pathToURI(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return u.String()
+}
@@ -0,0 +1,234 @@
+package lsp
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var keywords = []string{
+	"advance", "after", "always", "and", "assert", "assume", "at",
+	"between", "bool", "component", "const", "def", "else",
+	"eventually", "eventually-always", "false", "float", "flow",
+	"for", "func", "global", "if", "import", "init", "int",
+	"maximize", "minimize", "natural", "new", "next", "nft", "nil",
+	"nmt", "prob", "release", "run", "spec", "start", "states",
+	"stay", "stock", "string", "system", "then", "this", "true",
+	"uncertain", "unknown", "until", "weak-until", "within",
+}
+
+func (d *document) definition(p Position) *Location {
+	ix := d.index
+	if ix == nil {
+		return nil
+	}
+
+	line, col := d.position(p)
+	if r := ix.referenceAt(line, col); r != nil {
+		if sym, _ := ix.resolve(r.id); sym != nil {
+			return ix.location(sym.loc)
+		}
+	}
+
+	if sym := ix.symbolAt(line, col); sym != nil {
+		return ix.location(sym.loc)
+	}
+
+	// Struct names after new aren't kept in the tree, fall
+	// back on the text under the cursor
+	if chain := d.nameAt(p); len(chain) > 0 {
+		_, scope := ix.scope(line)
+		if sym, _ := ix.lookup(scope, chain); sym != nil {
+			return ix.location(sym.loc)
+		}
+	}
+	return nil
+}
+
+func (d *document) hover(p Position) *Hover {
+	ix := d.index
+	if ix == nil {
+		return nil
+	}
+
+	line, col := d.position(p)
+	if r := ix.referenceAt(line, col); r != nil {
+		sym, _ := ix.resolve(r.id)
+		if sym == nil {
+			return nil
+		}
+
+		ty := typeOf(sym.node)
+		if t := inferred(r.node); r.last && t != nil {
+			ty = formatType(t)
+		}
+
+		rng := ix.lspRange(r.span)
+		return &Hover{Contents: markdown(describe(sym, ty)), Range: &rng}
+	}
+
+	if sym := ix.symbolAt(line, col); sym != nil {
+		rng := ix.lspRange(sym.loc)
+		return &Hover{Contents: markdown(describe(sym, typeOf(sym.node))), Range: &rng}
+	}
+	return nil
+}
+
+func describe(sym *symbol, ty string) string {
+	switch sym.kind {
+	case kindStruct, kindClass, kindMethod:
+		return fmt.Sprintf("%s %s", sym.detail, key(sym.id))
+	case kindModule:
+		if sym.node == nil {
+			return fmt.Sprintf("import %s", sym.name)
+		}
+		return fmt.Sprintf("%s %s", sym.detail, key(sym.id))
+	case kindFile:
+		return fmt.Sprintf("spec %s", sym.name)
+	case kindVariable:
+		return fmt.Sprintf("%s = %s", key(sym.id), sym.detail)
+	}
+	return fmt.Sprintf("%s: %s", key(sym.id), ty)
+}
+
+func markdown(s string) MarkupContent {
+	return MarkupContent{Kind: "markdown", Value: fmt.Sprintf("```fault\n%s\n```", s)}
+}
+
+var advancing = regexp.MustCompile(`advance\(\s*(this\.)?\w*$`)
+var member = regexp.MustCompile(`((?:\w+\.)*\w+)\.\w*$`)
+
+func (d *document) completion(p Position) []*CompletionItem {
+	items := []*CompletionItem{}
+	ix := d.index
+	if ix == nil {
+		return items
+	}
+
+	line, _ := d.position(p)
+	prefix := d.prefix(p)
+	decl, scope := ix.scope(line)
+
+	// States the current component can move to
+	if m := advancing.FindStringSubmatch(prefix); m != nil {
+		if decl == nil {
+			return items
+		}
+		for _, s := range decl.children {
+			if s.detail != "state" {
+				continue
+			}
+			item := &CompletionItem{Label: s.name, Kind: completionEnum, Detail: key(s.id)}
+			if m[1] == "" {
+				item.InsertText = "this." + s.name
+			}
+			items = append(items, item)
+		}
+		return items
+	}
+
+	if m := member.FindStringSubmatch(prefix); m != nil {
+		_, id := ix.lookup(scope, strings.Split(m[1], "."))
+		if id == nil {
+			return items
+		}
+		for _, s := range ix.members(id) {
+			items = append(items, completionItem(s))
+		}
+		return items
+	}
+
+	for _, k := range keywords {
+		items = append(items, &CompletionItem{Label: k, Kind: completionKeyword})
+	}
+	for _, s := range ix.members([]string{ix.spec}) {
+		items = append(items, completionItem(s))
+	}
+	if decl != nil {
+		for _, s := range decl.children {
+			items = append(items, completionItem(s))
+		}
+	}
+	for _, s := range ix.outline {
+		if s.kind == kindModule && s.node == nil {
+			items = append(items, &CompletionItem{Label: s.name, Kind: completionModule, Detail: s.detail})
+		}
+	}
+	return items
+}
+
+func completionItem(s *symbol) *CompletionItem {
+	item := &CompletionItem{Label: s.name, Detail: s.detail}
+	switch s.kind {
+	case kindStruct:
+		item.Kind = completionStruct
+	case kindClass:
+		item.Kind = completionClass
+	case kindModule, kindFile:
+		item.Kind = completionModule
+	case kindMethod:
+		item.Kind = completionMethod
+	case kindConstant:
+		item.Kind = completionConstant
+	case kindVariable:
+		item.Kind = completionVariable
+	default:
+		item.Kind = completionField
+	}
+	return item
+}
+
+func (d *document) symbols() []*DocumentSymbol {
+	syms := []*DocumentSymbol{}
+	if d.index == nil {
+		return syms
+	}
+	for _, s := range d.index.outline {
+		syms = append(syms, d.index.documentSymbol(s))
+	}
+	return syms
+}
+
+func (ix *index) documentSymbol(s *symbol) *DocumentSymbol {
+	ds := &DocumentSymbol{
+		Name:           s.name,
+		Detail:         s.detail,
+		Kind:           s.kind,
+		Range:          ix.lspRange(s.decl),
+		SelectionRange: ix.lspRange(s.loc),
+	}
+	for _, c := range s.children {
+		ds.Children = append(ds.Children, ix.documentSymbol(c))
+	}
+	return ds
+}
+
+// nameAt is the dotted name under the cursor, up to the part
+// the cursor is on
+func (d *document) nameAt(p Position) []string {
+	lines := strings.Split(d.text, "\n")
+	if p.Line < 0 || p.Line >= len(lines) {
+		return nil
+	}
+
+	src := []rune(lines[p.Line])
+	col := fromUTF16(lines[p.Line], p.Character)
+	if col > len(src) {
+		return nil
+	}
+
+	start := col
+	for start > 0 && (isIdent(src[start-1]) || src[start-1] == '.') {
+		start--
+	}
+	end := col
+	for end < len(src) && isIdent(src[end]) {
+		end++
+	}
+
+	name := strings.Trim(string(src[start:end]), ".")
+	if name == "" {
+		return nil
+	}
+	return strings.Split(name, ".")
+}
@@ -0,0 +1,212 @@
+package lsp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const simple = `spec simple;
+
+def st = stock{
+    value: 30,
+};
+
+def fl = flow{
+    active: false,
+    vault: new st,
+    fn: func{
+        if vault.value > 4 {
+           vault.value <- vault.value - 2;
+        }
+    },
+};
+
+for 1 init{l = new fl;} run {
+    l.fn;
+}`
+
+const system = `system drains;
+
+import "simple.fspec";
+
+global fl = new simple.fl;
+
+component drain = states{
+    initial: func{
+        if !fl.active {
+            advance(this.open);
+        }
+    },
+    open: func{
+        if fl.vault.value < 0 {
+            advance(this.close);
+        }
+    },
+    close: func{
+        stay();
+    },
+};
+
+start {
+    drain: initial,
+};
+
+for 2 run {
+    if !drain.close{
+        fl.fn;
+    }
+}`
+
+func TestDefinition(t *testing.T) {
+	d := prepDocument(t)
+
+	tests := []struct {
+		pos  Position
+		file string
+		line int
+		char int
+	}{
+		{Position{Line: 8, Character: 16}, "simple.fspec", 7, 4},    // fl.active
+		{Position{Line: 13, Character: 22}, "simple.fspec", 3, 4},   // fl.vault.value
+		{Position{Line: 13, Character: 17}, "simple.fspec", 8, 4},   // fl.vault
+		{Position{Line: 13, Character: 12}, "drains.fsystem", 4, 7}, // fl
+		{Position{Line: 9, Character: 26}, "drains.fsystem", 12, 4}, // this.open
+		{Position{Line: 4, Character: 24}, "simple.fspec", 6, 4},    // new simple.fl
+	}
+
+	for _, test := range tests {
+		loc := d.definition(test.pos)
+		if loc == nil {
+			t.Fatalf("no definition found at %v", test.pos)
+		}
+
+		if !strings.HasSuffix(loc.URI, test.file) || loc.Range.Start.Line != test.line || loc.Range.Start.Character != test.char {
+			t.Fatalf("definition at %v not correct. want=%s %d:%d got=%s %d:%d", test.pos, test.file, test.line, test.char,
+				loc.URI, loc.Range.Start.Line, loc.Range.Start.Character)
+		}
+	}
+}
+
+func TestHover(t *testing.T) {
+	d := prepDocument(t)
+
+	h := d.hover(Position{Line: 13, Character: 22})
+	if h == nil || !strings.Contains(h.Contents.Value, "simple.st.value: INT") {
+		t.Fatalf("hover on property not correct. got=%+v", h)
+	}
+
+	if h.Range.Start.Character != 20 || h.Range.End.Character != 25 {
+		t.Fatalf("hover range not correct. got=%+v", h.Range)
+	}
+
+	h = d.hover(Position{Line: 8, Character: 16})
+	if h == nil || !strings.Contains(h.Contents.Value, "simple.fl.active: BOOL") {
+		t.Fatalf("hover on boolean not correct. got=%+v", h)
+	}
+
+	h = d.hover(Position{Line: 6, Character: 12})
+	if h == nil || !strings.Contains(h.Contents.Value, "component drains.drain") {
+		t.Fatalf("hover on declaration not correct. got=%+v", h)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	d := prepDocument(t)
+
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"        if fl.", []string{"active", "vault", "fn"}},
+		{"        if fl.vault.", []string{"value"}},
+		{"            advance(this.", []string{"initial", "open", "close"}},
+		{"        this.", []string{"initial", "open", "close"}},
+	}
+
+	for _, test := range tests {
+		// Incomplete lines don't parse, completion runs off
+		// the last index that did
+		edited := strings.Split(system, "\n")
+		edited[8] = test.line
+		doc := &document{uri: d.uri, path: d.path, text: strings.Join(edited, "\n"), index: d.index}
+
+		var labels []string
+		for _, item := range doc.completion(Position{Line: 8, Character: len(test.line)}) {
+			labels = append(labels, item.Label)
+		}
+
+		if strings.Join(labels, " ") != strings.Join(test.want, " ") {
+			t.Fatalf("completion for %q not correct. want=%s got=%s", test.line, test.want, labels)
+		}
+	}
+
+	edited := strings.Split(system, "\n")
+	edited[8] = "            advance("
+	doc := &document{uri: d.uri, path: d.path, text: strings.Join(edited, "\n"), index: d.index}
+	items := doc.completion(Position{Line: 8, Character: len(edited[8])})
+	if len(items) != 3 || items[0].InsertText != "this.initial" {
+		t.Fatalf("state completion not correct. got=%+v", items[0])
+	}
+}
+
+func TestDocumentSymbols(t *testing.T) {
+	d := prepDocument(t)
+	syms := d.symbols()
+
+	var names []string
+	for _, s := range syms {
+		names = append(names, s.Name)
+	}
+
+	if strings.Join(names, " ") != "simple fl drain" {
+		t.Fatalf("document symbols not correct. got=%s", names)
+	}
+
+	drain := syms[2]
+	if drain.Kind != kindModule || len(drain.Children) != 3 || drain.Children[1].Name != "open" || drain.Children[1].Detail != "state" {
+		t.Fatalf("component symbol not correct. got=%+v", drain)
+	}
+
+	if drain.SelectionRange.Start.Line != 6 || drain.SelectionRange.Start.Character != 10 || drain.Range.End.Line != 20 {
+		t.Fatalf("component symbol range not correct. got=%+v %+v", drain.Range, drain.SelectionRange)
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	d := newDocument(pathToURI(filepath.Join(t.TempDir(), "bad.fspec")), "spec bad;\nconst a = ;\nconst b = 2 +;\n")
+
+	if len(d.diagnostics) != 2 {
+		t.Fatalf("wrong number of diagnostics. want=2 got=%d", len(d.diagnostics))
+	}
+
+	r := d.diagnostics[0].Range
+	if r.Start.Line != 1 || r.Start.Character != 10 || r.End.Character != 11 {
+		t.Fatalf("diagnostic range not correct. got=%+v", r)
+	}
+
+	if d.index != nil {
+		t.Fatal("index built from a spec that does not parse")
+	}
+}
+
+func TestUTF16(t *testing.T) {
+	line := "a😀b"
+	if toUTF16(line, 2) != 3 || fromUTF16(line, 3) != 2 {
+		t.Fatalf("UTF-16 conversion not correct. got=%d %d", toUTF16(line, 2), fromUTF16(line, 3))
+	}
+}
+
+func prepDocument(t *testing.T) *document {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "simple.fspec"), []byte(simple), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := newDocument(pathToURI(filepath.Join(dir, "drains.fsystem")), system)
+	if len(d.diagnostics) != 0 {
+		t.Fatalf("valid spec returned diagnostics. got=%s", d.diagnostics[0].Message)
+	}
+	return d
+}
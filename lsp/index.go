@@ -0,0 +1,645 @@
+package lsp
+
+import (
+	"fault/ast"
+	"fault/util"
+	"os"
+	gopath "path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The index is built from the type checked tree, so every name
+// is already qualified by preprocess (spec, struct, property...)
+// and navigation is just a matter of matching ids.
+
+type span struct {
+	file    string
+	line    int // 1 based, as reported by the parser
+	col     int // in runes
+	endLine int
+	endCol  int
+}
+
+type symbol struct {
+	id       []string
+	name     string
+	kind     int
+	detail   string
+	loc      span // Just the name
+	decl     span // The whole declaration
+	node     ast.Node
+	parent   string
+	children []*symbol
+}
+
+// A reference to a name in the document. Dotted names are split
+// so that each segment points at its own declaration.
+type reference struct {
+	span
+	id   []string
+	node ast.Node
+	last bool
+}
+
+type index struct {
+	file    string
+	spec    string
+	sources map[string][]string
+	symbols map[string]*symbol
+	types   map[string][]string // Instance -> struct it was made from
+	outline []*symbol
+	refs    []*reference
+}
+
+func key(id []string) string {
+	return strings.Join(id, ".")
+}
+
+func newIndex(file string, source string, tree *ast.Spec) *index {
+	ix := &index{
+		file:    file,
+		sources: map[string][]string{file: strings.Split(source, "\n")},
+		symbols: make(map[string]*symbol),
+		types:   make(map[string][]string),
+	}
+	ix.walkSpec(tree, file)
+	return ix
+}
+
+func (ix *index) lines(file string) []string {
+	if l, ok := ix.sources[file]; ok {
+		return l
+	}
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		ix.sources[file] = nil
+		return nil
+	}
+	ix.sources[file] = strings.Split(string(src), "\n")
+	return ix.sources[file]
+}
+
+func (ix *index) line(file string, line int) string {
+	l := ix.lines(file)
+	if line < 1 || line > len(l) {
+		return ""
+	}
+	return strings.TrimRight(l[line-1], "\r")
+}
+
+func (ix *index) walkSpec(tree *ast.Spec, file string) {
+	local := file == ix.file
+	for _, s := range tree.Statements {
+		switch st := s.(type) {
+		case *ast.SpecDeclStatement:
+			ix.declareSpec(st.Name, file, local)
+		case *ast.SysDeclStatement:
+			ix.declareSpec(st.Name, file, local)
+		case *ast.ImportStatement:
+			ix.walkImport(st, file, local)
+		case *ast.ConstantStatement:
+			sym := ix.declare(st.Name, st.Value, kindConstant, file, st.Position())
+			if sym != nil && local {
+				ix.outline = append(ix.outline, sym)
+			}
+			ix.walk(st.Value, file)
+		case *ast.DefStatement:
+			sym := ix.walkDef(st, file)
+			if sym != nil && local {
+				ix.outline = append(ix.outline, sym)
+			}
+		case *ast.AssertionStatement:
+			if local {
+				ix.outline = append(ix.outline, ix.assertion(st, file))
+			}
+			ix.walk(st.Constraint, file)
+		case *ast.OptimizeStatement:
+			ix.walk(st.Target, file)
+		case *ast.ForStatement:
+			ix.walk(st.Inits, file)
+			ix.walk(st.Body, file)
+		}
+	}
+}
+
+func (ix *index) declareSpec(name *ast.Identifier, file string, local bool) {
+	if local {
+		ix.spec = name.Value
+	}
+	pos := name.Position()
+	ix.symbols[name.Value] = &symbol{
+		id:   []string{name.Value},
+		name: name.Value,
+		kind: kindFile,
+		loc:  ix.find(file, pos, name.Value),
+		decl: ix.span(file, pos),
+	}
+}
+
+func (ix *index) walkImport(st *ast.ImportStatement, file string, local bool) {
+	path := strings.Trim(st.Path.Value, "\"")
+	path = util.Filepath(gopath.Join(gopath.Dir(file), path))
+
+	start := span{file: path, line: 1, endLine: 1}
+	sym := &symbol{
+		id:     []string{st.Name.Value},
+		name:   st.Name.Value,
+		kind:   kindModule,
+		detail: path,
+		loc:    start,
+		decl:   start,
+	}
+	ix.symbols[st.Name.Value] = sym
+
+	if local {
+		// The parser only places the path
+		loc := ix.find(file, st.Position(), st.Path.Value)
+		ix.outline = append(ix.outline, &symbol{
+			id:     sym.id,
+			name:   sym.name,
+			kind:   kindModule,
+			detail: path,
+			loc:    loc,
+			decl:   loc,
+		})
+	}
+
+	// The imported spec's own declaration replaces sym if
+	// it was imported under the same name
+	if st.Tree != nil {
+		ix.walkSpec(st.Tree, path)
+	}
+}
+
+func (ix *index) walkDef(st *ast.DefStatement, file string) *symbol {
+	var kind int
+	var pairs map[*ast.Identifier]ast.Expression
+	var state bool
+	switch v := st.Value.(type) {
+	case *ast.StockLiteral:
+		kind, pairs = kindStruct, v.Pairs
+	case *ast.FlowLiteral:
+		kind, pairs = kindClass, v.Pairs
+	case *ast.ComponentLiteral:
+		kind, pairs, state = kindModule, v.Pairs, true
+	default:
+		kind = kindVariable
+	}
+
+	sym := ix.declare(st.Name, st.Value, kind, file, st.Position())
+	if sym == nil {
+		return nil
+	}
+
+	if inst, ok := st.Value.(*ast.StructInstance); ok {
+		ix.instance(sym.id, inst)
+		sym.detail = "new " + key(inst.Parent)
+	} else {
+		sym.detail = strings.ToLower(typeOf(st.Value))
+	}
+
+	for k, v := range pairs {
+		id := k.ProcessedName
+		if n, ok := v.(ast.Nameable); ok && len(n.RawId()) > 0 {
+			id = n.RawId()
+		}
+
+		child := ix.declare(&ast.Identifier{Token: k.Token, Value: k.Value, ProcessedName: id}, v, kindField, file, k.Position())
+		if child == nil {
+			continue
+		}
+		child.parent = key(sym.id)
+
+		switch val := v.(type) {
+		case *ast.FunctionLiteral:
+			child.kind = kindMethod
+			if state {
+				child.detail = "state"
+			} else {
+				child.detail = "func"
+			}
+		case *ast.StructInstance:
+			ix.instance(id, val)
+			child.detail = "new " + key(val.Parent)
+		}
+		sym.children = append(sym.children, child)
+		ix.walk(v, file)
+	}
+	sortSymbols(sym.children)
+	return sym
+}
+
+func (ix *index) assertion(st *ast.AssertionStatement, file string) *symbol {
+	name := "assert"
+	if st.Assume {
+		name = "assume"
+	}
+	pos := st.Position()
+	return &symbol{
+		name:   name,
+		kind:   kindBoolean,
+		detail: strings.TrimSpace(st.Constraint.String()),
+		loc:    ix.find(file, pos, name),
+		decl:   ix.span(file, pos),
+	}
+}
+
+// instance records which struct an instance was created from,
+// including any instances nested in its properties
+func (ix *index) instance(id []string, inst *ast.StructInstance) {
+	if len(id) == 0 || len(inst.Parent) == 0 {
+		return
+	}
+	ix.types[key(id)] = inst.Parent
+	for name, p := range inst.Properties {
+		if nested, ok := p.Value.(*ast.StructInstance); ok {
+			ix.instance(append(append([]string{}, id...), name), nested)
+		}
+	}
+}
+
+func (ix *index) declare(name *ast.Identifier, value ast.Node, kind int, file string, pos []int) *symbol {
+	if name == nil || len(name.ProcessedName) == 0 {
+		return nil
+	}
+
+	sym := &symbol{
+		id:   name.ProcessedName,
+		name: name.Value,
+		kind: kind,
+		node: value,
+		loc:  ix.find(file, pos, name.Value),
+		decl: ix.span(file, pos),
+	}
+	if kind == kindField || kind == kindConstant {
+		sym.detail = typeOf(value)
+	}
+	ix.symbols[key(sym.id)] = sym
+	return sym
+}
+
+// walk collects the references in an expression
+func (ix *index) walk(n ast.Node, file string) {
+	switch e := n.(type) {
+	case *ast.ParameterCall:
+		ix.reference(e, e.Value, e.ProcessedName, file)
+	case *ast.This:
+		ix.reference(e, e.Value, e.ProcessedName, file)
+	case *ast.Identifier:
+		ix.reference(e, []string{e.Value}, e.ProcessedName, file)
+	case *ast.AssertVar:
+	case *ast.InvariantClause:
+		if e == nil {
+			return
+		}
+		ix.walk(e.Left, file)
+		ix.walk(e.Right, file)
+	case *ast.InfixExpression:
+		ix.walk(e.Left, file)
+		ix.walk(e.Right, file)
+	case *ast.TemporalPrefix:
+		ix.walk(e.Right, file)
+	case *ast.TemporalWindow:
+		ix.walk(e.Right, file)
+	case *ast.ProbabilityExpression:
+		ix.walk(e.Event, file)
+	case *ast.TemporalInfix:
+		ix.walk(e.Left, file)
+		ix.walk(e.Right, file)
+	case *ast.PrefixExpression:
+		ix.walk(e.Right, file)
+	case *ast.IndexExpression:
+		ix.walk(e.Left, file)
+		ix.walk(e.Index, file)
+	case *ast.IfExpression:
+		if e == nil {
+			return
+		}
+		ix.walk(e.Condition, file)
+		ix.walk(e.Consequence, file)
+		ix.walk(e.Alternative, file)
+		ix.walk(e.Elif, file)
+	case *ast.BlockStatement:
+		if e == nil {
+			return
+		}
+		for _, s := range e.Statements {
+			ix.walk(s, file)
+		}
+	case *ast.ExpressionStatement:
+		if e == nil {
+			return
+		}
+		ix.walk(e.Expression, file)
+	case *ast.ParallelFunctions:
+		for _, f := range e.Expressions {
+			ix.walk(f, file)
+		}
+	case *ast.FunctionLiteral:
+		if e == nil {
+			return
+		}
+		ix.walk(e.Body, file)
+	case *ast.BuiltIn:
+		for _, p := range e.Parameters {
+			ix.walk(p, file)
+		}
+	case *ast.InitExpression:
+		ix.walk(e.Expression, file)
+	case *ast.StructInstance:
+		// Instances in the run block
+		ix.instance(e.ProcessedName, e)
+	}
+}
+
+func (ix *index) reference(n ast.Node, value []string, id []string, file string) {
+	pos := n.Position()
+	if file != ix.file || len(id) < len(value) || len(value) == 0 || pos[0] == 0 {
+		return
+	}
+
+	segments := ix.segments(file, pos[0], pos[1])
+	if len(segments) != len(value) {
+		// Can't line the name up with the source, treat it
+		// as one name
+		s := ix.span(file, pos)
+		ix.refs = append(ix.refs, &reference{span: s, id: id, node: n, last: true})
+		return
+	}
+
+	scope := id[:len(id)-len(value)]
+	for i, s := range segments {
+		ref := &reference{
+			span: s,
+			id:   append(append([]string{}, scope...), value[:i+1]...),
+			node: n,
+			last: i == len(segments)-1,
+		}
+		ix.refs = append(ix.refs, ref)
+	}
+}
+
+// segments splits a dotted name in the source into its parts
+func (ix *index) segments(file string, line int, col int) []span {
+	src := []rune(ix.line(file, line))
+	var spans []span
+	i := col
+	for i < len(src) {
+		start := i
+		for i < len(src) && isIdent(src[i]) {
+			i++
+		}
+		if i == start {
+			break
+		}
+		spans = append(spans, span{file: file, line: line, col: start, endLine: line, endCol: i})
+		if i+1 >= len(src) || src[i] != '.' || !isIdent(src[i+1]) {
+			break
+		}
+		i++
+	}
+	return spans
+}
+
+// span converts a node position to a range, the parser reports
+// where the last token starts so find where it ends
+func (ix *index) span(file string, pos []int) span {
+	s := span{file: file, line: pos[0], col: pos[1], endLine: pos[2], endCol: pos[3]}
+	src := []rune(ix.line(file, s.endLine))
+	if s.endCol < len(src) && isIdent(src[s.endCol]) {
+		for s.endCol < len(src) && isIdent(src[s.endCol]) {
+			s.endCol++
+		}
+	} else {
+		s.endCol++
+	}
+	return s
+}
+
+// find locates a name in the source starting from the
+// beginning of its declaration
+func (ix *index) find(file string, pos []int, name string) span {
+	s := span{file: file, line: pos[0], col: pos[1], endLine: pos[0], endCol: pos[1]}
+	src := []rune(ix.line(file, pos[0]))
+	target := []rune(name)
+	for i := pos[1]; i+len(target) <= len(src); i++ {
+		if string(src[i:i+len(target)]) != name {
+			continue
+		}
+		if (i > 0 && isIdent(src[i-1])) || (i+len(target) < len(src) && isIdent(src[i+len(target)])) {
+			continue
+		}
+		s.col, s.endCol = i, i+len(target)
+		return s
+	}
+	s.endCol = s.col + len(target)
+	return s
+}
+
+// resolve finds the declaration an id refers to, following
+// instances back to the struct they were created from
+func (ix *index) resolve(id []string) (*symbol, []string) {
+	if len(id) == 0 {
+		return nil, nil
+	}
+
+	cur := id[:1]
+	if _, ok := ix.symbols[key(cur)]; !ok {
+		return nil, nil
+	}
+
+	for _, s := range id[1:] {
+		next := append(append([]string{}, cur...), s)
+		if _, ok := ix.symbols[key(next)]; ok {
+			cur = next
+			continue
+		}
+
+		if ty, ok := ix.types[key(cur)]; ok {
+			inherited := append(append([]string{}, ty...), s)
+			if _, ok := ix.symbols[key(inherited)]; ok {
+				cur = inherited
+				continue
+			}
+		}
+
+		if _, ok := ix.types[key(next)]; ok {
+			cur = next
+			continue
+		}
+		return nil, nil
+	}
+
+	if sym, ok := ix.symbols[key(cur)]; ok {
+		return sym, cur
+	}
+
+	// An instance without a declaration of its own (made in
+	// the run block), point at what it's an instance of
+	if ty, ok := ix.types[key(cur)]; ok {
+		sym, _ := ix.resolve(ty)
+		return sym, cur
+	}
+	return nil, nil
+}
+
+// lookup resolves a name as written in the spec from inside
+// a declaration, trying the narrowest scope first
+func (ix *index) lookup(scope []string, name []string) (*symbol, []string) {
+	if name[0] == "this" {
+		return ix.resolve(append(append([]string{}, scope...), name[1:]...))
+	}
+
+	for i := len(scope); i > 0; i-- {
+		if sym, id := ix.resolve(append(append([]string{}, scope[:i]...), name...)); id != nil {
+			return sym, id
+		}
+	}
+
+	// Imported specs are referenced by name
+	return ix.resolve(name)
+}
+
+// members lists everything that can follow id in a dotted name
+func (ix *index) members(id []string) []*symbol {
+	var found []*symbol
+	if len(id) == 1 {
+		// Top level of a spec
+		for _, s := range ix.symbols {
+			if len(s.id) == 2 && s.id[0] == id[0] && s.parent == "" {
+				found = append(found, s)
+			}
+		}
+	} else if sym, ok := ix.symbols[key(id)]; ok {
+		found = append(found, sym.children...)
+	}
+
+	if ty, ok := ix.types[key(id)]; ok {
+		if sym, ok := ix.symbols[key(ty)]; ok {
+			found = append(found, sym.children...)
+		}
+	}
+	sortSymbols(found)
+	return found
+}
+
+// scope is the id of the declaration a position falls inside
+func (ix *index) scope(line int) (*symbol, []string) {
+	for _, s := range ix.outline {
+		if s.children == nil || s.decl.file != ix.file {
+			continue
+		}
+		if line >= s.decl.line && line <= s.decl.endLine {
+			return s, s.id
+		}
+	}
+	return nil, []string{ix.spec}
+}
+
+// referenceAt finds the reference under a position
+func (ix *index) referenceAt(line int, col int) *reference {
+	for _, r := range ix.refs {
+		if r.line == line && col >= r.col && col <= r.endCol {
+			return r
+		}
+	}
+	return nil
+}
+
+// symbolAt finds a declaration whose name is under a position
+func (ix *index) symbolAt(line int, col int) *symbol {
+	for _, s := range ix.symbols {
+		n := s.loc
+		if n.file == ix.file && n.line == line && col >= n.col && col <= n.endCol && n.endCol > n.col {
+			return s
+		}
+	}
+	return nil
+}
+
+func typeOf(n ast.Node) string {
+	switch v := n.(type) {
+	case nil:
+		return ""
+	case *ast.StockLiteral:
+		return "STOCK"
+	case *ast.FlowLiteral:
+		return "FLOW"
+	case *ast.ComponentLiteral:
+		return "COMPONENT"
+	case *ast.StructInstance:
+		return v.Type()
+	case *ast.FunctionLiteral:
+		return "FUNCTION"
+	}
+
+	if t := inferred(n); t != nil {
+		return formatType(t)
+	}
+	return n.Type()
+}
+
+// inferred digs out the type the checker settled on, if any
+func inferred(n ast.Node) *ast.Type {
+	switch v := n.(type) {
+	case *ast.Identifier:
+		return v.InferredType
+	case *ast.ParameterCall:
+		return v.InferredType
+	case *ast.This:
+		return v.InferredType
+	case *ast.IntegerLiteral:
+		return v.InferredType
+	case *ast.FloatLiteral:
+		return v.InferredType
+	case *ast.Natural:
+		return v.InferredType
+	case *ast.Boolean:
+		return v.InferredType
+	case *ast.StringLiteral:
+		return v.InferredType
+	case *ast.Uncertain:
+		return v.InferredType
+	case *ast.Unknown:
+		return v.InferredType
+	case *ast.InfixExpression:
+		return v.InferredType
+	case *ast.PrefixExpression:
+		return v.InferredType
+	}
+	return nil
+}
+
+func formatType(t *ast.Type) string {
+	if len(t.Parameters) == 0 {
+		return t.Type
+	}
+
+	var params []string
+	for _, p := range t.Parameters {
+		params = append(params, p.Type)
+	}
+	return t.Type + "(" + strings.Join(params, ", ") + ")"
+}
+
+func isIdent(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func sortSymbols(s []*symbol) {
+	// Pairs come out of maps, put them back in source order
+	sort.Slice(s, func(i, j int) bool { return before(s[i], s[j]) })
+}
+
+func before(a *symbol, b *symbol) bool {
+	if a.decl.line != b.decl.line {
+		return a.decl.line < b.decl.line
+	}
+	if a.decl.col != b.decl.col {
+		return a.decl.col < b.decl.col
+	}
+	return a.name < b.name
+}
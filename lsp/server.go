@@ -0,0 +1,232 @@
+// Package lsp is a language server for Fault specs. It speaks
+// the Language Server Protocol over stdio and runs the same
+// pipeline as the compiler (up to llvm) on every change.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+type Server struct {
+	in       *bufio.Reader
+	out      io.Writer
+	docs     map[string]*document
+	shutdown bool
+}
+
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		docs: make(map[string]*document),
+	}
+}
+
+// Serve handles messages until the client sends exit or
+// closes the connection
+func (s *Server) Serve() error {
+	for {
+		body, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			if err := s.reply(nil, nil, &responseError{Code: parseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit requested before shutdown")
+			}
+			return nil
+		}
+
+		if err := s.handle(&req); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) read() ([]byte, error) {
+	headers, err := textproto.NewReader(s.in).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF && len(headers) == 0 {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(headers.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Length header: %s", err)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(s.in, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (s *Server) write(msg interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}, rerr *responseError) error {
+	resp := &response{JSONRPC: "2.0", ID: id, Error: rerr}
+	if rerr == nil {
+		res, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.Result = res
+	}
+	return s.write(resp)
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return s.write(&notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+func (s *Server) handle(req *request) (err error) {
+	// A bug in one handler shouldn't take down the editor
+	// session, report it and carry on
+	defer func() {
+		if r := recover(); r != nil {
+			if req.ID != nil {
+				err = s.reply(req.ID, nil, &responseError{Code: internalError, Message: fmt.Sprintf("%v", r)})
+			}
+		}
+	}()
+
+	result, rerr := s.dispatch(req)
+	if req.ID == nil {
+		return nil // Notifications get no response
+	}
+	return s.reply(req.ID, result, rerr)
+}
+
+func (s *Server) dispatch(req *request) (interface{}, *responseError) {
+	switch req.Method {
+	case "initialize":
+		return &initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:       1, // Full text on every change
+				DefinitionProvider:     true,
+				HoverProvider:          true,
+				CompletionProvider:     &completionOptions{TriggerCharacters: []string{".", "("}},
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "fault"},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		d := newDocument(params.TextDocument.URI, params.TextDocument.Text)
+		s.docs[d.uri] = d
+		return nil, s.publish(d)
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[params.TextDocument.URI]
+		if !ok || len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		d.update(params.ContentChanges[len(params.ContentChanges)-1].Text)
+		return nil, s.publish(d)
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.wrap(s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []*Diagnostic{}}))
+	case "textDocument/definition":
+		d, p, err := s.locate(req)
+		if d == nil {
+			return nil, err
+		}
+		return d.definition(p), nil
+	case "textDocument/hover":
+		d, p, err := s.locate(req)
+		if d == nil {
+			return nil, err
+		}
+		return d.hover(p), nil
+	case "textDocument/completion":
+		d, p, err := s.locate(req)
+		if d == nil {
+			return []*CompletionItem{}, err
+		}
+		return d.completion(p), nil
+	case "textDocument/documentSymbol":
+		var params documentSymbolParams
+		if err := unmarshal(req.Params, &params); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			return []*DocumentSymbol{}, nil
+		}
+		return d.symbols(), nil
+	}
+
+	if strings.HasPrefix(req.Method, "$/") || req.ID == nil {
+		return nil, nil
+	}
+	return nil, &responseError{Code: methodNotFound, Message: fmt.Sprintf("method %s not supported", req.Method)}
+}
+
+func (s *Server) locate(req *request) (*document, Position, *responseError) {
+	var params positionParams
+	if err := unmarshal(req.Params, &params); err != nil {
+		return nil, Position{}, err
+	}
+	return s.docs[params.TextDocument.URI], params.Position, nil
+}
+
+func (s *Server) publish(d *document) *responseError {
+	return s.wrap(s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{URI: d.uri, Diagnostics: d.diagnostics}))
+}
+
+func (s *Server) wrap(err error) *responseError {
+	if err == nil {
+		return nil
+	}
+	return &responseError{Code: internalError, Message: err.Error()}
+}
+
+func unmarshal(params json.RawMessage, v interface{}) *responseError {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &responseError{Code: parseError, Message: err.Error()}
+	}
+	return nil
+}
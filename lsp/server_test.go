@@ -0,0 +1,126 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestServe(t *testing.T) {
+	uri := pathToURI(filepath.Join(t.TempDir(), "test.fspec"))
+
+	var in bytes.Buffer
+	frame(&in, 1, "initialize", map[string]interface{}{})
+	frame(&in, 0, "initialized", map[string]interface{}{})
+	frame(&in, 0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "fault", "version": 1, "text": simple},
+	})
+	frame(&in, 2, "textDocument/hover", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     map[string]int{"line": 10, "character": 18},
+	})
+	frame(&in, 0, "textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]string{{"text": "spec test;\nconst a = ;\n"}},
+	})
+	frame(&in, 3, "textDocument/formatting", map[string]interface{}{})
+	frame(&in, 4, "shutdown", nil)
+	frame(&in, 0, "exit", nil)
+
+	var out bytes.Buffer
+	if err := NewServer(&in, &out).Serve(); err != nil {
+		t.Fatalf("server failed. got=%s", err)
+	}
+
+	msgs := readAll(t, &out)
+	if len(msgs) != 6 {
+		t.Fatalf("wrong number of messages. want=6 got=%d", len(msgs))
+	}
+
+	var init struct {
+		Result initializeResult `json:"result"`
+	}
+	json.Unmarshal(msgs[0], &init)
+	if !init.Result.Capabilities.HoverProvider || init.Result.Capabilities.TextDocumentSync != 1 {
+		t.Fatalf("initialize result not correct. got=%s", msgs[0])
+	}
+
+	var open struct {
+		Method string                   `json:"method"`
+		Params publishDiagnosticsParams `json:"params"`
+	}
+	json.Unmarshal(msgs[1], &open)
+	if open.Method != "textDocument/publishDiagnostics" || len(open.Params.Diagnostics) != 0 {
+		t.Fatalf("diagnostics for valid spec not correct. got=%s", msgs[1])
+	}
+
+	var hover struct {
+		Result Hover `json:"result"`
+	}
+	json.Unmarshal(msgs[2], &hover)
+	if hover.Result.Contents.Value != "```fault\nsimple.st.value: INT\n```" {
+		t.Fatalf("hover not correct. got=%s", msgs[2])
+	}
+
+	var change struct {
+		Params publishDiagnosticsParams `json:"params"`
+	}
+	json.Unmarshal(msgs[3], &change)
+	if len(change.Params.Diagnostics) != 1 || change.Params.Diagnostics[0].Range.Start.Line != 1 {
+		t.Fatalf("diagnostics for invalid spec not correct. got=%s", msgs[3])
+	}
+
+	var unsupported struct {
+		Error responseError `json:"error"`
+	}
+	json.Unmarshal(msgs[4], &unsupported)
+	if unsupported.Error.Code != methodNotFound {
+		t.Fatalf("unsupported method not rejected. got=%s", msgs[4])
+	}
+
+	if string(msgs[5]) != `{"jsonrpc":"2.0","id":4,"result":null}` {
+		t.Fatalf("shutdown response not correct. got=%s", msgs[5])
+	}
+}
+
+func TestExitWithoutShutdown(t *testing.T) {
+	var in, out bytes.Buffer
+	frame(&in, 0, "exit", nil)
+	if err := NewServer(&in, &out).Serve(); err == nil {
+		t.Fatal("exit before shutdown not reported")
+	}
+}
+
+func frame(w io.Writer, id int, method string, params interface{}) {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id != 0 {
+		msg["id"] = id
+	}
+	body, _ := json.Marshal(msg)
+	fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func readAll(t *testing.T, r io.Reader) [][]byte {
+	var msgs [][]byte
+	br := bufio.NewReader(r)
+	for {
+		headers, err := textproto.NewReader(br).ReadMIMEHeader()
+		if err == io.EOF {
+			return msgs
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		length, _ := strconv.Atoi(headers.Get("Content-Length"))
+		body := make([]byte, length)
+		io.ReadFull(br, body)
+		msgs = append(msgs, body)
+	}
+}
@@ -1,82 +1,55 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fault/ast"
 	"fault/execute"
-	"fault/listener"
+	"fault/fault"
+	"fault/format"
 	"fault/llvm"
-	"fault/preprocess"
-	"fault/reachability"
+	"fault/lsp"
 	"fault/smt"
+	"fault/smt/forks"
 	resultlog "fault/smt/log"
 	smtvar "fault/smt/variables"
-	"fault/swaps"
-	"fault/types"
 	"fault/util"
-	"fault/visualize"
 	"flag"
 	"fmt"
 	"log"
 	"os"
-	gopath "path"
+	"path"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 
 	_ "github.com/olekukonko/tablewriter"
 )
 
-func parse(data string, path string, file string, filetype string, reach bool, visu bool) (*ast.Spec, *listener.FaultListener, *types.Checker, string, map[string]string) {
-
-	//Confirm that the filetype and file declaration match
-	if !validate_filetype(data, filetype) {
-		log.Fatalf("malformatted file: declaration does not match filetype.")
-	}
-	flags := make(map[string]bool)
-	flags["specType"] = (filetype == "fspec")
-	flags["testing"] = false
-	flags["skipRun"] = false
-	lstnr := listener.Execute(data, path, flags)
-
-	pre := preprocess.Execute(lstnr)
-
-	ty := types.Execute(pre.Processed, pre)
-
-	sw := swaps.NewPrecompiler(ty)
-	tree := sw.Swap(ty.Checked)
-
-	var visual string
-	if visu {
-		vis := visualize.NewVisual(ty.Checked)
-		vis.Build()
-		visual = vis.Render()
-	}
-
-	if reach {
-		r := reachability.NewTracer()
-		r.Scan(ty.Checked)
-	}
-	return tree, lstnr, ty, visual, sw.Alias
-}
-
-func validate_filetype(data string, filetype string) bool {
-	if filetype == "fspec" && data[0:4] == "spec" {
-		return true
-	}
-	if filetype == "fsystem" && data[0:6] == "system" {
-		return true
-	}
-	return false
-}
-
-func smt2(ir string, compiler *llvm.Compiler) *smt.Generator {
+func smt2(ir string, compiler *llvm.Compiler, symbolic bool, named bool) *smt.Generator {
 	generator := smt.NewGenerator()
+	generator.SymbolicInterleaving = symbolic
+	generator.NamedTerms = named
 	generator.LoadMeta(compiler)
-	generator.Run(ir)
+	if err := generator.Run(ir); err != nil {
+		log.Fatal(err)
+	}
 	return generator
 }
 
-func plainSolve(smt string) {
+func newModelChecker(solver string) *execute.ModelChecker {
 	ex := execute.NewModelChecker()
+	if err := ex.UseSolver(solver); err != nil {
+		log.Fatal(err)
+	}
+	return ex
+}
+
+func plainSolve(smt string, solver string) {
+	ex := newModelChecker(solver)
 	ex.LoadModel(smt, nil, nil, nil, nil)
+	defer ex.Close()
 	ok, err := ex.Check()
 	if err != nil {
 		log.Fatalf("model checker has failed: %s", err)
@@ -92,33 +65,470 @@ func plainSolve(smt string) {
 	fmt.Println(scenario)
 }
 
-func probability(smt string, uncertains map[string][]float64, unknowns []string, results map[string][]*smtvar.VarChange, rlog *resultlog.ResultLog) (*execute.ModelChecker, map[string]execute.Scenario) {
-	ex := execute.NewModelChecker()
+func probability(smt string, model string, solver string, likely bool, uncertains map[string]*ast.Distribution, unknowns []string, results map[string][]*smtvar.VarChange, rlog *resultlog.ResultLog) (*execute.ModelChecker, map[string]execute.Scenario) {
+	ex := newModelChecker(solver)
+	ex.Likely = likely
 	ex.LoadModel(smt, uncertains, unknowns, results, rlog)
-	ok, err := ex.Check()
+	defer ex.Close()
+	scenario, ok, err := ex.NextFailure()
 	if err != nil {
 		log.Fatalf("model checker has failed: %s", err)
 	}
 	if !ok {
 		fmt.Println("Fault could not find a failure case.")
+		explain(ex, model)
 		return ex, nil
 	}
-	scenario, err := ex.Solve()
+	data, err := ex.Filter(scenario)
 	if err != nil {
-		log.Fatalf("error found fetching solution from solver: %s", err)
+		log.Fatalf("model checker has failed: %s", err)
 	}
-	data := ex.Filter(scenario)
 	return ex, data
 }
 
-func run(filepath string, mode string, input string, output string, reach bool) {
+func enumerate(smt string, solver string, likely bool, n int, uncertains map[string]*ast.Distribution, unknowns []string, results map[string][]*smtvar.VarChange, rlog *resultlog.ResultLog, frks *forks.Fork) (*execute.ModelChecker, []*execute.Failure) {
+	ex := newModelChecker(solver)
+	ex.Likely = likely
+	ex.LoadModel(smt, uncertains, unknowns, results, rlog)
+	ex.LoadMeta(frks)
+	defer ex.Close()
+	failures, err := ex.Scenarios(n)
+	if err != nil {
+		log.Fatalf("model checker has failed: %s", err)
+	}
+	return ex, failures
+}
+
+func printScenarios(mc *execute.ModelChecker, failures []*execute.Failure, output string, model string) {
+	if len(failures) == 0 {
+		fmt.Println("Fault could not find a failure case.")
+		explain(mc, model)
+		return
+	}
+
+	for i, f := range failures {
+		fmt.Printf("Scenario %d of %d\n", i+1, len(failures))
+		mc.LoadFailure(f)
+		switch output {
+		case "legacy":
+			mc.Format(f.Results)
+		case "static":
+			mc.Static(f.Results)
+		default:
+			mc.EventLog(f.Results)
+		}
+	}
+}
+
+// explain prints what rules out every failure, if the model
+// was generated with named terms
+func explain(mc *execute.ModelChecker, model string) {
+	if mc.Log == nil || len(mc.Log.Terms) == 0 {
+		return
+	}
+
+	ex, err := mc.Explain(model)
+	if err != nil {
+		fmt.Printf("Fault could not explain the result: %s\n", err)
+		return
+	}
+	if ex != nil {
+		fmt.Print(ex)
+	}
+}
+
+// exportTrace writes the counterexamples out as a time series
+// for spreadsheets (csv) or waveform viewers (vcd)
+func exportTrace(mc *execute.ModelChecker, failures []*execute.Failure, output string, file string) {
+	if len(failures) == 0 {
+		fmt.Fprintln(os.Stderr, "Fault could not find a failure case.")
+		return
+	}
+
+	var err error
+	switch output {
+	case "csv":
+		err = mc.CSV(os.Stdout, failures)
+	case "vcd":
+		spec := strings.TrimSuffix(path.Base(file), path.Ext(file))
+		err = mc.VCD(os.Stdout, spec, failures)
+	}
+	if err != nil {
+		log.Fatalf("failed to export trace: %s", err)
+	}
+}
+
+func reportError(err error, diagnostics string) {
+	if diagnostics == "json" {
+		out, _ := json.MarshalIndent(fault.Diagnostics(err), "", "  ")
+		fmt.Println(string(out))
+	} else {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(1)
+}
+
+// formatFiles is the fmt subcommand. It prints the formatted
+// spec by default, -w rewrites the files in place and -check
+// only lists the files that aren't formatted (for CI).
+func formatFiles(args []string) {
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+	check := fs.Bool("check", false, "list files that are not formatted and exit with status 1 if there are any")
+	write := fs.Bool("w", false, "write the result back to the file instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: fault fmt [-check] [-w] files...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	var failed bool
+	for _, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+
+		formatted, err := format.Format(path, string(data))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+
+		switch {
+		case *check:
+			if formatted != string(data) {
+				fmt.Println(path)
+				failed = true
+			}
+		case *write:
+			if formatted != string(data) {
+				if err := os.WriteFile(path, []byte(formatted), 0644); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					failed = true
+				}
+			}
+		default:
+			fmt.Print(formatted)
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// assignments collects repeated name=value flags
+type assignments map[string]float64
+
+func (a assignments) String() string {
+	var pairs []string
+	for k, v := range a {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, v))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (a assignments) Set(s string) error {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("expected name=value, got %s", s)
+	}
+	v, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return fmt.Errorf("value of %s is not a number: %s", parts[0], parts[1])
+	}
+	a[parts[0]] = v
+	return nil
+}
+
+// overrides are the assignments as -set overrides, sorted by
+// name so the spec compiles the same way every time
+func (a assignments) overrides() []*fault.Override {
+	var overrides []*fault.Override
+	for name, v := range a {
+		overrides = append(overrides, &fault.Override{Name: name, Value: v})
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].Name < overrides[j].Name })
+	return overrides
+}
+
+func simulate(smt string, solver string, opts *execute.SimulationOptions, output string, uncertains map[string]*ast.Distribution, unknowns []string, results map[string][]*smtvar.VarChange, rlog *resultlog.ResultLog, frks *forks.Fork) {
+	ex := newModelChecker(solver)
+	ex.LoadModel(smt, uncertains, unknowns, results, rlog)
+	ex.LoadMeta(frks)
+	defer ex.Close()
+
+	if len(uncertains) == 0 {
+		fmt.Fprintln(os.Stderr, "spec has no uncertain values, every run will be the same")
+	}
+
+	sim, err := ex.Simulate(opts)
+	if err != nil {
+		log.Fatalf("simulation has failed: %s", err)
+	}
+
+	if output == "json" {
+		out, _ := json.MarshalIndent(sim, "", "  ")
+		fmt.Println(string(out))
+		return
+	}
+	fmt.Print(sim)
+}
+
+// probabilities checks the prob() asserts against the model
+// without the other asserts. Returns true if they were the
+// only asserts in the spec and there's nothing left to check.
+func probabilities(generator *smt.Generator, solver string, opts *execute.SimulationOptions, output string, uncertains map[string]*ast.Distribution, unknowns []string) bool {
+	if len(generator.Log.Probabilities) == 0 {
+		return false
+	}
+
+	ex := newModelChecker(solver)
+	ex.LoadModel(generator.Model(), uncertains, unknowns, generator.Results, generator.Log)
+	defer ex.Close()
+
+	verdicts, err := ex.CheckProbabilities(opts)
+	if err != nil {
+		log.Fatalf("checking probabilistic asserts has failed: %s", err)
+	}
+
+	if output == "json" {
+		out, _ := json.MarshalIndent(verdicts, "", "  ")
+		fmt.Println(string(out))
+	} else {
+		for _, v := range verdicts {
+			fmt.Print(v)
+		}
+		fmt.Println()
+	}
+	return len(generator.Log.ProcessedAsserts) == 0
+}
+
+// optimize answers the maximize and minimize queries against
+// the model without the asserts, printing each value and the
+// run that reaches it. Returns true if the queries were the
+// only thing in the spec to check.
+func optimize(generator *smt.Generator, solver string, output string, uncertains map[string]*ast.Distribution, unknowns []string) bool {
+	if len(generator.Log.Objectives) == 0 {
+		return false
+	}
+
+	ex := newModelChecker(solver)
+	ex.LoadModel(generator.Model(), uncertains, unknowns, generator.Results, generator.Log)
+	ex.LoadMeta(generator.Forks)
+	defer ex.Close()
+
+	optima, err := ex.OptimizeAll()
+	if err != nil {
+		log.Fatalf("optimization has failed: %s", err)
+	}
+
+	if output == "json" {
+		out, _ := json.MarshalIndent(optima, "", "  ")
+		fmt.Println(string(out))
+	} else {
+		for _, o := range optima {
+			fmt.Print(o)
+			ex.LoadFailure(o.Witness)
+			switch output {
+			case "legacy":
+				ex.Format(o.Witness.Results)
+			case "static":
+				ex.Static(o.Witness.Results)
+			default:
+				ex.EventLog(o.Witness.Results)
+			}
+		}
+	}
+	return len(generator.Log.ProcessedAsserts) == 0
+}
+
+// prove runs k-induction on the spec, printing the failure
+// that broke it if there is one
+func prove(source string, opts *fault.Options, k int, output string, diagnostics string) {
+	proof, err := fault.Prove(context.Background(), source, opts, k)
+	if err != nil {
+		reportError(err, diagnostics)
+	}
+
+	fmt.Println(proof)
+	if proof.Outcome == fault.Counterexample {
+		res := proof.Result
+		printScenarios(res.ModelChecker, res.Failures, output, res.Generator.Model())
+	}
+}
+
+// deepen checks the spec one round at a time, printing each
+// round that holds and the shortest failure if there is one
+func deepen(source string, opts *fault.Options, output string, diagnostics string) {
+	opts.Check = true
+	opts.Deepen = true
+	opts.Checked = func(round int) {
+		fmt.Printf("checked up to round %d\n", round)
+	}
+
+	res, err := fault.Compile(context.Background(), source, opts)
+	if err != nil {
+		reportError(err, diagnostics)
+	}
+
+	if !res.IsValid() {
+		fmt.Println("Fault found nothing to run. Missing run block or start block.")
+		return
+	}
+
+	fmt.Print(res.Deepening)
+	if res.Deepening.Failure != nil {
+		printScenarios(res.ModelChecker, res.Failures, output, res.Generator.Model())
+	}
+}
+
+// synthesize prints the box of unknown values where every
+// assert holds
+func synthesize(source string, opts *fault.Options, output string, diagnostics string) {
+	opts.Check = true
+	opts.Synthesize = true
+
+	res, err := fault.Compile(context.Background(), source, opts)
+	if err != nil {
+		reportError(err, diagnostics)
+	}
+
+	if !res.IsValid() {
+		fmt.Println("Fault found nothing to run. Missing run block or start block.")
+		return
+	}
+
+	if output == "json" {
+		out, _ := json.MarshalIndent(res.Envelope, "", "  ")
+		fmt.Println(string(out))
+		return
+	}
+	fmt.Print(res.Envelope)
+}
+
+// params collects repeated -param flags of the sweep subcommand
+type params []*fault.Param
+
+func (p *params) String() string {
+	var ranges []string
+	for _, x := range *p {
+		ranges = append(ranges, fmt.Sprintf("%s=%v..%v:%v", x.Name, x.From, x.To, x.Step))
+	}
+	return strings.Join(ranges, ",")
+}
+
+func (p *params) Set(s string) error {
+	x, err := fault.ParseParam(s)
+	if err != nil {
+		return err
+	}
+	*p = append(*p, x)
+	return nil
+}
+
+// sweepSpec is the sweep subcommand. It checks the spec once for
+// every combination of the -param ranges and prints the verdict
+// and the stocks at each point.
+func sweepSpec(args []string) {
+	fs := flag.NewFlagSet("sweep", flag.ExitOnError)
+	fp := fs.String("f", "", "path to file to check")
+	var ranges params
+	fs.Var(&ranges, "param", "constant or instance property to sweep as name=from..to:step, e.g. -param drain=1..10 or -param spec.pump.rate=0.5..2:0.5, can be repeated (default step: 1)")
+	sets := make(assignments)
+	fs.Var(sets, "set", "value kept the same at every point as name=value, can be repeated")
+	rounds := fs.Int("rounds", 0, "times the run block runs, overriding the number in the spec (default: as in the spec)")
+	jobs := fs.Int("j", 1, "points to check at the same time, 0 uses every CPU")
+	output := fs.String("format", "log", "format of the output: log or json")
+	diagnostics := fs.String("diagnostics", "text", "format of compile errors: text or json")
+	solverName := fs.String("solver", "", fmt.Sprintf("solver backend to run the model: %s (default: env if SOLVERCMD is set, otherwise z3)", strings.Join(execute.SolverNames(), ", ")))
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: fault sweep -f file -param name=from..to:step [-param ...] [-set name=value ...] [-rounds n] [-j n]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if *fp == "" || len(ranges) == 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	switch *output {
+	case "log", "json":
+	default:
+		fmt.Printf("%s is not a valid format, use log or json\n", *output)
+		os.Exit(1)
+	}
+
+	if *jobs < 0 {
+		fmt.Println("-j must be at least 0")
+		os.Exit(1)
+	}
+	if *rounds < 0 {
+		fmt.Println("-rounds must not be negative, 0 keeps the rounds in the spec")
+		os.Exit(1)
+	}
+	workers := *jobs
+	if workers == 0 {
+		workers = runtime.NumCPU()
+	}
+
+	solver := strings.ToLower(*solverName)
+	s, err := execute.LookupSolver(solver)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if !s.Available() {
+		fmt.Printf("solver %s not found, sweep needs a solver\n", s.Name)
+		os.Exit(1)
+	}
+
+	filetype := util.DetectMode(*fp)
+	if filetype == "" {
+		log.Fatal("file provided is not a .fspec or .fsystem file")
+	}
+	filepath := util.Filepath(*fp)
+	data, err := os.ReadFile(filepath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report, err := fault.Sweep(context.Background(), string(data), &fault.Options{
+		Filename:  filepath,
+		Type:      filetype,
+		Solver:    solver,
+		Overrides: sets.overrides(),
+		Rounds:    *rounds,
+	}, ranges, workers)
+	if err != nil {
+		reportError(err, *diagnostics)
+	}
+
+	if *output == "json" {
+		out, _ := json.MarshalIndent(report, "", "  ")
+		fmt.Println(string(out))
+		return
+	}
+	fmt.Print(report)
+}
+
+func run(filepath string, mode string, input string, output string, solver string, scenarios int, likely bool, diagnostics string, reach bool, sim *execute.SimulationOptions, synthesis *execute.SynthesisOptions, symbolic bool, k int, overrides []*fault.Override, rounds int) {
 	filetype := util.DetectMode(filepath)
 	if filetype == "" {
 		log.Fatal("file provided is not a .fspec or .fsystem file")
 	}
 
 	filepath = util.Filepath(filepath)
-	uncertains := make(map[string][]float64)
+	uncertains := make(map[string]*ast.Distribution)
 	unknowns := []string{}
 
 	data, err := os.ReadFile(filepath)
@@ -126,22 +536,83 @@ func run(filepath string, mode string, input string, output string, reach bool)
 		log.Fatal(err)
 	}
 	d := string(data)
-	path := gopath.Dir(filepath)
 
 	switch input {
 	case "fspec":
-		tree, lstnr, ty, visual, alias := parse(d, path, filepath, filetype, reach, output == "visualize")
-		if lstnr == nil {
-			log.Fatal("Fault parser returned nil")
+		if mode == "prove" {
+			prove(d, &fault.Options{
+				Filename:  filepath,
+				Type:      filetype,
+				Reach:     reach,
+				Solver:    solver,
+				Overrides: overrides,
+
+				SymbolicInterleaving: symbolic,
+			}, k, output, diagnostics)
+			return
 		}
 
-		if mode == "ast" {
-			fmt.Println(lstnr.AST)
+		if mode == "synthesize" {
+			synthesize(d, &fault.Options{
+				Filename:  filepath,
+				Type:      filetype,
+				Reach:     reach,
+				Solver:    solver,
+				Synthesis: synthesis,
+				Overrides: overrides,
+				Rounds:    rounds,
+
+				SymbolicInterleaving: symbolic,
+			}, output, diagnostics)
 			return
 		}
 
-		compiler := llvm.Execute(tree, ty.SpecStructs, lstnr.Uncertains, lstnr.Unknowns, alias, false)
+		if mode == "deepen" {
+			deepen(d, &fault.Options{
+				Filename:  filepath,
+				Type:      filetype,
+				Reach:     reach,
+				Solver:    solver,
+				Likely:    likely,
+				Overrides: overrides,
+				Rounds:    rounds,
+
+				SymbolicInterleaving: symbolic,
+			}, output, diagnostics)
+			return
+		}
+
+		stop := fault.StageSMT
+		switch mode {
+		case "ast":
+			stop = fault.StageParse
+		case "ir":
+			stop = fault.StageLLVM
+		}
+
+		res, err := fault.Compile(context.Background(), d, &fault.Options{
+			Filename:  filepath,
+			Type:      filetype,
+			Reach:     reach,
+			Visualize: output == "visualize",
+			Stop:      stop,
+			Explain:   mode == "check",
+			Overrides: overrides,
+			Rounds:    rounds,
+
+			SymbolicInterleaving: symbolic,
+		})
+		if err != nil {
+			reportError(err, diagnostics)
+		}
+
+		if mode == "ast" {
+			fmt.Println(res.AST)
+			return
+		}
 
+		compiler := res.Compiler
+		visual := res.Visual
 		uncertains = compiler.Uncertains
 		unknowns = compiler.Unknowns
 
@@ -161,18 +632,51 @@ func run(filepath string, mode string, input string, output string, reach bool)
 			return
 		}
 
-		generator := smt.Execute(compiler)
+		generator := res.Generator
 		if mode == "smt" {
 			fmt.Println(generator.SMT())
 			return
 		}
 
+		if mode == "simulate" {
+			simulate(generator.SMT(), solver, sim, output, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			return
+		}
+
 		if output == "smt" {
-			plainSolve(generator.SMT())
+			plainSolve(generator.SMT(), solver)
+			return
+		}
+
+		if mode == "check" {
+			checked := probabilities(generator, solver, sim, output, uncertains, unknowns)
+			optimized := optimize(generator, solver, output, uncertains, unknowns)
+			if checked || optimized {
+				return
+			}
+		}
+
+		if output == "json" {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			if err := mc.JSON(execute.Metadata{File: filepath, Type: filetype, Input: input}, failures); err != nil {
+				log.Fatal(err)
+			}
 			return
 		}
 
-		mc, data := probability(generator.SMT(), uncertains, unknowns, generator.Results, generator.Log)
+		if output == "csv" || output == "vcd" {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			exportTrace(mc, failures, output, filepath)
+			return
+		}
+
+		if scenarios > 1 {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			printScenarios(mc, failures, output, generator.Model())
+			return
+		}
+
+		mc, data := probability(generator.SMT(), generator.Model(), solver, likely, uncertains, unknowns, generator.Results, generator.Log)
 		if output == "visualize" {
 			fmt.Println(visual)
 			fmt.Printf("\n\n")
@@ -200,18 +704,51 @@ func run(filepath string, mode string, input string, output string, reach bool)
 		compiler := llvm.NewCompiler()
 		compiler.Uncertains = uncertains
 		compiler.Unknowns = unknowns
-		generator := smt2(d, compiler)
+		generator := smt2(d, compiler, symbolic, mode == "check")
 		if mode == "smt" {
 			fmt.Println(generator.SMT())
 			return
 		}
 
+		if mode == "simulate" {
+			simulate(generator.SMT(), solver, sim, output, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			return
+		}
+
 		if output == "smt" {
-			plainSolve(generator.SMT())
+			plainSolve(generator.SMT(), solver)
+			return
+		}
+
+		if mode == "check" {
+			checked := probabilities(generator, solver, sim, output, uncertains, unknowns)
+			optimized := optimize(generator, solver, output, uncertains, unknowns)
+			if checked || optimized {
+				return
+			}
+		}
+
+		if output == "json" {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			if err := mc.JSON(execute.Metadata{File: filepath, Type: filetype, Input: input}, failures); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		if output == "csv" || output == "vcd" {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			exportTrace(mc, failures, output, filepath)
 			return
 		}
 
-		mc, data := probability(generator.SMT(), uncertains, unknowns, generator.Results, generator.Log)
+		if scenarios > 1 {
+			mc, failures := enumerate(generator.SMT(), solver, likely, scenarios, uncertains, unknowns, generator.Results, generator.Log, generator.Forks)
+			printScenarios(mc, failures, output, generator.Model())
+			return
+		}
+
+		mc, data := probability(generator.SMT(), generator.Model(), solver, likely, uncertains, unknowns, generator.Results, generator.Log)
 		if mode == "visualize" {
 			mc.Mermaid()
 			return
@@ -234,11 +771,19 @@ func run(filepath string, mode string, input string, output string, reach bool)
 		}
 	case "smt2":
 		if output == "smt" {
-			plainSolve(d)
+			plainSolve(d, solver)
+			return
+		}
+
+		if output == "json" {
+			mc, failures := enumerate(d, solver, likely, scenarios, uncertains, unknowns, make(map[string][]*smtvar.VarChange), &resultlog.ResultLog{}, nil)
+			if err := mc.JSON(execute.Metadata{File: filepath, Type: filetype, Input: input}, failures); err != nil {
+				log.Fatal(err)
+			}
 			return
 		}
 
-		mc, data := probability(d, uncertains, unknowns, make(map[string][]*smtvar.VarChange), &resultlog.ResultLog{})
+		mc, data := probability(d, "", solver, likely, uncertains, unknowns, make(map[string][]*smtvar.VarChange), &resultlog.ResultLog{})
 
 		if mode == "visualize" {
 			mc.Mermaid()
@@ -262,16 +807,50 @@ func run(filepath string, mode string, input string, output string, reach bool)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "lsp" {
+		if err := lsp.NewServer(os.Stdin, os.Stdout).Serve(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "fmt" {
+		formatFiles(os.Args[2:])
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "sweep" {
+		sweepSpec(os.Args[2:])
+		return
+	}
+
 	var mode string
 	var input string
 	var output string
 	var filepath string
+	var solver string
+	var scenarios int
+	var diagnostics string
 	var reach bool
-	modeCommand := flag.String("m", "check", "stop compiler at certain milestones: ast, ir, smt, or check")
+	modeCommand := flag.String("m", "check", "stop compiler at certain milestones: ast, ir, smt, check, simulate, prove, deepen or synthesize")
 	inputCommand := flag.String("i", "fspec", "format of the input file (default: fspec)")
 	fpCommand := flag.String("f", "", "path to file to compile")
+	scenariosCommand := flag.Int("scenarios", 1, "number of distinct failure scenarios to search for")
+	likelyCommand := flag.Bool("likely", false, "search for the most probable failure given the uncertain values instead of any failure")
+	diagnosticsCommand := flag.String("diagnostics", "text", "format of compile errors: text or json")
+	samplesCommand := flag.Int("samples", 1000, "number of runs in simulate mode and for prob() asserts")
+	seedCommand := flag.Int64("seed", 1, "random seed for simulate mode and prob() asserts")
+	kCommand := flag.Int("k", 10, "most rounds to try in prove mode before giving up")
+	rangeCommand := flag.String("range", "-1000:1000", "values searched for each unknown in synthesize mode as low:high")
+	fixed := make(assignments)
+	roundsCommand := flag.Int("rounds", 0, "times the run block runs, overriding the number in the spec (default: as in the spec)")
+	sets := make(assignments)
+	flag.Var(sets, "set", "value of a constant or the initial value of an instance property as name=value, e.g. -set drain=3 or -set spec.pump.rate=0.5, can be repeated")
+	flag.Var(fixed, "fix", "value of an unknown in simulate mode and for prob() asserts as name=value, can be repeated (default: chosen by the solver)")
 	reachCommand := flag.Bool("complete", false, "make sure the transitions to all defined states are specified in the model")
-	outputCommand := flag.String("format", "log", "format of the output: log, static, smt, legacy, or visualize")
+	outputCommand := flag.String("format", "log", "format of the output: log, static, smt, json, csv, vcd, legacy, or visualize")
+	interleaveCommand := flag.String("interleave", "permute", "how parallel steps are ordered: permute (a branch per ordering) or symbolic (the solver picks)")
+	solverCommand := flag.String("solver", "", fmt.Sprintf("solver backend to run the model: %s (default: env if SOLVERCMD is set, otherwise z3)", strings.Join(execute.SolverNames(), ", ")))
 
 	flag.Parse()
 
@@ -291,6 +870,10 @@ func main() {
 		case "ir":
 		case "smt":
 		case "check":
+		case "simulate":
+		case "prove":
+		case "deepen":
+		case "synthesize":
 		default:
 			fmt.Printf("%s is not a valid mode\n", mode)
 			os.Exit(1)
@@ -307,16 +890,25 @@ func main() {
 		case "legacy":
 		case "visualize":
 		case "smt":
+		case "json":
+		case "csv":
+		case "vcd":
 		default:
 			fmt.Printf("%s is not a valid mode\n", output)
 			os.Exit(1)
 		}
 	}
 
+	solver = strings.ToLower(*solverCommand)
+	s, err := execute.LookupSolver(solver)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
 	//Check if solver is set
-	if mode == "check" &&
-		os.Getenv("SOLVERCMD") == "" || os.Getenv("SOLVERARG") == "" {
-		fmt.Printf("\n no solver configured, defaulting to SMT output without model checking. Please set SOLVERCMD and SOLVERARG variables.\n\n")
+	if mode == "check" && !s.Available() {
+		fmt.Printf("\n solver %s not found, defaulting to SMT output without model checking. Install %s, pick another backend with -solver or set SOLVERCMD and SOLVERARG variables.\n\n", s.Name, s.Command)
 		mode = "smt"
 	}
 
@@ -334,9 +926,118 @@ func main() {
 		}
 	}
 
+	scenarios = *scenariosCommand
+	if scenarios < 1 {
+		fmt.Println("-scenarios must be at least 1")
+		os.Exit(1)
+	}
+
+	if scenarios > 1 && output != "json" && (input == "smt2" || output == "smt" || output == "visualize") {
+		fmt.Printf("-scenarios is not supported with %s input and %s output\n", input, output)
+		os.Exit(1)
+	}
+
+	diagnostics = strings.ToLower(*diagnosticsCommand)
+	switch diagnostics {
+	case "text":
+	case "json":
+	default:
+		fmt.Printf("%s is not a valid diagnostics format\n", diagnostics)
+		os.Exit(1)
+	}
+
 	if *reachCommand {
 		reach = true
 	}
 
-	run(filepath, mode, input, output, reach)
+	var symbolic bool
+	switch strings.ToLower(*interleaveCommand) {
+	case "permute":
+	case "symbolic":
+		symbolic = true
+	default:
+		fmt.Printf("%s is not a valid interleaving, use permute or symbolic\n", *interleaveCommand)
+		os.Exit(1)
+	}
+
+	if *samplesCommand < 1 {
+		fmt.Println("-samples must be at least 1")
+		os.Exit(1)
+	}
+	sim := &execute.SimulationOptions{Samples: *samplesCommand, Seed: *seedCommand, Fixed: fixed}
+
+	if mode == "simulate" {
+		if input == "smt2" {
+			fmt.Println("simulate mode needs a spec or llvm ir, smt2 input has no uncertain values")
+			os.Exit(1)
+		}
+		if !s.Available() {
+			fmt.Printf("solver %s not found, simulate mode needs a solver\n", s.Name)
+			os.Exit(1)
+		}
+	}
+
+	var synthesis *execute.SynthesisOptions
+	if mode == "synthesize" {
+		if input != "fspec" {
+			fmt.Println("synthesize mode needs a spec")
+			os.Exit(1)
+		}
+		if !s.Available() {
+			fmt.Printf("solver %s not found, synthesize mode needs a solver\n", s.Name)
+			os.Exit(1)
+		}
+
+		low, high, found := strings.Cut(*rangeCommand, ":")
+		lo, errLow := strconv.ParseFloat(low, 64)
+		hi, errHigh := strconv.ParseFloat(high, 64)
+		if !found || errLow != nil || errHigh != nil || lo >= hi {
+			fmt.Printf("%s is not a valid range, use low:high\n", *rangeCommand)
+			os.Exit(1)
+		}
+		synthesis = &execute.SynthesisOptions{Low: lo, High: hi}
+	}
+
+	if mode == "deepen" {
+		if input != "fspec" {
+			fmt.Println("deepen mode needs a spec")
+			os.Exit(1)
+		}
+		if !s.Available() {
+			fmt.Printf("solver %s not found, deepen mode needs a solver\n", s.Name)
+			os.Exit(1)
+		}
+	}
+
+	if mode == "prove" {
+		if input != "fspec" {
+			fmt.Println("prove mode needs a spec, ll and smt2 input can't change the number of rounds")
+			os.Exit(1)
+		}
+		if !s.Available() {
+			fmt.Printf("solver %s not found, prove mode needs a solver\n", s.Name)
+			os.Exit(1)
+		}
+		if *kCommand < 1 {
+			fmt.Println("-k must be at least 1")
+			os.Exit(1)
+		}
+	}
+
+	if *roundsCommand < 0 {
+		fmt.Println("-rounds must not be negative, 0 keeps the rounds in the spec")
+		os.Exit(1)
+	}
+	if (len(sets) > 0 || *roundsCommand > 0) && input != "fspec" {
+		fmt.Printf("-set and -rounds need a spec, %s input is already compiled\n", input)
+		os.Exit(1)
+	}
+	if *roundsCommand > 0 && mode == "prove" {
+		fmt.Println("-rounds is not supported in prove mode, use -k for the most rounds to try")
+		os.Exit(1)
+	}
+
+	overrides := sets.overrides()
+
+	run(filepath, mode, input, output, solver, scenarios, *likelyCommand, diagnostics, reach, sim, synthesis, symbolic, *kCommand, overrides, *roundsCommand)
 }
@@ -37,6 +37,8 @@ func faultparserParserInit() {
 		"'flow'", "'for'", "'func'", "'if'", "'import'", "'init'", "'new'",
 		"'return'", "'run'", "'spec'", "'stock'", "'then'", "'when'", "'this'",
 		"'eventually'", "'eventually-always'", "'always'", "'nmt'", "'nft'",
+		"'next'", "'until'", "'weak-until'", "'release'", "'within'", "'after'",
+		"'between'", "'and'", "'prob'", "'maximize'", "'minimize'", "'at'",
 		"'nil'", "'true'", "'false'", "'advance'", "'component'", "'global'",
 		"'system'", "'start'", "'states'", "'stay'", "'string'", "'bool'", "'int'",
 		"'float'", "'natural'", "'uncertain'", "'unknown'", "", "'='", "'->'",
@@ -49,16 +51,18 @@ func faultparserParserInit() {
 		"", "ALL", "ASSERT", "ASSUME", "CLOCK", "CONST", "DEF", "ELSE", "FLOW",
 		"FOR", "FUNC", "IF", "IMPORT", "INIT", "NEW", "RETURN", "RUN", "SPEC",
 		"STOCK", "THEN", "WHEN", "THIS", "EVENTUALLY", "EVENTUALLYALWAYS", "ALWAYS",
-		"NMT", "NFT", "NIL", "TRUE", "FALSE", "ADVANCE", "COMPONENT", "GLOBAL",
-		"SYSTEM", "START", "STATE", "STAY", "TY_STRING", "TY_BOOL", "TY_INT",
-		"TY_FLOAT", "TY_NATURAL", "TY_UNCERTAIN", "TY_UNKNOWN", "IDENT", "ASSIGN",
-		"ASSIGN_FLOW1", "ASSIGN_FLOW2", "COLON", "COMMA", "DOT", "LPAREN", "RPAREN",
-		"LCURLY", "RCURLY", "LBRACE", "RBRACE", "SEMI", "PLUS_PLUS", "MINUS_MINUS",
-		"AMPERSAND", "AND", "BANG", "EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS",
-		"GREATER", "GREATER_OR_EQUALS", "OR", "PIPE", "PLUS", "MINUS", "CARET",
-		"EXPO", "MULTI", "DIV", "MOD", "LSHIFT", "RSHIFT", "BIT_CLEAR", "DECIMAL_LIT",
-		"OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT",
-		"WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
+		"NMT", "NFT", "NEXT", "UNTIL", "WEAK_UNTIL", "RELEASE", "WITHIN", "AFTER",
+		"BETWEEN", "WINDOW_AND", "PROB", "MAXIMIZE", "MINIMIZE", "AT", "NIL",
+		"TRUE", "FALSE", "ADVANCE", "COMPONENT", "GLOBAL", "SYSTEM", "START",
+		"STATE", "STAY", "TY_STRING", "TY_BOOL", "TY_INT", "TY_FLOAT", "TY_NATURAL",
+		"TY_UNCERTAIN", "TY_UNKNOWN", "IDENT", "ASSIGN", "ASSIGN_FLOW1", "ASSIGN_FLOW2",
+		"COLON", "COMMA", "DOT", "LPAREN", "RPAREN", "LCURLY", "RCURLY", "LBRACE",
+		"RBRACE", "SEMI", "PLUS_PLUS", "MINUS_MINUS", "AMPERSAND", "AND", "BANG",
+		"EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS", "GREATER", "GREATER_OR_EQUALS",
+		"OR", "PIPE", "PLUS", "MINUS", "CARET", "EXPO", "MULTI", "DIV", "MOD",
+		"LSHIFT", "RSHIFT", "BIT_CLEAR", "DECIMAL_LIT", "OCTAL_LIT", "HEX_LIT",
+		"FLOAT_LIT", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT", "WS", "COMMENT",
+		"TERMINATOR", "LINE_COMMENT",
 	}
 	staticData.ruleNames = []string{
 		"sysSpec", "sysClause", "globalDecl", "swap", "componentDecl", "startBlock",
@@ -67,16 +71,17 @@ func faultparserParserInit() {
 		"compoundString", "identList", "constants", "nil", "expressionList",
 		"structDecl", "structType", "sfProperties", "comProperties", "structProperties",
 		"initDecl", "block", "statementList", "statement", "simpleStmt", "incDecStmt",
-		"stateChange", "accessHistory", "assertion", "assumption", "temporal",
-		"invariant", "assignment", "emptyStmt", "ifStmt", "ifStmtRun", "ifStmtState",
-		"forStmt", "rounds", "paramCall", "stateBlock", "stateStep", "runBlock",
-		"initBlock", "initStep", "runStep", "faultType", "solvable", "expression",
-		"operand", "operandName", "prefix", "numeric", "integer", "negative",
-		"float_", "string_", "bool_", "functionLit", "stateLit", "eos",
+		"stateChange", "accessHistory", "assertion", "assumption", "optimize",
+		"temporal", "invariant", "window", "assignment", "emptyStmt", "ifStmt",
+		"ifStmtRun", "ifStmtState", "forStmt", "rounds", "paramCall", "stateBlock",
+		"stateStep", "runBlock", "initBlock", "initStep", "runStep", "faultType",
+		"solvable", "expression", "operand", "probability", "operandName", "prefix",
+		"numeric", "integer", "negative", "float_", "string_", "bool_", "functionLit",
+		"stateLit", "eos",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 90, 770, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 102, 812, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -88,345 +93,366 @@ func faultparserParserInit() {
 		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
 		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
 		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 1, 0,
-		1, 0, 5, 0, 139, 8, 0, 10, 0, 12, 0, 142, 9, 0, 1, 0, 5, 0, 145, 8, 0,
-		10, 0, 12, 0, 148, 9, 0, 1, 0, 5, 0, 151, 8, 0, 10, 0, 12, 0, 154, 9, 0,
-		1, 0, 1, 0, 1, 0, 5, 0, 159, 8, 0, 10, 0, 12, 0, 162, 9, 0, 1, 0, 3, 0,
-		165, 8, 0, 1, 0, 3, 0, 168, 8, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
-		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 5, 2, 182, 8, 2, 10, 2, 12, 2, 185, 9,
-		2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 196, 8,
-		3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 206, 8, 4, 10,
-		4, 12, 4, 209, 9, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 5,
-		5, 219, 8, 5, 10, 5, 12, 5, 222, 9, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1,
-		6, 1, 6, 1, 7, 1, 7, 5, 7, 233, 8, 7, 10, 7, 12, 7, 236, 9, 7, 1, 7, 3,
-		7, 239, 8, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 5, 9, 249,
-		8, 9, 10, 9, 12, 9, 252, 9, 9, 1, 9, 3, 9, 255, 8, 9, 1, 9, 1, 9, 1, 10,
-		3, 10, 260, 8, 10, 1, 10, 1, 10, 3, 10, 264, 8, 10, 1, 11, 1, 11, 1, 12,
-		1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 273, 8, 12, 1, 13, 1, 13, 1, 14, 1,
-		14, 1, 14, 1, 14, 1, 14, 1, 14, 5, 14, 283, 8, 14, 10, 14, 12, 14, 286,
-		9, 14, 1, 14, 1, 14, 3, 14, 290, 8, 14, 1, 15, 1, 15, 1, 15, 3, 15, 295,
-		8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 312, 8, 16, 1, 17, 1, 17,
-		1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 3, 17, 322, 8, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 17, 5, 17, 330, 8, 17, 10, 17, 12, 17, 333,
-		9, 17, 1, 18, 1, 18, 1, 18, 5, 18, 338, 8, 18, 10, 18, 12, 18, 341, 9,
-		18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 3, 19, 348, 8, 19, 1, 20, 1, 20,
-		1, 21, 1, 21, 1, 21, 5, 21, 355, 8, 21, 10, 21, 12, 21, 358, 9, 21, 1,
-		22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
-		5, 23, 371, 8, 23, 10, 23, 12, 23, 374, 9, 23, 1, 23, 1, 23, 1, 23, 1,
-		23, 1, 23, 1, 23, 5, 23, 382, 8, 23, 10, 23, 12, 23, 385, 9, 23, 1, 23,
-		3, 23, 388, 8, 23, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 394, 8, 24, 1, 25,
-		1, 25, 1, 25, 1, 25, 3, 25, 400, 8, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1,
-		26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26,
-		1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 421, 8, 26, 1, 27, 1, 27, 1, 27, 1,
-		27, 1, 28, 1, 28, 3, 28, 429, 8, 28, 1, 28, 1, 28, 1, 29, 4, 29, 434, 8,
-		29, 11, 29, 12, 29, 435, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
-		3, 30, 445, 8, 30, 1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 451, 8, 31, 1, 32,
-		1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 3, 33, 465, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33,
-		473, 8, 33, 10, 33, 12, 33, 476, 9, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1,
-		34, 4, 34, 483, 8, 34, 11, 34, 12, 34, 484, 1, 35, 1, 35, 1, 35, 3, 35,
-		490, 8, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 3, 36, 497, 8, 36, 1, 36,
-		1, 36, 1, 37, 1, 37, 1, 37, 3, 37, 504, 8, 37, 1, 38, 1, 38, 1, 38, 1,
-		38, 1, 38, 1, 38, 3, 38, 512, 8, 38, 1, 39, 1, 39, 3, 39, 516, 8, 39, 1,
-		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 525, 8, 39, 1, 40,
-		1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 3, 41, 533, 8, 41, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 41, 3, 41, 540, 8, 41, 3, 41, 542, 8, 41, 1, 42, 1, 42, 1,
-		42, 1, 42, 3, 42, 548, 8, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42,
-		555, 8, 42, 3, 42, 557, 8, 42, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 563,
-		8, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 570, 8, 43, 3, 43, 572,
-		8, 43, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 578, 8, 44, 1, 44, 1, 44, 1,
-		44, 3, 44, 583, 8, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46,
-		5, 46, 592, 8, 46, 10, 46, 12, 46, 595, 9, 46, 1, 47, 1, 47, 5, 47, 599,
-		8, 47, 10, 47, 12, 47, 602, 9, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 3,
-		48, 609, 8, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 617, 8,
-		48, 1, 49, 1, 49, 5, 49, 621, 8, 49, 10, 49, 12, 49, 624, 9, 49, 1, 49,
-		1, 49, 1, 50, 1, 50, 5, 50, 630, 8, 50, 10, 50, 12, 50, 633, 9, 50, 1,
-		50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 3, 51, 642, 8, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 5, 51, 648, 8, 51, 10, 51, 12, 51, 651, 9, 51, 1,
-		52, 1, 52, 1, 52, 5, 52, 656, 8, 52, 10, 52, 12, 52, 659, 9, 52, 1, 52,
-		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 667, 8, 52, 1, 53, 1, 53, 1,
-		54, 1, 54, 1, 54, 3, 54, 674, 8, 54, 1, 54, 1, 54, 5, 54, 678, 8, 54, 10,
-		54, 12, 54, 681, 9, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55,
-		689, 8, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 5, 55,
-		709, 8, 55, 10, 55, 12, 55, 712, 9, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1,
-		56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 3, 56, 724, 8, 56, 1, 57, 1, 57,
-		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 734, 8, 57, 3, 57, 736,
-		8, 57, 1, 58, 1, 58, 1, 58, 3, 58, 741, 8, 58, 1, 59, 1, 59, 1, 59, 3,
-		59, 746, 8, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 3, 61, 754, 8,
-		61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 66,
-		1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 0, 3, 34, 66, 110, 68, 0, 2, 4, 6, 8,
-		10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
-		46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
-		82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
-		114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 0, 15, 2, 0, 44,
-		44, 50, 50, 1, 0, 63, 68, 1, 0, 58, 59, 1, 0, 22, 24, 1, 0, 25, 26, 3,
-		0, 60, 60, 71, 73, 75, 80, 1, 0, 46, 47, 2, 0, 21, 21, 44, 44, 1, 0, 37,
-		43, 2, 0, 60, 60, 75, 80, 1, 0, 71, 73, 4, 0, 60, 60, 62, 62, 71, 73, 75,
-		75, 1, 0, 81, 83, 1, 0, 85, 86, 1, 0, 28, 29, 821, 0, 136, 1, 0, 0, 0,
-		2, 169, 1, 0, 0, 0, 4, 173, 1, 0, 0, 0, 6, 186, 1, 0, 0, 0, 8, 197, 1,
-		0, 0, 0, 10, 213, 1, 0, 0, 0, 12, 226, 1, 0, 0, 0, 14, 230, 1, 0, 0, 0,
-		16, 240, 1, 0, 0, 0, 18, 244, 1, 0, 0, 0, 20, 259, 1, 0, 0, 0, 22, 265,
-		1, 0, 0, 0, 24, 272, 1, 0, 0, 0, 26, 274, 1, 0, 0, 0, 28, 276, 1, 0, 0,
-		0, 30, 291, 1, 0, 0, 0, 32, 311, 1, 0, 0, 0, 34, 321, 1, 0, 0, 0, 36, 334,
-		1, 0, 0, 0, 38, 347, 1, 0, 0, 0, 40, 349, 1, 0, 0, 0, 42, 351, 1, 0, 0,
-		0, 44, 359, 1, 0, 0, 0, 46, 387, 1, 0, 0, 0, 48, 393, 1, 0, 0, 0, 50, 399,
-		1, 0, 0, 0, 52, 420, 1, 0, 0, 0, 54, 422, 1, 0, 0, 0, 56, 426, 1, 0, 0,
-		0, 58, 433, 1, 0, 0, 0, 60, 444, 1, 0, 0, 0, 62, 450, 1, 0, 0, 0, 64, 452,
-		1, 0, 0, 0, 66, 464, 1, 0, 0, 0, 68, 477, 1, 0, 0, 0, 70, 486, 1, 0, 0,
-		0, 72, 493, 1, 0, 0, 0, 74, 503, 1, 0, 0, 0, 76, 511, 1, 0, 0, 0, 78, 524,
-		1, 0, 0, 0, 80, 526, 1, 0, 0, 0, 82, 528, 1, 0, 0, 0, 84, 543, 1, 0, 0,
-		0, 86, 558, 1, 0, 0, 0, 88, 573, 1, 0, 0, 0, 90, 584, 1, 0, 0, 0, 92, 586,
-		1, 0, 0, 0, 94, 596, 1, 0, 0, 0, 96, 616, 1, 0, 0, 0, 98, 618, 1, 0, 0,
-		0, 100, 627, 1, 0, 0, 0, 102, 636, 1, 0, 0, 0, 104, 666, 1, 0, 0, 0, 106,
-		668, 1, 0, 0, 0, 108, 670, 1, 0, 0, 0, 110, 688, 1, 0, 0, 0, 112, 723,
-		1, 0, 0, 0, 114, 735, 1, 0, 0, 0, 116, 740, 1, 0, 0, 0, 118, 745, 1, 0,
-		0, 0, 120, 747, 1, 0, 0, 0, 122, 753, 1, 0, 0, 0, 124, 755, 1, 0, 0, 0,
-		126, 757, 1, 0, 0, 0, 128, 759, 1, 0, 0, 0, 130, 761, 1, 0, 0, 0, 132,
-		764, 1, 0, 0, 0, 134, 767, 1, 0, 0, 0, 136, 140, 3, 2, 1, 0, 137, 139,
-		3, 18, 9, 0, 138, 137, 1, 0, 0, 0, 139, 142, 1, 0, 0, 0, 140, 138, 1, 0,
-		0, 0, 140, 141, 1, 0, 0, 0, 141, 146, 1, 0, 0, 0, 142, 140, 1, 0, 0, 0,
-		143, 145, 3, 4, 2, 0, 144, 143, 1, 0, 0, 0, 145, 148, 1, 0, 0, 0, 146,
-		144, 1, 0, 0, 0, 146, 147, 1, 0, 0, 0, 147, 152, 1, 0, 0, 0, 148, 146,
-		1, 0, 0, 0, 149, 151, 3, 8, 4, 0, 150, 149, 1, 0, 0, 0, 151, 154, 1, 0,
-		0, 0, 152, 150, 1, 0, 0, 0, 152, 153, 1, 0, 0, 0, 153, 160, 1, 0, 0, 0,
-		154, 152, 1, 0, 0, 0, 155, 159, 3, 70, 35, 0, 156, 159, 3, 72, 36, 0, 157,
-		159, 3, 32, 16, 0, 158, 155, 1, 0, 0, 0, 158, 156, 1, 0, 0, 0, 158, 157,
-		1, 0, 0, 0, 159, 162, 1, 0, 0, 0, 160, 158, 1, 0, 0, 0, 160, 161, 1, 0,
-		0, 0, 161, 164, 1, 0, 0, 0, 162, 160, 1, 0, 0, 0, 163, 165, 3, 10, 5, 0,
-		164, 163, 1, 0, 0, 0, 164, 165, 1, 0, 0, 0, 165, 167, 1, 0, 0, 0, 166,
-		168, 3, 88, 44, 0, 167, 166, 1, 0, 0, 0, 167, 168, 1, 0, 0, 0, 168, 1,
-		1, 0, 0, 0, 169, 170, 5, 33, 0, 0, 170, 171, 5, 44, 0, 0, 171, 172, 3,
-		134, 67, 0, 172, 3, 1, 0, 0, 0, 173, 174, 5, 32, 0, 0, 174, 175, 5, 44,
-		0, 0, 175, 176, 5, 45, 0, 0, 176, 177, 3, 112, 56, 0, 177, 183, 3, 134,
-		67, 0, 178, 179, 3, 6, 3, 0, 179, 180, 3, 134, 67, 0, 180, 182, 1, 0, 0,
-		0, 181, 178, 1, 0, 0, 0, 182, 185, 1, 0, 0, 0, 183, 181, 1, 0, 0, 0, 183,
-		184, 1, 0, 0, 0, 184, 5, 1, 0, 0, 0, 185, 183, 1, 0, 0, 0, 186, 187, 3,
-		92, 46, 0, 187, 195, 5, 45, 0, 0, 188, 196, 3, 130, 65, 0, 189, 196, 3,
-		118, 59, 0, 190, 196, 3, 126, 63, 0, 191, 196, 3, 128, 64, 0, 192, 196,
-		3, 114, 57, 0, 193, 196, 3, 116, 58, 0, 194, 196, 3, 108, 54, 0, 195, 188,
-		1, 0, 0, 0, 195, 189, 1, 0, 0, 0, 195, 190, 1, 0, 0, 0, 195, 191, 1, 0,
-		0, 0, 195, 192, 1, 0, 0, 0, 195, 193, 1, 0, 0, 0, 195, 194, 1, 0, 0, 0,
-		196, 7, 1, 0, 0, 0, 197, 198, 5, 31, 0, 0, 198, 199, 5, 44, 0, 0, 199,
-		200, 5, 45, 0, 0, 200, 201, 5, 35, 0, 0, 201, 207, 5, 53, 0, 0, 202, 203,
-		3, 50, 25, 0, 203, 204, 5, 49, 0, 0, 204, 206, 1, 0, 0, 0, 205, 202, 1,
-		0, 0, 0, 206, 209, 1, 0, 0, 0, 207, 205, 1, 0, 0, 0, 207, 208, 1, 0, 0,
-		0, 208, 210, 1, 0, 0, 0, 209, 207, 1, 0, 0, 0, 210, 211, 5, 54, 0, 0, 211,
-		212, 3, 134, 67, 0, 212, 9, 1, 0, 0, 0, 213, 214, 5, 34, 0, 0, 214, 220,
-		5, 53, 0, 0, 215, 216, 3, 12, 6, 0, 216, 217, 5, 49, 0, 0, 217, 219, 1,
-		0, 0, 0, 218, 215, 1, 0, 0, 0, 219, 222, 1, 0, 0, 0, 220, 218, 1, 0, 0,
-		0, 220, 221, 1, 0, 0, 0, 221, 223, 1, 0, 0, 0, 222, 220, 1, 0, 0, 0, 223,
-		224, 5, 54, 0, 0, 224, 225, 3, 134, 67, 0, 225, 11, 1, 0, 0, 0, 226, 227,
-		5, 44, 0, 0, 227, 228, 5, 48, 0, 0, 228, 229, 5, 44, 0, 0, 229, 13, 1,
-		0, 0, 0, 230, 234, 3, 16, 8, 0, 231, 233, 3, 24, 12, 0, 232, 231, 1, 0,
-		0, 0, 233, 236, 1, 0, 0, 0, 234, 232, 1, 0, 0, 0, 234, 235, 1, 0, 0, 0,
-		235, 238, 1, 0, 0, 0, 236, 234, 1, 0, 0, 0, 237, 239, 3, 88, 44, 0, 238,
-		237, 1, 0, 0, 0, 238, 239, 1, 0, 0, 0, 239, 15, 1, 0, 0, 0, 240, 241, 5,
-		17, 0, 0, 241, 242, 5, 44, 0, 0, 242, 243, 3, 134, 67, 0, 243, 17, 1, 0,
-		0, 0, 244, 254, 5, 12, 0, 0, 245, 255, 3, 20, 10, 0, 246, 250, 5, 51, 0,
-		0, 247, 249, 3, 20, 10, 0, 248, 247, 1, 0, 0, 0, 249, 252, 1, 0, 0, 0,
-		250, 248, 1, 0, 0, 0, 250, 251, 1, 0, 0, 0, 251, 253, 1, 0, 0, 0, 252,
-		250, 1, 0, 0, 0, 253, 255, 5, 52, 0, 0, 254, 245, 1, 0, 0, 0, 254, 246,
-		1, 0, 0, 0, 255, 256, 1, 0, 0, 0, 256, 257, 3, 134, 67, 0, 257, 19, 1,
-		0, 0, 0, 258, 260, 7, 0, 0, 0, 259, 258, 1, 0, 0, 0, 259, 260, 1, 0, 0,
-		0, 260, 261, 1, 0, 0, 0, 261, 263, 3, 22, 11, 0, 262, 264, 5, 49, 0, 0,
-		263, 262, 1, 0, 0, 0, 263, 264, 1, 0, 0, 0, 264, 21, 1, 0, 0, 0, 265, 266,
-		3, 126, 63, 0, 266, 23, 1, 0, 0, 0, 267, 273, 3, 28, 14, 0, 268, 273, 3,
-		44, 22, 0, 269, 273, 3, 70, 35, 0, 270, 273, 3, 72, 36, 0, 271, 273, 3,
-		32, 16, 0, 272, 267, 1, 0, 0, 0, 272, 268, 1, 0, 0, 0, 272, 269, 1, 0,
-		0, 0, 272, 270, 1, 0, 0, 0, 272, 271, 1, 0, 0, 0, 273, 25, 1, 0, 0, 0,
-		274, 275, 7, 1, 0, 0, 275, 27, 1, 0, 0, 0, 276, 289, 5, 5, 0, 0, 277, 278,
-		3, 30, 15, 0, 278, 279, 3, 134, 67, 0, 279, 290, 1, 0, 0, 0, 280, 284,
-		5, 51, 0, 0, 281, 283, 3, 30, 15, 0, 282, 281, 1, 0, 0, 0, 283, 286, 1,
-		0, 0, 0, 284, 282, 1, 0, 0, 0, 284, 285, 1, 0, 0, 0, 285, 287, 1, 0, 0,
-		0, 286, 284, 1, 0, 0, 0, 287, 288, 5, 52, 0, 0, 288, 290, 3, 134, 67, 0,
-		289, 277, 1, 0, 0, 0, 289, 280, 1, 0, 0, 0, 290, 29, 1, 0, 0, 0, 291, 294,
-		3, 36, 18, 0, 292, 293, 5, 45, 0, 0, 293, 295, 3, 38, 19, 0, 294, 292,
-		1, 0, 0, 0, 294, 295, 1, 0, 0, 0, 295, 31, 1, 0, 0, 0, 296, 297, 5, 44,
-		0, 0, 297, 298, 5, 45, 0, 0, 298, 299, 3, 126, 63, 0, 299, 300, 3, 134,
-		67, 0, 300, 312, 1, 0, 0, 0, 301, 302, 5, 44, 0, 0, 302, 303, 5, 45, 0,
-		0, 303, 304, 3, 34, 17, 0, 304, 305, 3, 134, 67, 0, 305, 312, 1, 0, 0,
-		0, 306, 307, 5, 44, 0, 0, 307, 308, 5, 45, 0, 0, 308, 309, 3, 34, 17, 0,
-		309, 310, 3, 134, 67, 0, 310, 312, 1, 0, 0, 0, 311, 296, 1, 0, 0, 0, 311,
-		301, 1, 0, 0, 0, 311, 306, 1, 0, 0, 0, 312, 33, 1, 0, 0, 0, 313, 314, 6,
-		17, -1, 0, 314, 322, 3, 114, 57, 0, 315, 316, 5, 62, 0, 0, 316, 322, 3,
-		114, 57, 0, 317, 318, 5, 51, 0, 0, 318, 319, 3, 34, 17, 0, 319, 320, 5,
-		52, 0, 0, 320, 322, 1, 0, 0, 0, 321, 313, 1, 0, 0, 0, 321, 315, 1, 0, 0,
-		0, 321, 317, 1, 0, 0, 0, 322, 331, 1, 0, 0, 0, 323, 324, 10, 2, 0, 0, 324,
-		325, 5, 61, 0, 0, 325, 330, 3, 34, 17, 3, 326, 327, 10, 1, 0, 0, 327, 328,
-		5, 69, 0, 0, 328, 330, 3, 34, 17, 2, 329, 323, 1, 0, 0, 0, 329, 326, 1,
-		0, 0, 0, 330, 333, 1, 0, 0, 0, 331, 329, 1, 0, 0, 0, 331, 332, 1, 0, 0,
-		0, 332, 35, 1, 0, 0, 0, 333, 331, 1, 0, 0, 0, 334, 339, 3, 114, 57, 0,
-		335, 336, 5, 49, 0, 0, 336, 338, 3, 114, 57, 0, 337, 335, 1, 0, 0, 0, 338,
-		341, 1, 0, 0, 0, 339, 337, 1, 0, 0, 0, 339, 340, 1, 0, 0, 0, 340, 37, 1,
-		0, 0, 0, 341, 339, 1, 0, 0, 0, 342, 348, 3, 118, 59, 0, 343, 348, 3, 126,
-		63, 0, 344, 348, 3, 128, 64, 0, 345, 348, 3, 108, 54, 0, 346, 348, 3, 40,
-		20, 0, 347, 342, 1, 0, 0, 0, 347, 343, 1, 0, 0, 0, 347, 344, 1, 0, 0, 0,
-		347, 345, 1, 0, 0, 0, 347, 346, 1, 0, 0, 0, 348, 39, 1, 0, 0, 0, 349, 350,
-		5, 27, 0, 0, 350, 41, 1, 0, 0, 0, 351, 356, 3, 110, 55, 0, 352, 353, 5,
-		49, 0, 0, 353, 355, 3, 110, 55, 0, 354, 352, 1, 0, 0, 0, 355, 358, 1, 0,
-		0, 0, 356, 354, 1, 0, 0, 0, 356, 357, 1, 0, 0, 0, 357, 43, 1, 0, 0, 0,
-		358, 356, 1, 0, 0, 0, 359, 360, 5, 6, 0, 0, 360, 361, 5, 44, 0, 0, 361,
-		362, 5, 45, 0, 0, 362, 363, 3, 46, 23, 0, 363, 364, 3, 134, 67, 0, 364,
-		45, 1, 0, 0, 0, 365, 366, 5, 8, 0, 0, 366, 372, 5, 53, 0, 0, 367, 368,
-		3, 48, 24, 0, 368, 369, 5, 49, 0, 0, 369, 371, 1, 0, 0, 0, 370, 367, 1,
-		0, 0, 0, 371, 374, 1, 0, 0, 0, 372, 370, 1, 0, 0, 0, 372, 373, 1, 0, 0,
-		0, 373, 375, 1, 0, 0, 0, 374, 372, 1, 0, 0, 0, 375, 388, 5, 54, 0, 0, 376,
-		377, 5, 18, 0, 0, 377, 383, 5, 53, 0, 0, 378, 379, 3, 48, 24, 0, 379, 380,
-		5, 49, 0, 0, 380, 382, 1, 0, 0, 0, 381, 378, 1, 0, 0, 0, 382, 385, 1, 0,
-		0, 0, 383, 381, 1, 0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 386, 1, 0, 0, 0,
-		385, 383, 1, 0, 0, 0, 386, 388, 5, 54, 0, 0, 387, 365, 1, 0, 0, 0, 387,
-		376, 1, 0, 0, 0, 388, 47, 1, 0, 0, 0, 389, 390, 5, 44, 0, 0, 390, 391,
-		5, 48, 0, 0, 391, 394, 3, 130, 65, 0, 392, 394, 3, 52, 26, 0, 393, 389,
-		1, 0, 0, 0, 393, 392, 1, 0, 0, 0, 394, 49, 1, 0, 0, 0, 395, 396, 5, 44,
-		0, 0, 396, 397, 5, 48, 0, 0, 397, 400, 3, 132, 66, 0, 398, 400, 3, 52,
-		26, 0, 399, 395, 1, 0, 0, 0, 399, 398, 1, 0, 0, 0, 400, 51, 1, 0, 0, 0,
-		401, 402, 5, 44, 0, 0, 402, 403, 5, 48, 0, 0, 403, 421, 3, 118, 59, 0,
-		404, 405, 5, 44, 0, 0, 405, 406, 5, 48, 0, 0, 406, 421, 3, 126, 63, 0,
-		407, 408, 5, 44, 0, 0, 408, 409, 5, 48, 0, 0, 409, 421, 3, 128, 64, 0,
-		410, 411, 5, 44, 0, 0, 411, 412, 5, 48, 0, 0, 412, 421, 3, 114, 57, 0,
-		413, 414, 5, 44, 0, 0, 414, 415, 5, 48, 0, 0, 415, 421, 3, 116, 58, 0,
-		416, 417, 5, 44, 0, 0, 417, 418, 5, 48, 0, 0, 418, 421, 3, 108, 54, 0,
-		419, 421, 5, 44, 0, 0, 420, 401, 1, 0, 0, 0, 420, 404, 1, 0, 0, 0, 420,
-		407, 1, 0, 0, 0, 420, 410, 1, 0, 0, 0, 420, 413, 1, 0, 0, 0, 420, 416,
-		1, 0, 0, 0, 420, 419, 1, 0, 0, 0, 421, 53, 1, 0, 0, 0, 422, 423, 5, 13,
-		0, 0, 423, 424, 3, 112, 56, 0, 424, 425, 3, 134, 67, 0, 425, 55, 1, 0,
-		0, 0, 426, 428, 5, 53, 0, 0, 427, 429, 3, 58, 29, 0, 428, 427, 1, 0, 0,
-		0, 428, 429, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 431, 5, 54, 0, 0, 431,
-		57, 1, 0, 0, 0, 432, 434, 3, 60, 30, 0, 433, 432, 1, 0, 0, 0, 434, 435,
-		1, 0, 0, 0, 435, 433, 1, 0, 0, 0, 435, 436, 1, 0, 0, 0, 436, 59, 1, 0,
-		0, 0, 437, 445, 3, 28, 14, 0, 438, 445, 3, 54, 27, 0, 439, 440, 3, 62,
-		31, 0, 440, 441, 3, 134, 67, 0, 441, 445, 1, 0, 0, 0, 442, 445, 3, 56,
-		28, 0, 443, 445, 3, 82, 41, 0, 444, 437, 1, 0, 0, 0, 444, 438, 1, 0, 0,
-		0, 444, 439, 1, 0, 0, 0, 444, 442, 1, 0, 0, 0, 444, 443, 1, 0, 0, 0, 445,
-		61, 1, 0, 0, 0, 446, 451, 3, 110, 55, 0, 447, 451, 3, 64, 32, 0, 448, 451,
-		3, 78, 39, 0, 449, 451, 3, 80, 40, 0, 450, 446, 1, 0, 0, 0, 450, 447, 1,
-		0, 0, 0, 450, 448, 1, 0, 0, 0, 450, 449, 1, 0, 0, 0, 451, 63, 1, 0, 0,
-		0, 452, 453, 3, 110, 55, 0, 453, 454, 7, 2, 0, 0, 454, 65, 1, 0, 0, 0,
-		455, 456, 6, 33, -1, 0, 456, 457, 5, 30, 0, 0, 457, 458, 5, 51, 0, 0, 458,
-		459, 3, 92, 46, 0, 459, 460, 5, 52, 0, 0, 460, 465, 1, 0, 0, 0, 461, 462,
-		5, 36, 0, 0, 462, 463, 5, 51, 0, 0, 463, 465, 5, 52, 0, 0, 464, 455, 1,
-		0, 0, 0, 464, 461, 1, 0, 0, 0, 465, 474, 1, 0, 0, 0, 466, 467, 10, 2, 0,
-		0, 467, 468, 5, 61, 0, 0, 468, 473, 3, 66, 33, 3, 469, 470, 10, 1, 0, 0,
-		470, 471, 5, 69, 0, 0, 471, 473, 3, 66, 33, 2, 472, 466, 1, 0, 0, 0, 472,
-		469, 1, 0, 0, 0, 473, 476, 1, 0, 0, 0, 474, 472, 1, 0, 0, 0, 474, 475,
-		1, 0, 0, 0, 475, 67, 1, 0, 0, 0, 476, 474, 1, 0, 0, 0, 477, 482, 3, 114,
-		57, 0, 478, 479, 5, 55, 0, 0, 479, 480, 3, 110, 55, 0, 480, 481, 5, 56,
-		0, 0, 481, 483, 1, 0, 0, 0, 482, 478, 1, 0, 0, 0, 483, 484, 1, 0, 0, 0,
-		484, 482, 1, 0, 0, 0, 484, 485, 1, 0, 0, 0, 485, 69, 1, 0, 0, 0, 486, 487,
-		5, 2, 0, 0, 487, 489, 3, 76, 38, 0, 488, 490, 3, 74, 37, 0, 489, 488, 1,
-		0, 0, 0, 489, 490, 1, 0, 0, 0, 490, 491, 1, 0, 0, 0, 491, 492, 3, 134,
-		67, 0, 492, 71, 1, 0, 0, 0, 493, 494, 5, 3, 0, 0, 494, 496, 3, 76, 38,
-		0, 495, 497, 3, 74, 37, 0, 496, 495, 1, 0, 0, 0, 496, 497, 1, 0, 0, 0,
-		497, 498, 1, 0, 0, 0, 498, 499, 3, 134, 67, 0, 499, 73, 1, 0, 0, 0, 500,
-		504, 7, 3, 0, 0, 501, 502, 7, 4, 0, 0, 502, 504, 3, 120, 60, 0, 503, 500,
-		1, 0, 0, 0, 503, 501, 1, 0, 0, 0, 504, 75, 1, 0, 0, 0, 505, 512, 3, 110,
-		55, 0, 506, 507, 5, 20, 0, 0, 507, 508, 3, 110, 55, 0, 508, 509, 5, 19,
-		0, 0, 509, 510, 3, 110, 55, 0, 510, 512, 1, 0, 0, 0, 511, 505, 1, 0, 0,
-		0, 511, 506, 1, 0, 0, 0, 512, 77, 1, 0, 0, 0, 513, 515, 3, 42, 21, 0, 514,
-		516, 7, 5, 0, 0, 515, 514, 1, 0, 0, 0, 515, 516, 1, 0, 0, 0, 516, 517,
-		1, 0, 0, 0, 517, 518, 5, 45, 0, 0, 518, 519, 3, 42, 21, 0, 519, 525, 1,
-		0, 0, 0, 520, 521, 3, 42, 21, 0, 521, 522, 7, 6, 0, 0, 522, 523, 3, 42,
-		21, 0, 523, 525, 1, 0, 0, 0, 524, 513, 1, 0, 0, 0, 524, 520, 1, 0, 0, 0,
-		525, 79, 1, 0, 0, 0, 526, 527, 5, 57, 0, 0, 527, 81, 1, 0, 0, 0, 528, 532,
-		5, 11, 0, 0, 529, 530, 3, 62, 31, 0, 530, 531, 5, 57, 0, 0, 531, 533, 1,
-		0, 0, 0, 532, 529, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533, 534, 1, 0, 0,
-		0, 534, 535, 3, 110, 55, 0, 535, 541, 3, 56, 28, 0, 536, 539, 5, 7, 0,
-		0, 537, 540, 3, 82, 41, 0, 538, 540, 3, 56, 28, 0, 539, 537, 1, 0, 0, 0,
-		539, 538, 1, 0, 0, 0, 540, 542, 1, 0, 0, 0, 541, 536, 1, 0, 0, 0, 541,
-		542, 1, 0, 0, 0, 542, 83, 1, 0, 0, 0, 543, 547, 5, 11, 0, 0, 544, 545,
-		3, 62, 31, 0, 545, 546, 5, 57, 0, 0, 546, 548, 1, 0, 0, 0, 547, 544, 1,
-		0, 0, 0, 547, 548, 1, 0, 0, 0, 548, 549, 1, 0, 0, 0, 549, 550, 3, 110,
-		55, 0, 550, 556, 3, 98, 49, 0, 551, 554, 5, 7, 0, 0, 552, 555, 3, 84, 42,
-		0, 553, 555, 3, 98, 49, 0, 554, 552, 1, 0, 0, 0, 554, 553, 1, 0, 0, 0,
-		555, 557, 1, 0, 0, 0, 556, 551, 1, 0, 0, 0, 556, 557, 1, 0, 0, 0, 557,
-		85, 1, 0, 0, 0, 558, 562, 5, 11, 0, 0, 559, 560, 3, 62, 31, 0, 560, 561,
-		5, 57, 0, 0, 561, 563, 1, 0, 0, 0, 562, 559, 1, 0, 0, 0, 562, 563, 1, 0,
-		0, 0, 563, 564, 1, 0, 0, 0, 564, 565, 3, 110, 55, 0, 565, 571, 3, 94, 47,
-		0, 566, 569, 5, 7, 0, 0, 567, 570, 3, 86, 43, 0, 568, 570, 3, 94, 47, 0,
-		569, 567, 1, 0, 0, 0, 569, 568, 1, 0, 0, 0, 570, 572, 1, 0, 0, 0, 571,
-		566, 1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 87, 1, 0, 0, 0, 573, 574, 5,
-		9, 0, 0, 574, 577, 3, 90, 45, 0, 575, 576, 5, 13, 0, 0, 576, 578, 3, 100,
-		50, 0, 577, 575, 1, 0, 0, 0, 577, 578, 1, 0, 0, 0, 578, 579, 1, 0, 0, 0,
-		579, 580, 5, 16, 0, 0, 580, 582, 3, 98, 49, 0, 581, 583, 3, 134, 67, 0,
-		582, 581, 1, 0, 0, 0, 582, 583, 1, 0, 0, 0, 583, 89, 1, 0, 0, 0, 584, 585,
-		3, 120, 60, 0, 585, 91, 1, 0, 0, 0, 586, 587, 7, 7, 0, 0, 587, 588, 5,
-		50, 0, 0, 588, 593, 5, 44, 0, 0, 589, 590, 5, 50, 0, 0, 590, 592, 5, 44,
-		0, 0, 591, 589, 1, 0, 0, 0, 592, 595, 1, 0, 0, 0, 593, 591, 1, 0, 0, 0,
-		593, 594, 1, 0, 0, 0, 594, 93, 1, 0, 0, 0, 595, 593, 1, 0, 0, 0, 596, 600,
-		5, 53, 0, 0, 597, 599, 3, 96, 48, 0, 598, 597, 1, 0, 0, 0, 599, 602, 1,
-		0, 0, 0, 600, 598, 1, 0, 0, 0, 600, 601, 1, 0, 0, 0, 601, 603, 1, 0, 0,
-		0, 602, 600, 1, 0, 0, 0, 603, 604, 5, 54, 0, 0, 604, 95, 1, 0, 0, 0, 605,
-		608, 3, 92, 46, 0, 606, 607, 5, 70, 0, 0, 607, 609, 3, 92, 46, 0, 608,
-		606, 1, 0, 0, 0, 608, 609, 1, 0, 0, 0, 609, 610, 1, 0, 0, 0, 610, 611,
-		3, 134, 67, 0, 611, 617, 1, 0, 0, 0, 612, 613, 3, 66, 33, 0, 613, 614,
-		3, 134, 67, 0, 614, 617, 1, 0, 0, 0, 615, 617, 3, 86, 43, 0, 616, 605,
-		1, 0, 0, 0, 616, 612, 1, 0, 0, 0, 616, 615, 1, 0, 0, 0, 617, 97, 1, 0,
-		0, 0, 618, 622, 5, 53, 0, 0, 619, 621, 3, 104, 52, 0, 620, 619, 1, 0, 0,
-		0, 621, 624, 1, 0, 0, 0, 622, 620, 1, 0, 0, 0, 622, 623, 1, 0, 0, 0, 623,
-		625, 1, 0, 0, 0, 624, 622, 1, 0, 0, 0, 625, 626, 5, 54, 0, 0, 626, 99,
-		1, 0, 0, 0, 627, 631, 5, 53, 0, 0, 628, 630, 3, 102, 51, 0, 629, 628, 1,
-		0, 0, 0, 630, 633, 1, 0, 0, 0, 631, 629, 1, 0, 0, 0, 631, 632, 1, 0, 0,
-		0, 632, 634, 1, 0, 0, 0, 633, 631, 1, 0, 0, 0, 634, 635, 5, 54, 0, 0, 635,
-		101, 1, 0, 0, 0, 636, 637, 5, 44, 0, 0, 637, 638, 5, 45, 0, 0, 638, 641,
-		5, 14, 0, 0, 639, 642, 3, 92, 46, 0, 640, 642, 5, 44, 0, 0, 641, 639, 1,
-		0, 0, 0, 641, 640, 1, 0, 0, 0, 642, 643, 1, 0, 0, 0, 643, 649, 3, 134,
-		67, 0, 644, 645, 3, 6, 3, 0, 645, 646, 3, 134, 67, 0, 646, 648, 1, 0, 0,
-		0, 647, 644, 1, 0, 0, 0, 648, 651, 1, 0, 0, 0, 649, 647, 1, 0, 0, 0, 649,
-		650, 1, 0, 0, 0, 650, 103, 1, 0, 0, 0, 651, 649, 1, 0, 0, 0, 652, 657,
-		3, 92, 46, 0, 653, 654, 5, 70, 0, 0, 654, 656, 3, 92, 46, 0, 655, 653,
-		1, 0, 0, 0, 656, 659, 1, 0, 0, 0, 657, 655, 1, 0, 0, 0, 657, 658, 1, 0,
-		0, 0, 658, 660, 1, 0, 0, 0, 659, 657, 1, 0, 0, 0, 660, 661, 3, 134, 67,
-		0, 661, 667, 1, 0, 0, 0, 662, 663, 3, 62, 31, 0, 663, 664, 3, 134, 67,
-		0, 664, 667, 1, 0, 0, 0, 665, 667, 3, 84, 42, 0, 666, 652, 1, 0, 0, 0,
-		666, 662, 1, 0, 0, 0, 666, 665, 1, 0, 0, 0, 667, 105, 1, 0, 0, 0, 668,
-		669, 7, 8, 0, 0, 669, 107, 1, 0, 0, 0, 670, 671, 3, 106, 53, 0, 671, 673,
-		5, 51, 0, 0, 672, 674, 3, 112, 56, 0, 673, 672, 1, 0, 0, 0, 673, 674, 1,
-		0, 0, 0, 674, 679, 1, 0, 0, 0, 675, 676, 5, 49, 0, 0, 676, 678, 3, 112,
-		56, 0, 677, 675, 1, 0, 0, 0, 678, 681, 1, 0, 0, 0, 679, 677, 1, 0, 0, 0,
-		679, 680, 1, 0, 0, 0, 680, 682, 1, 0, 0, 0, 681, 679, 1, 0, 0, 0, 682,
-		683, 5, 52, 0, 0, 683, 109, 1, 0, 0, 0, 684, 685, 6, 55, -1, 0, 685, 689,
-		3, 112, 56, 0, 686, 689, 3, 108, 54, 0, 687, 689, 3, 116, 58, 0, 688, 684,
-		1, 0, 0, 0, 688, 686, 1, 0, 0, 0, 688, 687, 1, 0, 0, 0, 689, 710, 1, 0,
-		0, 0, 690, 691, 10, 6, 0, 0, 691, 692, 5, 74, 0, 0, 692, 709, 3, 110, 55,
-		7, 693, 694, 10, 5, 0, 0, 694, 695, 7, 9, 0, 0, 695, 709, 3, 110, 55, 6,
-		696, 697, 10, 4, 0, 0, 697, 698, 7, 10, 0, 0, 698, 709, 3, 110, 55, 5,
-		699, 700, 10, 3, 0, 0, 700, 701, 7, 1, 0, 0, 701, 709, 3, 110, 55, 4, 702,
-		703, 10, 2, 0, 0, 703, 704, 5, 61, 0, 0, 704, 709, 3, 110, 55, 3, 705,
-		706, 10, 1, 0, 0, 706, 707, 5, 69, 0, 0, 707, 709, 3, 110, 55, 2, 708,
-		690, 1, 0, 0, 0, 708, 693, 1, 0, 0, 0, 708, 696, 1, 0, 0, 0, 708, 699,
-		1, 0, 0, 0, 708, 702, 1, 0, 0, 0, 708, 705, 1, 0, 0, 0, 709, 712, 1, 0,
-		0, 0, 710, 708, 1, 0, 0, 0, 710, 711, 1, 0, 0, 0, 711, 111, 1, 0, 0, 0,
-		712, 710, 1, 0, 0, 0, 713, 724, 3, 40, 20, 0, 714, 724, 3, 118, 59, 0,
-		715, 724, 3, 126, 63, 0, 716, 724, 3, 128, 64, 0, 717, 724, 3, 114, 57,
-		0, 718, 724, 3, 68, 34, 0, 719, 720, 5, 51, 0, 0, 720, 721, 3, 110, 55,
-		0, 721, 722, 5, 52, 0, 0, 722, 724, 1, 0, 0, 0, 723, 713, 1, 0, 0, 0, 723,
-		714, 1, 0, 0, 0, 723, 715, 1, 0, 0, 0, 723, 716, 1, 0, 0, 0, 723, 717,
-		1, 0, 0, 0, 723, 718, 1, 0, 0, 0, 723, 719, 1, 0, 0, 0, 724, 113, 1, 0,
-		0, 0, 725, 736, 5, 44, 0, 0, 726, 736, 3, 92, 46, 0, 727, 736, 5, 21, 0,
-		0, 728, 736, 5, 4, 0, 0, 729, 730, 5, 14, 0, 0, 730, 733, 5, 44, 0, 0,
-		731, 732, 5, 50, 0, 0, 732, 734, 5, 44, 0, 0, 733, 731, 1, 0, 0, 0, 733,
-		734, 1, 0, 0, 0, 734, 736, 1, 0, 0, 0, 735, 725, 1, 0, 0, 0, 735, 726,
-		1, 0, 0, 0, 735, 727, 1, 0, 0, 0, 735, 728, 1, 0, 0, 0, 735, 729, 1, 0,
-		0, 0, 736, 115, 1, 0, 0, 0, 737, 741, 1, 0, 0, 0, 738, 739, 7, 11, 0, 0,
-		739, 741, 3, 110, 55, 0, 740, 737, 1, 0, 0, 0, 740, 738, 1, 0, 0, 0, 741,
-		117, 1, 0, 0, 0, 742, 746, 3, 120, 60, 0, 743, 746, 3, 122, 61, 0, 744,
-		746, 3, 124, 62, 0, 745, 742, 1, 0, 0, 0, 745, 743, 1, 0, 0, 0, 745, 744,
-		1, 0, 0, 0, 746, 119, 1, 0, 0, 0, 747, 748, 7, 12, 0, 0, 748, 121, 1, 0,
-		0, 0, 749, 750, 5, 72, 0, 0, 750, 754, 3, 120, 60, 0, 751, 752, 5, 72,
-		0, 0, 752, 754, 3, 124, 62, 0, 753, 749, 1, 0, 0, 0, 753, 751, 1, 0, 0,
-		0, 754, 123, 1, 0, 0, 0, 755, 756, 5, 84, 0, 0, 756, 125, 1, 0, 0, 0, 757,
-		758, 7, 13, 0, 0, 758, 127, 1, 0, 0, 0, 759, 760, 7, 14, 0, 0, 760, 129,
-		1, 0, 0, 0, 761, 762, 5, 10, 0, 0, 762, 763, 3, 56, 28, 0, 763, 131, 1,
-		0, 0, 0, 764, 765, 5, 10, 0, 0, 765, 766, 3, 94, 47, 0, 766, 133, 1, 0,
-		0, 0, 767, 768, 5, 57, 0, 0, 768, 135, 1, 0, 0, 0, 80, 140, 146, 152, 158,
-		160, 164, 167, 183, 195, 207, 220, 234, 238, 250, 254, 259, 263, 272, 284,
-		289, 294, 311, 321, 329, 331, 339, 347, 356, 372, 383, 387, 393, 399, 420,
-		428, 435, 444, 450, 464, 472, 474, 484, 489, 496, 503, 511, 515, 524, 532,
-		539, 541, 547, 554, 556, 562, 569, 571, 577, 582, 593, 600, 608, 616, 622,
-		631, 641, 649, 657, 666, 673, 679, 688, 708, 710, 723, 733, 735, 740, 745,
-		753,
+		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
+		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 1, 0, 1, 0, 5, 0, 145, 8, 0, 10, 0,
+		12, 0, 148, 9, 0, 1, 0, 5, 0, 151, 8, 0, 10, 0, 12, 0, 154, 9, 0, 1, 0,
+		5, 0, 157, 8, 0, 10, 0, 12, 0, 160, 9, 0, 1, 0, 1, 0, 1, 0, 5, 0, 165,
+		8, 0, 10, 0, 12, 0, 168, 9, 0, 1, 0, 3, 0, 171, 8, 0, 1, 0, 3, 0, 174,
+		8, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2,
+		1, 2, 5, 2, 188, 8, 2, 10, 2, 12, 2, 191, 9, 2, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 202, 8, 3, 1, 4, 1, 4, 1, 4, 1, 4,
+		1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 212, 8, 4, 10, 4, 12, 4, 215, 9, 4, 1, 4,
+		1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 5, 5, 225, 8, 5, 10, 5, 12, 5,
+		228, 9, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 5, 7,
+		239, 8, 7, 10, 7, 12, 7, 242, 9, 7, 1, 7, 3, 7, 245, 8, 7, 1, 8, 1, 8,
+		1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 5, 9, 255, 8, 9, 10, 9, 12, 9, 258,
+		9, 9, 1, 9, 3, 9, 261, 8, 9, 1, 9, 1, 9, 1, 10, 3, 10, 266, 8, 10, 1, 10,
+		1, 10, 3, 10, 270, 8, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1,
+		12, 1, 12, 3, 12, 280, 8, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 14, 5, 14, 290, 8, 14, 10, 14, 12, 14, 293, 9, 14, 1, 14, 1,
+		14, 3, 14, 297, 8, 14, 1, 15, 1, 15, 1, 15, 3, 15, 302, 8, 15, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16,
+		1, 16, 1, 16, 1, 16, 3, 16, 319, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1,
+		17, 1, 17, 1, 17, 1, 17, 3, 17, 329, 8, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 17, 5, 17, 337, 8, 17, 10, 17, 12, 17, 340, 9, 17, 1, 18, 1,
+		18, 1, 18, 5, 18, 345, 8, 18, 10, 18, 12, 18, 348, 9, 18, 1, 19, 1, 19,
+		1, 19, 1, 19, 1, 19, 3, 19, 355, 8, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1,
+		21, 5, 21, 362, 8, 21, 10, 21, 12, 21, 365, 9, 21, 1, 22, 1, 22, 1, 22,
+		1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 5, 23, 378, 8,
+		23, 10, 23, 12, 23, 381, 9, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
+		5, 23, 389, 8, 23, 10, 23, 12, 23, 392, 9, 23, 1, 23, 3, 23, 395, 8, 23,
+		1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 401, 8, 24, 1, 25, 1, 25, 1, 25, 1,
+		25, 3, 25, 407, 8, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26,
+		1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1,
+		26, 1, 26, 3, 26, 428, 8, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28,
+		3, 28, 436, 8, 28, 1, 28, 1, 28, 1, 29, 4, 29, 441, 8, 29, 11, 29, 12,
+		29, 442, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 452, 8,
+		30, 1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 458, 8, 31, 1, 32, 1, 32, 1, 32,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 472,
+		8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33, 480, 8, 33, 10,
+		33, 12, 33, 483, 9, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 4, 34, 490,
+		8, 34, 11, 34, 12, 34, 491, 1, 35, 1, 35, 1, 35, 3, 35, 497, 8, 35, 1,
+		35, 1, 35, 1, 36, 1, 36, 1, 36, 3, 36, 504, 8, 36, 1, 36, 1, 36, 1, 37,
+		1, 37, 1, 37, 1, 37, 3, 37, 512, 8, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1,
+		38, 3, 38, 519, 8, 38, 1, 39, 1, 39, 3, 39, 523, 8, 39, 1, 39, 1, 39, 1,
+		39, 1, 39, 1, 39, 3, 39, 530, 8, 39, 3, 39, 532, 8, 39, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 543, 8, 40, 1, 41,
+		1, 41, 3, 41, 547, 8, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
+		41, 3, 41, 556, 8, 41, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43,
+		564, 8, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 571, 8, 43, 3, 43,
+		573, 8, 43, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 579, 8, 44, 1, 44, 1, 44,
+		1, 44, 1, 44, 1, 44, 3, 44, 586, 8, 44, 3, 44, 588, 8, 44, 1, 45, 1, 45,
+		1, 45, 1, 45, 3, 45, 594, 8, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 3,
+		45, 601, 8, 45, 3, 45, 603, 8, 45, 1, 46, 1, 46, 1, 46, 1, 46, 3, 46, 609,
+		8, 46, 1, 46, 1, 46, 1, 46, 3, 46, 614, 8, 46, 1, 47, 1, 47, 1, 48, 1,
+		48, 1, 48, 1, 48, 1, 48, 5, 48, 623, 8, 48, 10, 48, 12, 48, 626, 9, 48,
+		1, 49, 1, 49, 5, 49, 630, 8, 49, 10, 49, 12, 49, 633, 9, 49, 1, 49, 1,
+		49, 1, 50, 1, 50, 1, 50, 3, 50, 640, 8, 50, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 50, 3, 50, 648, 8, 50, 1, 51, 1, 51, 5, 51, 652, 8, 51, 10, 51,
+		12, 51, 655, 9, 51, 1, 51, 1, 51, 1, 52, 1, 52, 5, 52, 661, 8, 52, 10,
+		52, 12, 52, 664, 9, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53,
+		3, 53, 673, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 5, 53, 679, 8, 53, 10, 53,
+		12, 53, 682, 9, 53, 1, 54, 1, 54, 1, 54, 5, 54, 687, 8, 54, 10, 54, 12,
+		54, 690, 9, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 3, 54, 698, 8,
+		54, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 3, 56, 705, 8, 56, 1, 56, 1, 56,
+		5, 56, 709, 8, 56, 10, 56, 12, 56, 712, 9, 56, 1, 56, 1, 56, 1, 57, 1,
+		57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 722, 8, 57, 1, 57, 1, 57, 1, 57,
+		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
+		57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 5, 57, 745, 8, 57,
+		10, 57, 12, 57, 748, 9, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1,
+		58, 1, 58, 1, 58, 1, 58, 1, 58, 3, 58, 761, 8, 58, 1, 59, 1, 59, 1, 59,
+		1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 3,
+		60, 776, 8, 60, 3, 60, 778, 8, 60, 1, 61, 1, 61, 1, 61, 3, 61, 783, 8,
+		61, 1, 62, 1, 62, 1, 62, 3, 62, 788, 8, 62, 1, 63, 1, 63, 1, 64, 1, 64,
+		1, 64, 1, 64, 3, 64, 796, 8, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 67, 1,
+		67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 0, 3,
+		34, 66, 114, 71, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
+		30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
+		66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100,
+		102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130,
+		132, 134, 136, 138, 140, 0, 19, 2, 0, 27, 27, 56, 56, 2, 0, 56, 56, 62,
+		62, 1, 0, 75, 80, 1, 0, 70, 71, 1, 0, 36, 37, 1, 0, 22, 24, 1, 0, 25, 26,
+		3, 0, 72, 72, 83, 85, 87, 92, 1, 0, 58, 59, 2, 0, 21, 21, 56, 56, 1, 0,
+		49, 55, 3, 0, 22, 22, 24, 24, 27, 27, 2, 0, 72, 72, 87, 92, 1, 0, 83, 85,
+		1, 0, 28, 30, 4, 0, 72, 72, 74, 74, 83, 85, 87, 87, 1, 0, 93, 95, 1, 0,
+		97, 98, 1, 0, 40, 41, 869, 0, 142, 1, 0, 0, 0, 2, 175, 1, 0, 0, 0, 4, 179,
+		1, 0, 0, 0, 6, 192, 1, 0, 0, 0, 8, 203, 1, 0, 0, 0, 10, 219, 1, 0, 0, 0,
+		12, 232, 1, 0, 0, 0, 14, 236, 1, 0, 0, 0, 16, 246, 1, 0, 0, 0, 18, 250,
+		1, 0, 0, 0, 20, 265, 1, 0, 0, 0, 22, 271, 1, 0, 0, 0, 24, 279, 1, 0, 0,
+		0, 26, 281, 1, 0, 0, 0, 28, 283, 1, 0, 0, 0, 30, 298, 1, 0, 0, 0, 32, 318,
+		1, 0, 0, 0, 34, 328, 1, 0, 0, 0, 36, 341, 1, 0, 0, 0, 38, 354, 1, 0, 0,
+		0, 40, 356, 1, 0, 0, 0, 42, 358, 1, 0, 0, 0, 44, 366, 1, 0, 0, 0, 46, 394,
+		1, 0, 0, 0, 48, 400, 1, 0, 0, 0, 50, 406, 1, 0, 0, 0, 52, 427, 1, 0, 0,
+		0, 54, 429, 1, 0, 0, 0, 56, 433, 1, 0, 0, 0, 58, 440, 1, 0, 0, 0, 60, 451,
+		1, 0, 0, 0, 62, 457, 1, 0, 0, 0, 64, 459, 1, 0, 0, 0, 66, 471, 1, 0, 0,
+		0, 68, 484, 1, 0, 0, 0, 70, 493, 1, 0, 0, 0, 72, 500, 1, 0, 0, 0, 74, 507,
+		1, 0, 0, 0, 76, 518, 1, 0, 0, 0, 78, 531, 1, 0, 0, 0, 80, 542, 1, 0, 0,
+		0, 82, 555, 1, 0, 0, 0, 84, 557, 1, 0, 0, 0, 86, 559, 1, 0, 0, 0, 88, 574,
+		1, 0, 0, 0, 90, 589, 1, 0, 0, 0, 92, 604, 1, 0, 0, 0, 94, 615, 1, 0, 0,
+		0, 96, 617, 1, 0, 0, 0, 98, 627, 1, 0, 0, 0, 100, 647, 1, 0, 0, 0, 102,
+		649, 1, 0, 0, 0, 104, 658, 1, 0, 0, 0, 106, 667, 1, 0, 0, 0, 108, 697,
+		1, 0, 0, 0, 110, 699, 1, 0, 0, 0, 112, 701, 1, 0, 0, 0, 114, 721, 1, 0,
+		0, 0, 116, 760, 1, 0, 0, 0, 118, 762, 1, 0, 0, 0, 120, 777, 1, 0, 0, 0,
+		122, 782, 1, 0, 0, 0, 124, 787, 1, 0, 0, 0, 126, 789, 1, 0, 0, 0, 128,
+		795, 1, 0, 0, 0, 130, 797, 1, 0, 0, 0, 132, 799, 1, 0, 0, 0, 134, 801,
+		1, 0, 0, 0, 136, 803, 1, 0, 0, 0, 138, 806, 1, 0, 0, 0, 140, 809, 1, 0,
+		0, 0, 142, 146, 3, 2, 1, 0, 143, 145, 3, 18, 9, 0, 144, 143, 1, 0, 0, 0,
+		145, 148, 1, 0, 0, 0, 146, 144, 1, 0, 0, 0, 146, 147, 1, 0, 0, 0, 147,
+		152, 1, 0, 0, 0, 148, 146, 1, 0, 0, 0, 149, 151, 3, 4, 2, 0, 150, 149,
+		1, 0, 0, 0, 151, 154, 1, 0, 0, 0, 152, 150, 1, 0, 0, 0, 152, 153, 1, 0,
+		0, 0, 153, 158, 1, 0, 0, 0, 154, 152, 1, 0, 0, 0, 155, 157, 3, 8, 4, 0,
+		156, 155, 1, 0, 0, 0, 157, 160, 1, 0, 0, 0, 158, 156, 1, 0, 0, 0, 158,
+		159, 1, 0, 0, 0, 159, 166, 1, 0, 0, 0, 160, 158, 1, 0, 0, 0, 161, 165,
+		3, 70, 35, 0, 162, 165, 3, 72, 36, 0, 163, 165, 3, 32, 16, 0, 164, 161,
+		1, 0, 0, 0, 164, 162, 1, 0, 0, 0, 164, 163, 1, 0, 0, 0, 165, 168, 1, 0,
+		0, 0, 166, 164, 1, 0, 0, 0, 166, 167, 1, 0, 0, 0, 167, 170, 1, 0, 0, 0,
+		168, 166, 1, 0, 0, 0, 169, 171, 3, 10, 5, 0, 170, 169, 1, 0, 0, 0, 170,
+		171, 1, 0, 0, 0, 171, 173, 1, 0, 0, 0, 172, 174, 3, 92, 46, 0, 173, 172,
+		1, 0, 0, 0, 173, 174, 1, 0, 0, 0, 174, 1, 1, 0, 0, 0, 175, 176, 5, 45,
+		0, 0, 176, 177, 5, 56, 0, 0, 177, 178, 3, 140, 70, 0, 178, 3, 1, 0, 0,
+		0, 179, 180, 5, 44, 0, 0, 180, 181, 5, 56, 0, 0, 181, 182, 5, 57, 0, 0,
+		182, 183, 3, 116, 58, 0, 183, 189, 3, 140, 70, 0, 184, 185, 3, 6, 3, 0,
+		185, 186, 3, 140, 70, 0, 186, 188, 1, 0, 0, 0, 187, 184, 1, 0, 0, 0, 188,
+		191, 1, 0, 0, 0, 189, 187, 1, 0, 0, 0, 189, 190, 1, 0, 0, 0, 190, 5, 1,
+		0, 0, 0, 191, 189, 1, 0, 0, 0, 192, 193, 3, 96, 48, 0, 193, 201, 5, 57,
+		0, 0, 194, 202, 3, 136, 68, 0, 195, 202, 3, 124, 62, 0, 196, 202, 3, 132,
+		66, 0, 197, 202, 3, 134, 67, 0, 198, 202, 3, 120, 60, 0, 199, 202, 3, 122,
+		61, 0, 200, 202, 3, 112, 56, 0, 201, 194, 1, 0, 0, 0, 201, 195, 1, 0, 0,
+		0, 201, 196, 1, 0, 0, 0, 201, 197, 1, 0, 0, 0, 201, 198, 1, 0, 0, 0, 201,
+		199, 1, 0, 0, 0, 201, 200, 1, 0, 0, 0, 202, 7, 1, 0, 0, 0, 203, 204, 5,
+		43, 0, 0, 204, 205, 5, 56, 0, 0, 205, 206, 5, 57, 0, 0, 206, 207, 5, 47,
+		0, 0, 207, 213, 5, 65, 0, 0, 208, 209, 3, 50, 25, 0, 209, 210, 5, 61, 0,
+		0, 210, 212, 1, 0, 0, 0, 211, 208, 1, 0, 0, 0, 212, 215, 1, 0, 0, 0, 213,
+		211, 1, 0, 0, 0, 213, 214, 1, 0, 0, 0, 214, 216, 1, 0, 0, 0, 215, 213,
+		1, 0, 0, 0, 216, 217, 5, 66, 0, 0, 217, 218, 3, 140, 70, 0, 218, 9, 1,
+		0, 0, 0, 219, 220, 5, 46, 0, 0, 220, 226, 5, 65, 0, 0, 221, 222, 3, 12,
+		6, 0, 222, 223, 5, 61, 0, 0, 223, 225, 1, 0, 0, 0, 224, 221, 1, 0, 0, 0,
+		225, 228, 1, 0, 0, 0, 226, 224, 1, 0, 0, 0, 226, 227, 1, 0, 0, 0, 227,
+		229, 1, 0, 0, 0, 228, 226, 1, 0, 0, 0, 229, 230, 5, 66, 0, 0, 230, 231,
+		3, 140, 70, 0, 231, 11, 1, 0, 0, 0, 232, 233, 5, 56, 0, 0, 233, 234, 5,
+		60, 0, 0, 234, 235, 7, 0, 0, 0, 235, 13, 1, 0, 0, 0, 236, 240, 3, 16, 8,
+		0, 237, 239, 3, 24, 12, 0, 238, 237, 1, 0, 0, 0, 239, 242, 1, 0, 0, 0,
+		240, 238, 1, 0, 0, 0, 240, 241, 1, 0, 0, 0, 241, 244, 1, 0, 0, 0, 242,
+		240, 1, 0, 0, 0, 243, 245, 3, 92, 46, 0, 244, 243, 1, 0, 0, 0, 244, 245,
+		1, 0, 0, 0, 245, 15, 1, 0, 0, 0, 246, 247, 5, 17, 0, 0, 247, 248, 5, 56,
+		0, 0, 248, 249, 3, 140, 70, 0, 249, 17, 1, 0, 0, 0, 250, 260, 5, 12, 0,
+		0, 251, 261, 3, 20, 10, 0, 252, 256, 5, 63, 0, 0, 253, 255, 3, 20, 10,
+		0, 254, 253, 1, 0, 0, 0, 255, 258, 1, 0, 0, 0, 256, 254, 1, 0, 0, 0, 256,
+		257, 1, 0, 0, 0, 257, 259, 1, 0, 0, 0, 258, 256, 1, 0, 0, 0, 259, 261,
+		5, 64, 0, 0, 260, 251, 1, 0, 0, 0, 260, 252, 1, 0, 0, 0, 261, 262, 1, 0,
+		0, 0, 262, 263, 3, 140, 70, 0, 263, 19, 1, 0, 0, 0, 264, 266, 7, 1, 0,
+		0, 265, 264, 1, 0, 0, 0, 265, 266, 1, 0, 0, 0, 266, 267, 1, 0, 0, 0, 267,
+		269, 3, 22, 11, 0, 268, 270, 5, 61, 0, 0, 269, 268, 1, 0, 0, 0, 269, 270,
+		1, 0, 0, 0, 270, 21, 1, 0, 0, 0, 271, 272, 3, 132, 66, 0, 272, 23, 1, 0,
+		0, 0, 273, 280, 3, 28, 14, 0, 274, 280, 3, 44, 22, 0, 275, 280, 3, 70,
+		35, 0, 276, 280, 3, 72, 36, 0, 277, 280, 3, 74, 37, 0, 278, 280, 3, 32,
+		16, 0, 279, 273, 1, 0, 0, 0, 279, 274, 1, 0, 0, 0, 279, 275, 1, 0, 0, 0,
+		279, 276, 1, 0, 0, 0, 279, 277, 1, 0, 0, 0, 279, 278, 1, 0, 0, 0, 280,
+		25, 1, 0, 0, 0, 281, 282, 7, 2, 0, 0, 282, 27, 1, 0, 0, 0, 283, 296, 5,
+		5, 0, 0, 284, 285, 3, 30, 15, 0, 285, 286, 3, 140, 70, 0, 286, 297, 1,
+		0, 0, 0, 287, 291, 5, 63, 0, 0, 288, 290, 3, 30, 15, 0, 289, 288, 1, 0,
+		0, 0, 290, 293, 1, 0, 0, 0, 291, 289, 1, 0, 0, 0, 291, 292, 1, 0, 0, 0,
+		292, 294, 1, 0, 0, 0, 293, 291, 1, 0, 0, 0, 294, 295, 5, 64, 0, 0, 295,
+		297, 3, 140, 70, 0, 296, 284, 1, 0, 0, 0, 296, 287, 1, 0, 0, 0, 297, 29,
+		1, 0, 0, 0, 298, 301, 3, 36, 18, 0, 299, 300, 5, 57, 0, 0, 300, 302, 3,
+		38, 19, 0, 301, 299, 1, 0, 0, 0, 301, 302, 1, 0, 0, 0, 302, 31, 1, 0, 0,
+		0, 303, 304, 5, 56, 0, 0, 304, 305, 5, 57, 0, 0, 305, 306, 3, 132, 66,
+		0, 306, 307, 3, 140, 70, 0, 307, 319, 1, 0, 0, 0, 308, 309, 5, 56, 0, 0,
+		309, 310, 5, 57, 0, 0, 310, 311, 3, 34, 17, 0, 311, 312, 3, 140, 70, 0,
+		312, 319, 1, 0, 0, 0, 313, 314, 5, 56, 0, 0, 314, 315, 5, 57, 0, 0, 315,
+		316, 3, 34, 17, 0, 316, 317, 3, 140, 70, 0, 317, 319, 1, 0, 0, 0, 318,
+		303, 1, 0, 0, 0, 318, 308, 1, 0, 0, 0, 318, 313, 1, 0, 0, 0, 319, 33, 1,
+		0, 0, 0, 320, 321, 6, 17, -1, 0, 321, 329, 3, 120, 60, 0, 322, 323, 5,
+		74, 0, 0, 323, 329, 3, 120, 60, 0, 324, 325, 5, 63, 0, 0, 325, 326, 3,
+		34, 17, 0, 326, 327, 5, 64, 0, 0, 327, 329, 1, 0, 0, 0, 328, 320, 1, 0,
+		0, 0, 328, 322, 1, 0, 0, 0, 328, 324, 1, 0, 0, 0, 329, 338, 1, 0, 0, 0,
+		330, 331, 10, 2, 0, 0, 331, 332, 5, 73, 0, 0, 332, 337, 3, 34, 17, 3, 333,
+		334, 10, 1, 0, 0, 334, 335, 5, 81, 0, 0, 335, 337, 3, 34, 17, 2, 336, 330,
+		1, 0, 0, 0, 336, 333, 1, 0, 0, 0, 337, 340, 1, 0, 0, 0, 338, 336, 1, 0,
+		0, 0, 338, 339, 1, 0, 0, 0, 339, 35, 1, 0, 0, 0, 340, 338, 1, 0, 0, 0,
+		341, 346, 3, 120, 60, 0, 342, 343, 5, 61, 0, 0, 343, 345, 3, 120, 60, 0,
+		344, 342, 1, 0, 0, 0, 345, 348, 1, 0, 0, 0, 346, 344, 1, 0, 0, 0, 346,
+		347, 1, 0, 0, 0, 347, 37, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0, 349, 355, 3,
+		124, 62, 0, 350, 355, 3, 132, 66, 0, 351, 355, 3, 134, 67, 0, 352, 355,
+		3, 112, 56, 0, 353, 355, 3, 40, 20, 0, 354, 349, 1, 0, 0, 0, 354, 350,
+		1, 0, 0, 0, 354, 351, 1, 0, 0, 0, 354, 352, 1, 0, 0, 0, 354, 353, 1, 0,
+		0, 0, 355, 39, 1, 0, 0, 0, 356, 357, 5, 39, 0, 0, 357, 41, 1, 0, 0, 0,
+		358, 363, 3, 114, 57, 0, 359, 360, 5, 61, 0, 0, 360, 362, 3, 114, 57, 0,
+		361, 359, 1, 0, 0, 0, 362, 365, 1, 0, 0, 0, 363, 361, 1, 0, 0, 0, 363,
+		364, 1, 0, 0, 0, 364, 43, 1, 0, 0, 0, 365, 363, 1, 0, 0, 0, 366, 367, 5,
+		6, 0, 0, 367, 368, 5, 56, 0, 0, 368, 369, 5, 57, 0, 0, 369, 370, 3, 46,
+		23, 0, 370, 371, 3, 140, 70, 0, 371, 45, 1, 0, 0, 0, 372, 373, 5, 8, 0,
+		0, 373, 379, 5, 65, 0, 0, 374, 375, 3, 48, 24, 0, 375, 376, 5, 61, 0, 0,
+		376, 378, 1, 0, 0, 0, 377, 374, 1, 0, 0, 0, 378, 381, 1, 0, 0, 0, 379,
+		377, 1, 0, 0, 0, 379, 380, 1, 0, 0, 0, 380, 382, 1, 0, 0, 0, 381, 379,
+		1, 0, 0, 0, 382, 395, 5, 66, 0, 0, 383, 384, 5, 18, 0, 0, 384, 390, 5,
+		65, 0, 0, 385, 386, 3, 48, 24, 0, 386, 387, 5, 61, 0, 0, 387, 389, 1, 0,
+		0, 0, 388, 385, 1, 0, 0, 0, 389, 392, 1, 0, 0, 0, 390, 388, 1, 0, 0, 0,
+		390, 391, 1, 0, 0, 0, 391, 393, 1, 0, 0, 0, 392, 390, 1, 0, 0, 0, 393,
+		395, 5, 66, 0, 0, 394, 372, 1, 0, 0, 0, 394, 383, 1, 0, 0, 0, 395, 47,
+		1, 0, 0, 0, 396, 397, 5, 56, 0, 0, 397, 398, 5, 60, 0, 0, 398, 401, 3,
+		136, 68, 0, 399, 401, 3, 52, 26, 0, 400, 396, 1, 0, 0, 0, 400, 399, 1,
+		0, 0, 0, 401, 49, 1, 0, 0, 0, 402, 403, 7, 0, 0, 0, 403, 404, 5, 60, 0,
+		0, 404, 407, 3, 138, 69, 0, 405, 407, 3, 52, 26, 0, 406, 402, 1, 0, 0,
+		0, 406, 405, 1, 0, 0, 0, 407, 51, 1, 0, 0, 0, 408, 409, 5, 56, 0, 0, 409,
+		410, 5, 60, 0, 0, 410, 428, 3, 124, 62, 0, 411, 412, 5, 56, 0, 0, 412,
+		413, 5, 60, 0, 0, 413, 428, 3, 132, 66, 0, 414, 415, 5, 56, 0, 0, 415,
+		416, 5, 60, 0, 0, 416, 428, 3, 134, 67, 0, 417, 418, 5, 56, 0, 0, 418,
+		419, 5, 60, 0, 0, 419, 428, 3, 120, 60, 0, 420, 421, 5, 56, 0, 0, 421,
+		422, 5, 60, 0, 0, 422, 428, 3, 122, 61, 0, 423, 424, 5, 56, 0, 0, 424,
+		425, 5, 60, 0, 0, 425, 428, 3, 112, 56, 0, 426, 428, 5, 56, 0, 0, 427,
+		408, 1, 0, 0, 0, 427, 411, 1, 0, 0, 0, 427, 414, 1, 0, 0, 0, 427, 417,
+		1, 0, 0, 0, 427, 420, 1, 0, 0, 0, 427, 423, 1, 0, 0, 0, 427, 426, 1, 0,
+		0, 0, 428, 53, 1, 0, 0, 0, 429, 430, 5, 13, 0, 0, 430, 431, 3, 116, 58,
+		0, 431, 432, 3, 140, 70, 0, 432, 55, 1, 0, 0, 0, 433, 435, 5, 65, 0, 0,
+		434, 436, 3, 58, 29, 0, 435, 434, 1, 0, 0, 0, 435, 436, 1, 0, 0, 0, 436,
+		437, 1, 0, 0, 0, 437, 438, 5, 66, 0, 0, 438, 57, 1, 0, 0, 0, 439, 441,
+		3, 60, 30, 0, 440, 439, 1, 0, 0, 0, 441, 442, 1, 0, 0, 0, 442, 440, 1,
+		0, 0, 0, 442, 443, 1, 0, 0, 0, 443, 59, 1, 0, 0, 0, 444, 452, 3, 28, 14,
+		0, 445, 452, 3, 54, 27, 0, 446, 447, 3, 62, 31, 0, 447, 448, 3, 140, 70,
+		0, 448, 452, 1, 0, 0, 0, 449, 452, 3, 56, 28, 0, 450, 452, 3, 86, 43, 0,
+		451, 444, 1, 0, 0, 0, 451, 445, 1, 0, 0, 0, 451, 446, 1, 0, 0, 0, 451,
+		449, 1, 0, 0, 0, 451, 450, 1, 0, 0, 0, 452, 61, 1, 0, 0, 0, 453, 458, 3,
+		114, 57, 0, 454, 458, 3, 64, 32, 0, 455, 458, 3, 82, 41, 0, 456, 458, 3,
+		84, 42, 0, 457, 453, 1, 0, 0, 0, 457, 454, 1, 0, 0, 0, 457, 455, 1, 0,
+		0, 0, 457, 456, 1, 0, 0, 0, 458, 63, 1, 0, 0, 0, 459, 460, 3, 114, 57,
+		0, 460, 461, 7, 3, 0, 0, 461, 65, 1, 0, 0, 0, 462, 463, 6, 33, -1, 0, 463,
+		464, 5, 42, 0, 0, 464, 465, 5, 63, 0, 0, 465, 466, 3, 96, 48, 0, 466, 467,
+		5, 64, 0, 0, 467, 472, 1, 0, 0, 0, 468, 469, 5, 48, 0, 0, 469, 470, 5,
+		63, 0, 0, 470, 472, 5, 64, 0, 0, 471, 462, 1, 0, 0, 0, 471, 468, 1, 0,
+		0, 0, 472, 481, 1, 0, 0, 0, 473, 474, 10, 2, 0, 0, 474, 475, 5, 73, 0,
+		0, 475, 480, 3, 66, 33, 3, 476, 477, 10, 1, 0, 0, 477, 478, 5, 81, 0, 0,
+		478, 480, 3, 66, 33, 2, 479, 473, 1, 0, 0, 0, 479, 476, 1, 0, 0, 0, 480,
+		483, 1, 0, 0, 0, 481, 479, 1, 0, 0, 0, 481, 482, 1, 0, 0, 0, 482, 67, 1,
+		0, 0, 0, 483, 481, 1, 0, 0, 0, 484, 489, 3, 120, 60, 0, 485, 486, 5, 67,
+		0, 0, 486, 487, 3, 114, 57, 0, 487, 488, 5, 68, 0, 0, 488, 490, 1, 0, 0,
+		0, 489, 485, 1, 0, 0, 0, 490, 491, 1, 0, 0, 0, 491, 489, 1, 0, 0, 0, 491,
+		492, 1, 0, 0, 0, 492, 69, 1, 0, 0, 0, 493, 494, 5, 2, 0, 0, 494, 496, 3,
+		78, 39, 0, 495, 497, 3, 76, 38, 0, 496, 495, 1, 0, 0, 0, 496, 497, 1, 0,
+		0, 0, 497, 498, 1, 0, 0, 0, 498, 499, 3, 140, 70, 0, 499, 71, 1, 0, 0,
+		0, 500, 501, 5, 3, 0, 0, 501, 503, 3, 78, 39, 0, 502, 504, 3, 76, 38, 0,
+		503, 502, 1, 0, 0, 0, 503, 504, 1, 0, 0, 0, 504, 505, 1, 0, 0, 0, 505,
+		506, 3, 140, 70, 0, 506, 73, 1, 0, 0, 0, 507, 508, 7, 4, 0, 0, 508, 511,
+		3, 114, 57, 0, 509, 510, 5, 38, 0, 0, 510, 512, 3, 126, 63, 0, 511, 509,
+		1, 0, 0, 0, 511, 512, 1, 0, 0, 0, 512, 513, 1, 0, 0, 0, 513, 514, 3, 140,
+		70, 0, 514, 75, 1, 0, 0, 0, 515, 519, 7, 5, 0, 0, 516, 517, 7, 6, 0, 0,
+		517, 519, 3, 126, 63, 0, 518, 515, 1, 0, 0, 0, 518, 516, 1, 0, 0, 0, 519,
+		77, 1, 0, 0, 0, 520, 522, 3, 114, 57, 0, 521, 523, 3, 80, 40, 0, 522, 521,
+		1, 0, 0, 0, 522, 523, 1, 0, 0, 0, 523, 532, 1, 0, 0, 0, 524, 525, 5, 20,
+		0, 0, 525, 526, 3, 114, 57, 0, 526, 527, 5, 19, 0, 0, 527, 529, 3, 114,
+		57, 0, 528, 530, 3, 80, 40, 0, 529, 528, 1, 0, 0, 0, 529, 530, 1, 0, 0,
+		0, 530, 532, 1, 0, 0, 0, 531, 520, 1, 0, 0, 0, 531, 524, 1, 0, 0, 0, 532,
+		79, 1, 0, 0, 0, 533, 534, 5, 31, 0, 0, 534, 543, 3, 126, 63, 0, 535, 536,
+		5, 32, 0, 0, 536, 543, 3, 126, 63, 0, 537, 538, 5, 33, 0, 0, 538, 539,
+		3, 126, 63, 0, 539, 540, 5, 34, 0, 0, 540, 541, 3, 126, 63, 0, 541, 543,
+		1, 0, 0, 0, 542, 533, 1, 0, 0, 0, 542, 535, 1, 0, 0, 0, 542, 537, 1, 0,
+		0, 0, 543, 81, 1, 0, 0, 0, 544, 546, 3, 42, 21, 0, 545, 547, 7, 7, 0, 0,
+		546, 545, 1, 0, 0, 0, 546, 547, 1, 0, 0, 0, 547, 548, 1, 0, 0, 0, 548,
+		549, 5, 57, 0, 0, 549, 550, 3, 42, 21, 0, 550, 556, 1, 0, 0, 0, 551, 552,
+		3, 42, 21, 0, 552, 553, 7, 8, 0, 0, 553, 554, 3, 42, 21, 0, 554, 556, 1,
+		0, 0, 0, 555, 544, 1, 0, 0, 0, 555, 551, 1, 0, 0, 0, 556, 83, 1, 0, 0,
+		0, 557, 558, 5, 69, 0, 0, 558, 85, 1, 0, 0, 0, 559, 563, 5, 11, 0, 0, 560,
+		561, 3, 62, 31, 0, 561, 562, 5, 69, 0, 0, 562, 564, 1, 0, 0, 0, 563, 560,
+		1, 0, 0, 0, 563, 564, 1, 0, 0, 0, 564, 565, 1, 0, 0, 0, 565, 566, 3, 114,
+		57, 0, 566, 572, 3, 56, 28, 0, 567, 570, 5, 7, 0, 0, 568, 571, 3, 86, 43,
+		0, 569, 571, 3, 56, 28, 0, 570, 568, 1, 0, 0, 0, 570, 569, 1, 0, 0, 0,
+		571, 573, 1, 0, 0, 0, 572, 567, 1, 0, 0, 0, 572, 573, 1, 0, 0, 0, 573,
+		87, 1, 0, 0, 0, 574, 578, 5, 11, 0, 0, 575, 576, 3, 62, 31, 0, 576, 577,
+		5, 69, 0, 0, 577, 579, 1, 0, 0, 0, 578, 575, 1, 0, 0, 0, 578, 579, 1, 0,
+		0, 0, 579, 580, 1, 0, 0, 0, 580, 581, 3, 114, 57, 0, 581, 587, 3, 102,
+		51, 0, 582, 585, 5, 7, 0, 0, 583, 586, 3, 88, 44, 0, 584, 586, 3, 102,
+		51, 0, 585, 583, 1, 0, 0, 0, 585, 584, 1, 0, 0, 0, 586, 588, 1, 0, 0, 0,
+		587, 582, 1, 0, 0, 0, 587, 588, 1, 0, 0, 0, 588, 89, 1, 0, 0, 0, 589, 593,
+		5, 11, 0, 0, 590, 591, 3, 62, 31, 0, 591, 592, 5, 69, 0, 0, 592, 594, 1,
+		0, 0, 0, 593, 590, 1, 0, 0, 0, 593, 594, 1, 0, 0, 0, 594, 595, 1, 0, 0,
+		0, 595, 596, 3, 114, 57, 0, 596, 602, 3, 98, 49, 0, 597, 600, 5, 7, 0,
+		0, 598, 601, 3, 90, 45, 0, 599, 601, 3, 98, 49, 0, 600, 598, 1, 0, 0, 0,
+		600, 599, 1, 0, 0, 0, 601, 603, 1, 0, 0, 0, 602, 597, 1, 0, 0, 0, 602,
+		603, 1, 0, 0, 0, 603, 91, 1, 0, 0, 0, 604, 605, 5, 9, 0, 0, 605, 608, 3,
+		94, 47, 0, 606, 607, 5, 13, 0, 0, 607, 609, 3, 104, 52, 0, 608, 606, 1,
+		0, 0, 0, 608, 609, 1, 0, 0, 0, 609, 610, 1, 0, 0, 0, 610, 611, 5, 16, 0,
+		0, 611, 613, 3, 102, 51, 0, 612, 614, 3, 140, 70, 0, 613, 612, 1, 0, 0,
+		0, 613, 614, 1, 0, 0, 0, 614, 93, 1, 0, 0, 0, 615, 616, 3, 126, 63, 0,
+		616, 95, 1, 0, 0, 0, 617, 618, 7, 9, 0, 0, 618, 619, 5, 62, 0, 0, 619,
+		624, 7, 0, 0, 0, 620, 621, 5, 62, 0, 0, 621, 623, 7, 0, 0, 0, 622, 620,
+		1, 0, 0, 0, 623, 626, 1, 0, 0, 0, 624, 622, 1, 0, 0, 0, 624, 625, 1, 0,
+		0, 0, 625, 97, 1, 0, 0, 0, 626, 624, 1, 0, 0, 0, 627, 631, 5, 65, 0, 0,
+		628, 630, 3, 100, 50, 0, 629, 628, 1, 0, 0, 0, 630, 633, 1, 0, 0, 0, 631,
+		629, 1, 0, 0, 0, 631, 632, 1, 0, 0, 0, 632, 634, 1, 0, 0, 0, 633, 631,
+		1, 0, 0, 0, 634, 635, 5, 66, 0, 0, 635, 99, 1, 0, 0, 0, 636, 639, 3, 96,
+		48, 0, 637, 638, 5, 82, 0, 0, 638, 640, 3, 96, 48, 0, 639, 637, 1, 0, 0,
+		0, 639, 640, 1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641, 642, 3, 140, 70, 0,
+		642, 648, 1, 0, 0, 0, 643, 644, 3, 66, 33, 0, 644, 645, 3, 140, 70, 0,
+		645, 648, 1, 0, 0, 0, 646, 648, 3, 90, 45, 0, 647, 636, 1, 0, 0, 0, 647,
+		643, 1, 0, 0, 0, 647, 646, 1, 0, 0, 0, 648, 101, 1, 0, 0, 0, 649, 653,
+		5, 65, 0, 0, 650, 652, 3, 108, 54, 0, 651, 650, 1, 0, 0, 0, 652, 655, 1,
+		0, 0, 0, 653, 651, 1, 0, 0, 0, 653, 654, 1, 0, 0, 0, 654, 656, 1, 0, 0,
+		0, 655, 653, 1, 0, 0, 0, 656, 657, 5, 66, 0, 0, 657, 103, 1, 0, 0, 0, 658,
+		662, 5, 65, 0, 0, 659, 661, 3, 106, 53, 0, 660, 659, 1, 0, 0, 0, 661, 664,
+		1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 662, 663, 1, 0, 0, 0, 663, 665, 1, 0,
+		0, 0, 664, 662, 1, 0, 0, 0, 665, 666, 5, 66, 0, 0, 666, 105, 1, 0, 0, 0,
+		667, 668, 5, 56, 0, 0, 668, 669, 5, 57, 0, 0, 669, 672, 5, 14, 0, 0, 670,
+		673, 3, 96, 48, 0, 671, 673, 5, 56, 0, 0, 672, 670, 1, 0, 0, 0, 672, 671,
+		1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 680, 3, 140, 70, 0, 675, 676, 3,
+		6, 3, 0, 676, 677, 3, 140, 70, 0, 677, 679, 1, 0, 0, 0, 678, 675, 1, 0,
+		0, 0, 679, 682, 1, 0, 0, 0, 680, 678, 1, 0, 0, 0, 680, 681, 1, 0, 0, 0,
+		681, 107, 1, 0, 0, 0, 682, 680, 1, 0, 0, 0, 683, 688, 3, 96, 48, 0, 684,
+		685, 5, 82, 0, 0, 685, 687, 3, 96, 48, 0, 686, 684, 1, 0, 0, 0, 687, 690,
+		1, 0, 0, 0, 688, 686, 1, 0, 0, 0, 688, 689, 1, 0, 0, 0, 689, 691, 1, 0,
+		0, 0, 690, 688, 1, 0, 0, 0, 691, 692, 3, 140, 70, 0, 692, 698, 1, 0, 0,
+		0, 693, 694, 3, 62, 31, 0, 694, 695, 3, 140, 70, 0, 695, 698, 1, 0, 0,
+		0, 696, 698, 3, 88, 44, 0, 697, 683, 1, 0, 0, 0, 697, 693, 1, 0, 0, 0,
+		697, 696, 1, 0, 0, 0, 698, 109, 1, 0, 0, 0, 699, 700, 7, 10, 0, 0, 700,
+		111, 1, 0, 0, 0, 701, 702, 3, 110, 55, 0, 702, 704, 5, 63, 0, 0, 703, 705,
+		3, 116, 58, 0, 704, 703, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 710, 1,
+		0, 0, 0, 706, 707, 5, 61, 0, 0, 707, 709, 3, 116, 58, 0, 708, 706, 1, 0,
+		0, 0, 709, 712, 1, 0, 0, 0, 710, 708, 1, 0, 0, 0, 710, 711, 1, 0, 0, 0,
+		711, 713, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0, 713, 714, 5, 64, 0, 0, 714,
+		113, 1, 0, 0, 0, 715, 716, 6, 57, -1, 0, 716, 722, 3, 116, 58, 0, 717,
+		722, 3, 112, 56, 0, 718, 722, 3, 122, 61, 0, 719, 720, 7, 11, 0, 0, 720,
+		722, 3, 114, 57, 2, 721, 715, 1, 0, 0, 0, 721, 717, 1, 0, 0, 0, 721, 718,
+		1, 0, 0, 0, 721, 719, 1, 0, 0, 0, 722, 746, 1, 0, 0, 0, 723, 724, 10, 8,
+		0, 0, 724, 725, 5, 86, 0, 0, 725, 745, 3, 114, 57, 9, 726, 727, 10, 7,
+		0, 0, 727, 728, 7, 12, 0, 0, 728, 745, 3, 114, 57, 8, 729, 730, 10, 6,
+		0, 0, 730, 731, 7, 13, 0, 0, 731, 745, 3, 114, 57, 7, 732, 733, 10, 5,
+		0, 0, 733, 734, 7, 2, 0, 0, 734, 745, 3, 114, 57, 6, 735, 736, 10, 4, 0,
+		0, 736, 737, 5, 73, 0, 0, 737, 745, 3, 114, 57, 5, 738, 739, 10, 3, 0,
+		0, 739, 740, 5, 81, 0, 0, 740, 745, 3, 114, 57, 4, 741, 742, 10, 1, 0,
+		0, 742, 743, 7, 14, 0, 0, 743, 745, 3, 114, 57, 2, 744, 723, 1, 0, 0, 0,
+		744, 726, 1, 0, 0, 0, 744, 729, 1, 0, 0, 0, 744, 732, 1, 0, 0, 0, 744,
+		735, 1, 0, 0, 0, 744, 738, 1, 0, 0, 0, 744, 741, 1, 0, 0, 0, 745, 748,
+		1, 0, 0, 0, 746, 744, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 115, 1, 0,
+		0, 0, 748, 746, 1, 0, 0, 0, 749, 761, 3, 40, 20, 0, 750, 761, 3, 124, 62,
+		0, 751, 761, 3, 132, 66, 0, 752, 761, 3, 134, 67, 0, 753, 761, 3, 120,
+		60, 0, 754, 761, 3, 68, 34, 0, 755, 761, 3, 118, 59, 0, 756, 757, 5, 63,
+		0, 0, 757, 758, 3, 114, 57, 0, 758, 759, 5, 64, 0, 0, 759, 761, 1, 0, 0,
+		0, 760, 749, 1, 0, 0, 0, 760, 750, 1, 0, 0, 0, 760, 751, 1, 0, 0, 0, 760,
+		752, 1, 0, 0, 0, 760, 753, 1, 0, 0, 0, 760, 754, 1, 0, 0, 0, 760, 755,
+		1, 0, 0, 0, 760, 756, 1, 0, 0, 0, 761, 117, 1, 0, 0, 0, 762, 763, 5, 35,
+		0, 0, 763, 764, 5, 63, 0, 0, 764, 765, 3, 114, 57, 0, 765, 766, 5, 64,
+		0, 0, 766, 119, 1, 0, 0, 0, 767, 778, 5, 56, 0, 0, 768, 778, 3, 96, 48,
+		0, 769, 778, 5, 21, 0, 0, 770, 778, 5, 4, 0, 0, 771, 772, 5, 14, 0, 0,
+		772, 775, 5, 56, 0, 0, 773, 774, 5, 62, 0, 0, 774, 776, 5, 56, 0, 0, 775,
+		773, 1, 0, 0, 0, 775, 776, 1, 0, 0, 0, 776, 778, 1, 0, 0, 0, 777, 767,
+		1, 0, 0, 0, 777, 768, 1, 0, 0, 0, 777, 769, 1, 0, 0, 0, 777, 770, 1, 0,
+		0, 0, 777, 771, 1, 0, 0, 0, 778, 121, 1, 0, 0, 0, 779, 783, 1, 0, 0, 0,
+		780, 781, 7, 15, 0, 0, 781, 783, 3, 114, 57, 0, 782, 779, 1, 0, 0, 0, 782,
+		780, 1, 0, 0, 0, 783, 123, 1, 0, 0, 0, 784, 788, 3, 126, 63, 0, 785, 788,
+		3, 128, 64, 0, 786, 788, 3, 130, 65, 0, 787, 784, 1, 0, 0, 0, 787, 785,
+		1, 0, 0, 0, 787, 786, 1, 0, 0, 0, 788, 125, 1, 0, 0, 0, 789, 790, 7, 16,
+		0, 0, 790, 127, 1, 0, 0, 0, 791, 792, 5, 84, 0, 0, 792, 796, 3, 126, 63,
+		0, 793, 794, 5, 84, 0, 0, 794, 796, 3, 130, 65, 0, 795, 791, 1, 0, 0, 0,
+		795, 793, 1, 0, 0, 0, 796, 129, 1, 0, 0, 0, 797, 798, 5, 96, 0, 0, 798,
+		131, 1, 0, 0, 0, 799, 800, 7, 17, 0, 0, 800, 133, 1, 0, 0, 0, 801, 802,
+		7, 18, 0, 0, 802, 135, 1, 0, 0, 0, 803, 804, 5, 10, 0, 0, 804, 805, 3,
+		56, 28, 0, 805, 137, 1, 0, 0, 0, 806, 807, 5, 10, 0, 0, 807, 808, 3, 98,
+		49, 0, 808, 139, 1, 0, 0, 0, 809, 810, 5, 69, 0, 0, 810, 141, 1, 0, 0,
+		0, 84, 146, 152, 158, 164, 166, 170, 173, 189, 201, 213, 226, 240, 244,
+		256, 260, 265, 269, 279, 291, 296, 301, 318, 328, 336, 338, 346, 354, 363,
+		379, 390, 394, 400, 406, 427, 435, 442, 451, 457, 471, 479, 481, 491, 496,
+		503, 511, 518, 522, 529, 531, 542, 546, 555, 563, 570, 572, 578, 585, 587,
+		593, 600, 602, 608, 613, 624, 631, 639, 647, 653, 662, 672, 680, 688, 697,
+		704, 710, 721, 744, 746, 760, 775, 777, 782, 787, 795,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -491,70 +517,82 @@ const (
 	FaultParserALWAYS                 = 24
 	FaultParserNMT                    = 25
 	FaultParserNFT                    = 26
-	FaultParserNIL                    = 27
-	FaultParserTRUE                   = 28
-	FaultParserFALSE                  = 29
-	FaultParserADVANCE                = 30
-	FaultParserCOMPONENT              = 31
-	FaultParserGLOBAL                 = 32
-	FaultParserSYSTEM                 = 33
-	FaultParserSTART                  = 34
-	FaultParserSTATE                  = 35
-	FaultParserSTAY                   = 36
-	FaultParserTY_STRING              = 37
-	FaultParserTY_BOOL                = 38
-	FaultParserTY_INT                 = 39
-	FaultParserTY_FLOAT               = 40
-	FaultParserTY_NATURAL             = 41
-	FaultParserTY_UNCERTAIN           = 42
-	FaultParserTY_UNKNOWN             = 43
-	FaultParserIDENT                  = 44
-	FaultParserASSIGN                 = 45
-	FaultParserASSIGN_FLOW1           = 46
-	FaultParserASSIGN_FLOW2           = 47
-	FaultParserCOLON                  = 48
-	FaultParserCOMMA                  = 49
-	FaultParserDOT                    = 50
-	FaultParserLPAREN                 = 51
-	FaultParserRPAREN                 = 52
-	FaultParserLCURLY                 = 53
-	FaultParserRCURLY                 = 54
-	FaultParserLBRACE                 = 55
-	FaultParserRBRACE                 = 56
-	FaultParserSEMI                   = 57
-	FaultParserPLUS_PLUS              = 58
-	FaultParserMINUS_MINUS            = 59
-	FaultParserAMPERSAND              = 60
-	FaultParserAND                    = 61
-	FaultParserBANG                   = 62
-	FaultParserEQUALS                 = 63
-	FaultParserNOT_EQUALS             = 64
-	FaultParserLESS                   = 65
-	FaultParserLESS_OR_EQUALS         = 66
-	FaultParserGREATER                = 67
-	FaultParserGREATER_OR_EQUALS      = 68
-	FaultParserOR                     = 69
-	FaultParserPIPE                   = 70
-	FaultParserPLUS                   = 71
-	FaultParserMINUS                  = 72
-	FaultParserCARET                  = 73
-	FaultParserEXPO                   = 74
-	FaultParserMULTI                  = 75
-	FaultParserDIV                    = 76
-	FaultParserMOD                    = 77
-	FaultParserLSHIFT                 = 78
-	FaultParserRSHIFT                 = 79
-	FaultParserBIT_CLEAR              = 80
-	FaultParserDECIMAL_LIT            = 81
-	FaultParserOCTAL_LIT              = 82
-	FaultParserHEX_LIT                = 83
-	FaultParserFLOAT_LIT              = 84
-	FaultParserRAW_STRING_LIT         = 85
-	FaultParserINTERPRETED_STRING_LIT = 86
-	FaultParserWS                     = 87
-	FaultParserCOMMENT                = 88
-	FaultParserTERMINATOR             = 89
-	FaultParserLINE_COMMENT           = 90
+	FaultParserNEXT                   = 27
+	FaultParserUNTIL                  = 28
+	FaultParserWEAK_UNTIL             = 29
+	FaultParserRELEASE                = 30
+	FaultParserWITHIN                 = 31
+	FaultParserAFTER                  = 32
+	FaultParserBETWEEN                = 33
+	FaultParserWINDOW_AND             = 34
+	FaultParserPROB                   = 35
+	FaultParserMAXIMIZE               = 36
+	FaultParserMINIMIZE               = 37
+	FaultParserAT                     = 38
+	FaultParserNIL                    = 39
+	FaultParserTRUE                   = 40
+	FaultParserFALSE                  = 41
+	FaultParserADVANCE                = 42
+	FaultParserCOMPONENT              = 43
+	FaultParserGLOBAL                 = 44
+	FaultParserSYSTEM                 = 45
+	FaultParserSTART                  = 46
+	FaultParserSTATE                  = 47
+	FaultParserSTAY                   = 48
+	FaultParserTY_STRING              = 49
+	FaultParserTY_BOOL                = 50
+	FaultParserTY_INT                 = 51
+	FaultParserTY_FLOAT               = 52
+	FaultParserTY_NATURAL             = 53
+	FaultParserTY_UNCERTAIN           = 54
+	FaultParserTY_UNKNOWN             = 55
+	FaultParserIDENT                  = 56
+	FaultParserASSIGN                 = 57
+	FaultParserASSIGN_FLOW1           = 58
+	FaultParserASSIGN_FLOW2           = 59
+	FaultParserCOLON                  = 60
+	FaultParserCOMMA                  = 61
+	FaultParserDOT                    = 62
+	FaultParserLPAREN                 = 63
+	FaultParserRPAREN                 = 64
+	FaultParserLCURLY                 = 65
+	FaultParserRCURLY                 = 66
+	FaultParserLBRACE                 = 67
+	FaultParserRBRACE                 = 68
+	FaultParserSEMI                   = 69
+	FaultParserPLUS_PLUS              = 70
+	FaultParserMINUS_MINUS            = 71
+	FaultParserAMPERSAND              = 72
+	FaultParserAND                    = 73
+	FaultParserBANG                   = 74
+	FaultParserEQUALS                 = 75
+	FaultParserNOT_EQUALS             = 76
+	FaultParserLESS                   = 77
+	FaultParserLESS_OR_EQUALS         = 78
+	FaultParserGREATER                = 79
+	FaultParserGREATER_OR_EQUALS      = 80
+	FaultParserOR                     = 81
+	FaultParserPIPE                   = 82
+	FaultParserPLUS                   = 83
+	FaultParserMINUS                  = 84
+	FaultParserCARET                  = 85
+	FaultParserEXPO                   = 86
+	FaultParserMULTI                  = 87
+	FaultParserDIV                    = 88
+	FaultParserMOD                    = 89
+	FaultParserLSHIFT                 = 90
+	FaultParserRSHIFT                 = 91
+	FaultParserBIT_CLEAR              = 92
+	FaultParserDECIMAL_LIT            = 93
+	FaultParserOCTAL_LIT              = 94
+	FaultParserHEX_LIT                = 95
+	FaultParserFLOAT_LIT              = 96
+	FaultParserRAW_STRING_LIT         = 97
+	FaultParserINTERPRETED_STRING_LIT = 98
+	FaultParserWS                     = 99
+	FaultParserCOMMENT                = 100
+	FaultParserTERMINATOR             = 101
+	FaultParserLINE_COMMENT           = 102
 )
 
 // FaultParser rules.
@@ -596,37 +634,40 @@ const (
 	FaultParserRULE_accessHistory    = 34
 	FaultParserRULE_assertion        = 35
 	FaultParserRULE_assumption       = 36
-	FaultParserRULE_temporal         = 37
-	FaultParserRULE_invariant        = 38
-	FaultParserRULE_assignment       = 39
-	FaultParserRULE_emptyStmt        = 40
-	FaultParserRULE_ifStmt           = 41
-	FaultParserRULE_ifStmtRun        = 42
-	FaultParserRULE_ifStmtState      = 43
-	FaultParserRULE_forStmt          = 44
-	FaultParserRULE_rounds           = 45
-	FaultParserRULE_paramCall        = 46
-	FaultParserRULE_stateBlock       = 47
-	FaultParserRULE_stateStep        = 48
-	FaultParserRULE_runBlock         = 49
-	FaultParserRULE_initBlock        = 50
-	FaultParserRULE_initStep         = 51
-	FaultParserRULE_runStep          = 52
-	FaultParserRULE_faultType        = 53
-	FaultParserRULE_solvable         = 54
-	FaultParserRULE_expression       = 55
-	FaultParserRULE_operand          = 56
-	FaultParserRULE_operandName      = 57
-	FaultParserRULE_prefix           = 58
-	FaultParserRULE_numeric          = 59
-	FaultParserRULE_integer          = 60
-	FaultParserRULE_negative         = 61
-	FaultParserRULE_float_           = 62
-	FaultParserRULE_string_          = 63
-	FaultParserRULE_bool_            = 64
-	FaultParserRULE_functionLit      = 65
-	FaultParserRULE_stateLit         = 66
-	FaultParserRULE_eos              = 67
+	FaultParserRULE_optimize         = 37
+	FaultParserRULE_temporal         = 38
+	FaultParserRULE_invariant        = 39
+	FaultParserRULE_window           = 40
+	FaultParserRULE_assignment       = 41
+	FaultParserRULE_emptyStmt        = 42
+	FaultParserRULE_ifStmt           = 43
+	FaultParserRULE_ifStmtRun        = 44
+	FaultParserRULE_ifStmtState      = 45
+	FaultParserRULE_forStmt          = 46
+	FaultParserRULE_rounds           = 47
+	FaultParserRULE_paramCall        = 48
+	FaultParserRULE_stateBlock       = 49
+	FaultParserRULE_stateStep        = 50
+	FaultParserRULE_runBlock         = 51
+	FaultParserRULE_initBlock        = 52
+	FaultParserRULE_initStep         = 53
+	FaultParserRULE_runStep          = 54
+	FaultParserRULE_faultType        = 55
+	FaultParserRULE_solvable         = 56
+	FaultParserRULE_expression       = 57
+	FaultParserRULE_operand          = 58
+	FaultParserRULE_probability      = 59
+	FaultParserRULE_operandName      = 60
+	FaultParserRULE_prefix           = 61
+	FaultParserRULE_numeric          = 62
+	FaultParserRULE_integer          = 63
+	FaultParserRULE_negative         = 64
+	FaultParserRULE_float_           = 65
+	FaultParserRULE_string_          = 66
+	FaultParserRULE_bool_            = 67
+	FaultParserRULE_functionLit      = 68
+	FaultParserRULE_stateLit         = 69
+	FaultParserRULE_eos              = 70
 )
 
 // ISysSpecContext is an interface to support dynamic dispatch.
@@ -1034,75 +1075,75 @@ func (p *FaultParser) SysSpec() (localctx ISysSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(136)
+		p.SetState(142)
 		p.SysClause()
 	}
-	p.SetState(140)
+	p.SetState(146)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIMPORT {
 		{
-			p.SetState(137)
+			p.SetState(143)
 			p.ImportDecl()
 		}
 
-		p.SetState(142)
+		p.SetState(148)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(146)
+	p.SetState(152)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserGLOBAL {
 		{
-			p.SetState(143)
+			p.SetState(149)
 			p.GlobalDecl()
 		}
 
-		p.SetState(148)
+		p.SetState(154)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(152)
+	p.SetState(158)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMPONENT {
 		{
-			p.SetState(149)
+			p.SetState(155)
 			p.ComponentDecl()
 		}
 
-		p.SetState(154)
+		p.SetState(160)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(160)
+	p.SetState(166)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17592186044428) != 0 {
-		p.SetState(158)
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&72057594037927948) != 0 {
+		p.SetState(164)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserASSERT:
 			{
-				p.SetState(155)
+				p.SetState(161)
 				p.Assertion()
 			}
 
 		case FaultParserASSUME:
 			{
-				p.SetState(156)
+				p.SetState(162)
 				p.Assumption()
 			}
 
 		case FaultParserIDENT:
 			{
-				p.SetState(157)
+				p.SetState(163)
 				p.StringDecl()
 			}
 
@@ -1110,28 +1151,28 @@ func (p *FaultParser) SysSpec() (localctx ISysSpecContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(162)
+		p.SetState(168)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(164)
+	p.SetState(170)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserSTART {
 		{
-			p.SetState(163)
+			p.SetState(169)
 			p.StartBlock()
 		}
 
 	}
-	p.SetState(167)
+	p.SetState(173)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserFOR {
 		{
-			p.SetState(166)
+			p.SetState(172)
 			p.ForStmt()
 		}
 
@@ -1262,15 +1303,15 @@ func (p *FaultParser) SysClause() (localctx ISysClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(169)
+		p.SetState(175)
 		p.Match(FaultParserSYSTEM)
 	}
 	{
-		p.SetState(170)
+		p.SetState(176)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(171)
+		p.SetState(177)
 		p.Eos()
 	}
 
@@ -1492,42 +1533,42 @@ func (p *FaultParser) GlobalDecl() (localctx IGlobalDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(173)
+		p.SetState(179)
 		p.Match(FaultParserGLOBAL)
 	}
 	{
-		p.SetState(174)
+		p.SetState(180)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(175)
+		p.SetState(181)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(176)
+		p.SetState(182)
 		p.Operand()
 	}
 	{
-		p.SetState(177)
+		p.SetState(183)
 		p.Eos()
 	}
-	p.SetState(183)
+	p.SetState(189)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(178)
+				p.SetState(184)
 				p.Swap()
 			}
 			{
-				p.SetState(179)
+				p.SetState(185)
 				p.Eos()
 			}
 
 		}
-		p.SetState(185)
+		p.SetState(191)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext())
 	}
@@ -1771,55 +1812,55 @@ func (p *FaultParser) Swap() (localctx ISwapContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(186)
+		p.SetState(192)
 		p.ParamCall()
 	}
 	{
-		p.SetState(187)
+		p.SetState(193)
 		p.Match(FaultParserASSIGN)
 	}
-	p.SetState(195)
+	p.SetState(201)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(188)
+			p.SetState(194)
 			p.FunctionLit()
 		}
 
 	case 2:
 		{
-			p.SetState(189)
+			p.SetState(195)
 			p.Numeric()
 		}
 
 	case 3:
 		{
-			p.SetState(190)
+			p.SetState(196)
 			p.String_()
 		}
 
 	case 4:
 		{
-			p.SetState(191)
+			p.SetState(197)
 			p.Bool_()
 		}
 
 	case 5:
 		{
-			p.SetState(192)
+			p.SetState(198)
 			p.OperandName()
 		}
 
 	case 6:
 		{
-			p.SetState(193)
+			p.SetState(199)
 			p.Prefix()
 		}
 
 	case 7:
 		{
-			p.SetState(194)
+			p.SetState(200)
 			p.Solvable()
 		}
 
@@ -2024,49 +2065,49 @@ func (p *FaultParser) ComponentDecl() (localctx IComponentDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(203)
 		p.Match(FaultParserCOMPONENT)
 	}
 	{
-		p.SetState(198)
+		p.SetState(204)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(199)
+		p.SetState(205)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(200)
+		p.SetState(206)
 		p.Match(FaultParserSTATE)
 	}
 	{
-		p.SetState(201)
+		p.SetState(207)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(207)
+	p.SetState(213)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == FaultParserIDENT {
+	for _la == FaultParserNEXT || _la == FaultParserIDENT {
 		{
-			p.SetState(202)
+			p.SetState(208)
 			p.ComProperties()
 		}
 		{
-			p.SetState(203)
+			p.SetState(209)
 			p.Match(FaultParserCOMMA)
 		}
 
-		p.SetState(209)
+		p.SetState(215)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(210)
+		p.SetState(216)
 		p.Match(FaultParserRCURLY)
 	}
 	{
-		p.SetState(211)
+		p.SetState(217)
 		p.Eos()
 	}
 
@@ -2254,37 +2295,37 @@ func (p *FaultParser) StartBlock() (localctx IStartBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
+		p.SetState(219)
 		p.Match(FaultParserSTART)
 	}
 	{
-		p.SetState(214)
+		p.SetState(220)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(220)
+	p.SetState(226)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIDENT {
 		{
-			p.SetState(215)
+			p.SetState(221)
 			p.StartPair()
 		}
 		{
-			p.SetState(216)
+			p.SetState(222)
 			p.Match(FaultParserCOMMA)
 		}
 
-		p.SetState(222)
+		p.SetState(228)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(223)
+		p.SetState(229)
 		p.Match(FaultParserRCURLY)
 	}
 	{
-		p.SetState(224)
+		p.SetState(230)
 		p.Eos()
 	}
 
@@ -2302,6 +2343,7 @@ type IStartPairContext interface {
 	AllIDENT() []antlr.TerminalNode
 	IDENT(i int) antlr.TerminalNode
 	COLON() antlr.TerminalNode
+	NEXT() antlr.TerminalNode
 
 	// IsStartPairContext differentiates from other interfaces.
 	IsStartPairContext()
@@ -2346,6 +2388,10 @@ func (s *StartPairContext) COLON() antlr.TerminalNode {
 	return s.GetToken(FaultParserCOLON, 0)
 }
 
+func (s *StartPairContext) NEXT() antlr.TerminalNode {
+	return s.GetToken(FaultParserNEXT, 0)
+}
+
 func (s *StartPairContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2382,6 +2428,7 @@ func (p *FaultParser) StartPair() (localctx IStartPairContext) {
 
 	localctx = NewStartPairContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 12, FaultParserRULE_startPair)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2401,16 +2448,23 @@ func (p *FaultParser) StartPair() (localctx IStartPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(226)
+		p.SetState(232)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(227)
+		p.SetState(233)
 		p.Match(FaultParserCOLON)
 	}
 	{
-		p.SetState(228)
-		p.Match(FaultParserIDENT)
+		p.SetState(234)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FaultParserNEXT || _la == FaultParserIDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
@@ -2589,30 +2643,30 @@ func (p *FaultParser) Spec() (localctx ISpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(230)
+		p.SetState(236)
 		p.SpecClause()
 	}
-	p.SetState(234)
+	p.SetState(240)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17592186044524) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&72057800196358252) != 0 {
 		{
-			p.SetState(231)
+			p.SetState(237)
 			p.Declaration()
 		}
 
-		p.SetState(236)
+		p.SetState(242)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(238)
+	p.SetState(244)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserFOR {
 		{
-			p.SetState(237)
+			p.SetState(243)
 			p.ForStmt()
 		}
 
@@ -2743,15 +2797,15 @@ func (p *FaultParser) SpecClause() (localctx ISpecClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(240)
+		p.SetState(246)
 		p.Match(FaultParserSPEC)
 	}
 	{
-		p.SetState(241)
+		p.SetState(247)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(242)
+		p.SetState(248)
 		p.Eos()
 	}
 
@@ -2929,40 +2983,40 @@ func (p *FaultParser) ImportDecl() (localctx IImportDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(250)
 		p.Match(FaultParserIMPORT)
 	}
-	p.SetState(254)
+	p.SetState(260)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserIDENT, FaultParserDOT, FaultParserRAW_STRING_LIT, FaultParserINTERPRETED_STRING_LIT:
 		{
-			p.SetState(245)
+			p.SetState(251)
 			p.ImportSpec()
 		}
 
 	case FaultParserLPAREN:
 		{
-			p.SetState(246)
+			p.SetState(252)
 			p.Match(FaultParserLPAREN)
 		}
-		p.SetState(250)
+		p.SetState(256)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64((_la-44)) & ^0x3f) == 0 && ((int64(1)<<(_la-44))&6597069766721) != 0 {
+		for (int64((_la-56)) & ^0x3f) == 0 && ((int64(1)<<(_la-56))&6597069766721) != 0 {
 			{
-				p.SetState(247)
+				p.SetState(253)
 				p.ImportSpec()
 			}
 
-			p.SetState(252)
+			p.SetState(258)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(253)
+			p.SetState(259)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -2970,7 +3024,7 @@ func (p *FaultParser) ImportDecl() (localctx IImportDeclContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(256)
+		p.SetState(262)
 		p.Eos()
 	}
 
@@ -3104,13 +3158,13 @@ func (p *FaultParser) ImportSpec() (localctx IImportSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(259)
+	p.SetState(265)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserIDENT || _la == FaultParserDOT {
 		{
-			p.SetState(258)
+			p.SetState(264)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserIDENT || _la == FaultParserDOT) {
@@ -3123,16 +3177,16 @@ func (p *FaultParser) ImportSpec() (localctx IImportSpecContext) {
 
 	}
 	{
-		p.SetState(261)
+		p.SetState(267)
 		p.ImportPath()
 	}
-	p.SetState(263)
+	p.SetState(269)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserCOMMA {
 		{
-			p.SetState(262)
+			p.SetState(268)
 			p.Match(FaultParserCOMMA)
 		}
 
@@ -3253,7 +3307,7 @@ func (p *FaultParser) ImportPath() (localctx IImportPathContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(265)
+		p.SetState(271)
 		p.String_()
 	}
 
@@ -3272,6 +3326,7 @@ type IDeclarationContext interface {
 	StructDecl() IStructDeclContext
 	Assertion() IAssertionContext
 	Assumption() IAssumptionContext
+	Optimize() IOptimizeContext
 	StringDecl() IStringDeclContext
 
 	// IsDeclarationContext differentiates from other interfaces.
@@ -3369,6 +3424,22 @@ func (s *DeclarationContext) Assumption() IAssumptionContext {
 	return t.(IAssumptionContext)
 }
 
+func (s *DeclarationContext) Optimize() IOptimizeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptimizeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptimizeContext)
+}
+
 func (s *DeclarationContext) StringDecl() IStringDeclContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -3438,42 +3509,49 @@ func (p *FaultParser) Declaration() (localctx IDeclarationContext) {
 		}
 	}()
 
-	p.SetState(272)
+	p.SetState(279)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserCONST:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(267)
+			p.SetState(273)
 			p.ConstDecl()
 		}
 
 	case FaultParserDEF:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(268)
+			p.SetState(274)
 			p.StructDecl()
 		}
 
 	case FaultParserASSERT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(269)
+			p.SetState(275)
 			p.Assertion()
 		}
 
 	case FaultParserASSUME:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(270)
+			p.SetState(276)
 			p.Assumption()
 		}
 
-	case FaultParserIDENT:
+	case FaultParserMAXIMIZE, FaultParserMINIMIZE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(271)
+			p.SetState(277)
+			p.Optimize()
+		}
+
+	case FaultParserIDENT:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(278)
 			p.StringDecl()
 		}
 
@@ -3610,10 +3688,10 @@ func (p *FaultParser) Comparison() (localctx IComparisonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(274)
+		p.SetState(281)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-63)) & ^0x3f) == 0 && ((int64(1)<<(_la-63))&63) != 0) {
+		if !((int64((_la-75)) & ^0x3f) == 0 && ((int64(1)<<(_la-75))&63) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3795,48 +3873,48 @@ func (p *FaultParser) ConstDecl() (localctx IConstDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(276)
+		p.SetState(283)
 		p.Match(FaultParserCONST)
 	}
-	p.SetState(289)
+	p.SetState(296)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserCLOCK, FaultParserNEW, FaultParserTHIS, FaultParserIDENT:
 		{
-			p.SetState(277)
+			p.SetState(284)
 			p.ConstSpec()
 		}
 		{
-			p.SetState(278)
+			p.SetState(285)
 			p.Eos()
 		}
 
 	case FaultParserLPAREN:
 		{
-			p.SetState(280)
+			p.SetState(287)
 			p.Match(FaultParserLPAREN)
 		}
-		p.SetState(284)
+		p.SetState(291)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17592188157968) != 0 {
+		for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&72057594040041488) != 0 {
 			{
-				p.SetState(281)
+				p.SetState(288)
 				p.ConstSpec()
 			}
 
-			p.SetState(286)
+			p.SetState(293)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(287)
+			p.SetState(294)
 			p.Match(FaultParserRPAREN)
 		}
 		{
-			p.SetState(288)
+			p.SetState(295)
 			p.Eos()
 		}
 
@@ -3982,20 +4060,20 @@ func (p *FaultParser) ConstSpec() (localctx IConstSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(291)
+		p.SetState(298)
 		p.IdentList()
 	}
-	p.SetState(294)
+	p.SetState(301)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserASSIGN {
 		{
-			p.SetState(292)
+			p.SetState(299)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(293)
+			p.SetState(300)
 			p.Constants()
 		}
 
@@ -4158,63 +4236,63 @@ func (p *FaultParser) StringDecl() (localctx IStringDeclContext) {
 		}
 	}()
 
-	p.SetState(311)
+	p.SetState(318)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(296)
+			p.SetState(303)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(297)
+			p.SetState(304)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(298)
+			p.SetState(305)
 			p.String_()
 		}
 		{
-			p.SetState(299)
+			p.SetState(306)
 			p.Eos()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(301)
+			p.SetState(308)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(302)
+			p.SetState(309)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(303)
+			p.SetState(310)
 			p.compoundString(0)
 		}
 		{
-			p.SetState(304)
+			p.SetState(311)
 			p.Eos()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(306)
+			p.SetState(313)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(307)
+			p.SetState(314)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(308)
+			p.SetState(315)
 			p.compoundString(0)
 		}
 		{
-			p.SetState(309)
+			p.SetState(316)
 			p.Eos()
 		}
 
@@ -4413,37 +4491,37 @@ func (p *FaultParser) compoundString(_p int) (localctx ICompoundStringContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(321)
+	p.SetState(328)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserCLOCK, FaultParserNEW, FaultParserTHIS, FaultParserIDENT:
 		{
-			p.SetState(314)
+			p.SetState(321)
 			p.OperandName()
 		}
 
 	case FaultParserBANG:
 		{
-			p.SetState(315)
+			p.SetState(322)
 			p.Match(FaultParserBANG)
 		}
 		{
-			p.SetState(316)
+			p.SetState(323)
 			p.OperandName()
 		}
 
 	case FaultParserLPAREN:
 		{
-			p.SetState(317)
+			p.SetState(324)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(318)
+			p.SetState(325)
 			p.compoundString(0)
 		}
 		{
-			p.SetState(319)
+			p.SetState(326)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -4451,7 +4529,7 @@ func (p *FaultParser) compoundString(_p int) (localctx ICompoundStringContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(331)
+	p.SetState(338)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext())
 
@@ -4461,47 +4539,47 @@ func (p *FaultParser) compoundString(_p int) (localctx ICompoundStringContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(329)
+			p.SetState(336)
 			p.GetErrorHandler().Sync(p)
 			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewCompoundStringContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_compoundString)
-				p.SetState(323)
+				p.SetState(330)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(324)
+					p.SetState(331)
 					p.Match(FaultParserAND)
 				}
 				{
-					p.SetState(325)
+					p.SetState(332)
 					p.compoundString(3)
 				}
 
 			case 2:
 				localctx = NewCompoundStringContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_compoundString)
-				p.SetState(326)
+				p.SetState(333)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(327)
+					p.SetState(334)
 					p.Match(FaultParserOR)
 				}
 				{
-					p.SetState(328)
+					p.SetState(335)
 					p.compoundString(2)
 				}
 
 			}
 
 		}
-		p.SetState(333)
+		p.SetState(340)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext())
 	}
@@ -4658,24 +4736,24 @@ func (p *FaultParser) IdentList() (localctx IIdentListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(334)
+		p.SetState(341)
 		p.OperandName()
 	}
-	p.SetState(339)
+	p.SetState(346)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(335)
+			p.SetState(342)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(336)
+			p.SetState(343)
 			p.OperandName()
 		}
 
-		p.SetState(341)
+		p.SetState(348)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -4861,42 +4939,42 @@ func (p *FaultParser) Constants() (localctx IConstantsContext) {
 		}
 	}()
 
-	p.SetState(347)
+	p.SetState(354)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserMINUS, FaultParserDECIMAL_LIT, FaultParserOCTAL_LIT, FaultParserHEX_LIT, FaultParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(342)
+			p.SetState(349)
 			p.Numeric()
 		}
 
 	case FaultParserRAW_STRING_LIT, FaultParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(343)
+			p.SetState(350)
 			p.String_()
 		}
 
 	case FaultParserTRUE, FaultParserFALSE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(344)
+			p.SetState(351)
 			p.Bool_()
 		}
 
 	case FaultParserTY_STRING, FaultParserTY_BOOL, FaultParserTY_INT, FaultParserTY_FLOAT, FaultParserTY_NATURAL, FaultParserTY_UNCERTAIN, FaultParserTY_UNKNOWN:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(345)
+			p.SetState(352)
 			p.Solvable()
 		}
 
 	case FaultParserNIL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(346)
+			p.SetState(353)
 			p.Nil_()
 		}
 
@@ -5007,7 +5085,7 @@ func (p *FaultParser) Nil_() (localctx INilContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(349)
+		p.SetState(356)
 		p.Match(FaultParserNIL)
 	}
 
@@ -5163,24 +5241,24 @@ func (p *FaultParser) ExpressionList() (localctx IExpressionListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(351)
+		p.SetState(358)
 		p.expression(0)
 	}
-	p.SetState(356)
+	p.SetState(363)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(352)
+			p.SetState(359)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(353)
+			p.SetState(360)
 			p.expression(0)
 		}
 
-		p.SetState(358)
+		p.SetState(365)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -5332,23 +5410,23 @@ func (p *FaultParser) StructDecl() (localctx IStructDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(359)
+		p.SetState(366)
 		p.Match(FaultParserDEF)
 	}
 	{
-		p.SetState(360)
+		p.SetState(367)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(361)
+		p.SetState(368)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(362)
+		p.SetState(369)
 		p.StructType()
 	}
 	{
-		p.SetState(363)
+		p.SetState(370)
 		p.Eos()
 	}
 
@@ -5630,7 +5708,7 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		}
 	}()
 
-	p.SetState(387)
+	p.SetState(394)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -5638,33 +5716,33 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		localctx = NewFlowContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(365)
+			p.SetState(372)
 			p.Match(FaultParserFLOW)
 		}
 		{
-			p.SetState(366)
+			p.SetState(373)
 			p.Match(FaultParserLCURLY)
 		}
-		p.SetState(372)
+		p.SetState(379)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserIDENT {
 			{
-				p.SetState(367)
+				p.SetState(374)
 				p.SfProperties()
 			}
 			{
-				p.SetState(368)
+				p.SetState(375)
 				p.Match(FaultParserCOMMA)
 			}
 
-			p.SetState(374)
+			p.SetState(381)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(375)
+			p.SetState(382)
 			p.Match(FaultParserRCURLY)
 		}
 
@@ -5672,33 +5750,33 @@ func (p *FaultParser) StructType() (localctx IStructTypeContext) {
 		localctx = NewStockContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(376)
+			p.SetState(383)
 			p.Match(FaultParserSTOCK)
 		}
 		{
-			p.SetState(377)
+			p.SetState(384)
 			p.Match(FaultParserLCURLY)
 		}
-		p.SetState(383)
+		p.SetState(390)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserIDENT {
 			{
-				p.SetState(378)
+				p.SetState(385)
 				p.SfProperties()
 			}
 			{
-				p.SetState(379)
+				p.SetState(386)
 				p.Match(FaultParserCOMMA)
 			}
 
-			p.SetState(385)
+			p.SetState(392)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(386)
+			p.SetState(393)
 			p.Match(FaultParserRCURLY)
 		}
 
@@ -5901,22 +5979,22 @@ func (p *FaultParser) SfProperties() (localctx ISfPropertiesContext) {
 		}
 	}()
 
-	p.SetState(393)
+	p.SetState(400)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropFuncContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(389)
+			p.SetState(396)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(390)
+			p.SetState(397)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(391)
+			p.SetState(398)
 			p.FunctionLit()
 		}
 
@@ -5924,7 +6002,7 @@ func (p *FaultParser) SfProperties() (localctx ISfPropertiesContext) {
 		localctx = NewSfMiscContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(392)
+			p.SetState(399)
 			p.StructProperties()
 		}
 
@@ -6000,10 +6078,6 @@ func (s *StateFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StateFuncContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(FaultParserIDENT, 0)
-}
-
 func (s *StateFuncContext) COLON() antlr.TerminalNode {
 	return s.GetToken(FaultParserCOLON, 0)
 }
@@ -6024,6 +6098,14 @@ func (s *StateFuncContext) StateLit() IStateLitContext {
 	return t.(IStateLitContext)
 }
 
+func (s *StateFuncContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(FaultParserIDENT, 0)
+}
+
+func (s *StateFuncContext) NEXT() antlr.TerminalNode {
+	return s.GetToken(FaultParserNEXT, 0)
+}
+
 func (s *StateFuncContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
 		listenerT.EnterStateFunc(s)
@@ -6108,6 +6190,7 @@ func (p *FaultParser) ComProperties() (localctx IComPropertiesContext) {
 
 	localctx = NewComPropertiesContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 50, FaultParserRULE_comProperties)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6125,22 +6208,29 @@ func (p *FaultParser) ComProperties() (localctx IComPropertiesContext) {
 		}
 	}()
 
-	p.SetState(399)
+	p.SetState(406)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewStateFuncContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(395)
-			p.Match(FaultParserIDENT)
+			p.SetState(402)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == FaultParserNEXT || _la == FaultParserIDENT) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
 		{
-			p.SetState(396)
+			p.SetState(403)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(397)
+			p.SetState(404)
 			p.StateLit()
 		}
 
@@ -6148,7 +6238,7 @@ func (p *FaultParser) ComProperties() (localctx IComPropertiesContext) {
 		localctx = NewCompMiscContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(398)
+			p.SetState(405)
 			p.StructProperties()
 		}
 
@@ -6565,22 +6655,22 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		}
 	}()
 
-	p.SetState(420)
+	p.SetState(427)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropIntContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(401)
+			p.SetState(408)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(402)
+			p.SetState(409)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(403)
+			p.SetState(410)
 			p.Numeric()
 		}
 
@@ -6588,15 +6678,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropStringContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(404)
+			p.SetState(411)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(405)
+			p.SetState(412)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(406)
+			p.SetState(413)
 			p.String_()
 		}
 
@@ -6604,15 +6694,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropBoolContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(407)
+			p.SetState(414)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(408)
+			p.SetState(415)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(409)
+			p.SetState(416)
 			p.Bool_()
 		}
 
@@ -6620,15 +6710,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropVarContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(410)
+			p.SetState(417)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(411)
+			p.SetState(418)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(412)
+			p.SetState(419)
 			p.OperandName()
 		}
 
@@ -6636,15 +6726,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropVarContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(413)
+			p.SetState(420)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(414)
+			p.SetState(421)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(415)
+			p.SetState(422)
 			p.Prefix()
 		}
 
@@ -6652,15 +6742,15 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropSolvableContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(416)
+			p.SetState(423)
 			p.Match(FaultParserIDENT)
 		}
 		{
-			p.SetState(417)
+			p.SetState(424)
 			p.Match(FaultParserCOLON)
 		}
 		{
-			p.SetState(418)
+			p.SetState(425)
 			p.Solvable()
 		}
 
@@ -6668,7 +6758,7 @@ func (p *FaultParser) StructProperties() (localctx IStructPropertiesContext) {
 		localctx = NewPropSolvableContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(419)
+			p.SetState(426)
 			p.Match(FaultParserIDENT)
 		}
 
@@ -6811,15 +6901,15 @@ func (p *FaultParser) InitDecl() (localctx IInitDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(422)
+		p.SetState(429)
 		p.Match(FaultParserINIT)
 	}
 	{
-		p.SetState(423)
+		p.SetState(430)
 		p.Operand()
 	}
 	{
-		p.SetState(424)
+		p.SetState(431)
 		p.Eos()
 	}
 
@@ -6948,21 +7038,21 @@ func (p *FaultParser) Block() (localctx IBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(426)
+		p.SetState(433)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(428)
+	p.SetState(435)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(427)
+			p.SetState(434)
 			p.StatementList()
 		}
 
 	}
 	{
-		p.SetState(430)
+		p.SetState(437)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -7108,14 +7198,14 @@ func (p *FaultParser) StatementList() (localctx IStatementListContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(433)
+	p.SetState(440)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
 			{
-				p.SetState(432)
+				p.SetState(439)
 				p.Statement()
 			}
 
@@ -7123,7 +7213,7 @@ func (p *FaultParser) StatementList() (localctx IStatementListContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(435)
+		p.SetState(442)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
 	}
@@ -7326,45 +7416,45 @@ func (p *FaultParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(444)
+	p.SetState(451)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(437)
+			p.SetState(444)
 			p.ConstDecl()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(438)
+			p.SetState(445)
 			p.InitDecl()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(439)
+			p.SetState(446)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(440)
+			p.SetState(447)
 			p.Eos()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(442)
+			p.SetState(449)
 			p.Block()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(443)
+			p.SetState(450)
 			p.IfStmt()
 		}
 
@@ -7534,34 +7624,34 @@ func (p *FaultParser) SimpleStmt() (localctx ISimpleStmtContext) {
 		}
 	}()
 
-	p.SetState(450)
+	p.SetState(457)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(446)
+			p.SetState(453)
 			p.expression(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(447)
+			p.SetState(454)
 			p.IncDecStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(448)
+			p.SetState(455)
 			p.Assignment()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(449)
+			p.SetState(456)
 			p.EmptyStmt()
 		}
 
@@ -7693,11 +7783,11 @@ func (p *FaultParser) IncDecStmt() (localctx IIncDecStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(452)
+		p.SetState(459)
 		p.expression(0)
 	}
 	{
-		p.SetState(453)
+		p.SetState(460)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserPLUS_PLUS || _la == FaultParserMINUS_MINUS) {
@@ -7956,7 +8046,7 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(464)
+	p.SetState(471)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -7966,19 +8056,19 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(456)
+			p.SetState(463)
 			p.Match(FaultParserADVANCE)
 		}
 		{
-			p.SetState(457)
+			p.SetState(464)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(458)
+			p.SetState(465)
 			p.ParamCall()
 		}
 		{
-			p.SetState(459)
+			p.SetState(466)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -7987,15 +8077,15 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(461)
+			p.SetState(468)
 			p.Match(FaultParserSTAY)
 		}
 		{
-			p.SetState(462)
+			p.SetState(469)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(463)
+			p.SetState(470)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -8003,7 +8093,7 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(474)
+	p.SetState(481)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
 
@@ -8013,47 +8103,47 @@ func (p *FaultParser) stateChange(_p int) (localctx IStateChangeContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(472)
+			p.SetState(479)
 			p.GetErrorHandler().Sync(p)
 			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewBuiltinInfixContext(p, NewStateChangeContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_stateChange)
-				p.SetState(466)
+				p.SetState(473)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(467)
+					p.SetState(474)
 					p.Match(FaultParserAND)
 				}
 				{
-					p.SetState(468)
+					p.SetState(475)
 					p.stateChange(3)
 				}
 
 			case 2:
 				localctx = NewBuiltinInfixContext(p, NewStateChangeContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_stateChange)
-				p.SetState(469)
+				p.SetState(476)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(470)
+					p.SetState(477)
 					p.Match(FaultParserOR)
 				}
 				{
-					p.SetState(471)
+					p.SetState(478)
 					p.stateChange(2)
 				}
 
 			}
 
 		}
-		p.SetState(476)
+		p.SetState(483)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
 	}
@@ -8238,25 +8328,25 @@ func (p *FaultParser) AccessHistory() (localctx IAccessHistoryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(477)
+		p.SetState(484)
 		p.OperandName()
 	}
-	p.SetState(482)
+	p.SetState(489)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
 			{
-				p.SetState(478)
+				p.SetState(485)
 				p.Match(FaultParserLBRACE)
 			}
 			{
-				p.SetState(479)
+				p.SetState(486)
 				p.expression(0)
 			}
 			{
-				p.SetState(480)
+				p.SetState(487)
 				p.Match(FaultParserRBRACE)
 			}
 
@@ -8264,7 +8354,7 @@ func (p *FaultParser) AccessHistory() (localctx IAccessHistoryContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(484)
+		p.SetState(491)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext())
 	}
@@ -8424,26 +8514,26 @@ func (p *FaultParser) Assertion() (localctx IAssertionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(486)
+		p.SetState(493)
 		p.Match(FaultParserASSERT)
 	}
 	{
-		p.SetState(487)
+		p.SetState(494)
 		p.Invariant()
 	}
-	p.SetState(489)
+	p.SetState(496)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&130023424) != 0 {
 		{
-			p.SetState(488)
+			p.SetState(495)
 			p.Temporal()
 		}
 
 	}
 	{
-		p.SetState(491)
+		p.SetState(498)
 		p.Eos()
 	}
 
@@ -8602,94 +8692,98 @@ func (p *FaultParser) Assumption() (localctx IAssumptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(493)
+		p.SetState(500)
 		p.Match(FaultParserASSUME)
 	}
 	{
-		p.SetState(494)
+		p.SetState(501)
 		p.Invariant()
 	}
-	p.SetState(496)
+	p.SetState(503)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&130023424) != 0 {
 		{
-			p.SetState(495)
+			p.SetState(502)
 			p.Temporal()
 		}
 
 	}
 	{
-		p.SetState(498)
+		p.SetState(505)
 		p.Eos()
 	}
 
 	return localctx
 }
 
-// ITemporalContext is an interface to support dynamic dispatch.
-type ITemporalContext interface {
+// IOptimizeContext is an interface to support dynamic dispatch.
+type IOptimizeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EVENTUALLY() antlr.TerminalNode
-	ALWAYS() antlr.TerminalNode
-	EVENTUALLYALWAYS() antlr.TerminalNode
+	Expression() IExpressionContext
+	Eos() IEosContext
+	MAXIMIZE() antlr.TerminalNode
+	MINIMIZE() antlr.TerminalNode
+	AT() antlr.TerminalNode
 	Integer() IIntegerContext
-	NMT() antlr.TerminalNode
-	NFT() antlr.TerminalNode
 
-	// IsTemporalContext differentiates from other interfaces.
-	IsTemporalContext()
+	// IsOptimizeContext differentiates from other interfaces.
+	IsOptimizeContext()
 }
 
-type TemporalContext struct {
+type OptimizeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTemporalContext() *TemporalContext {
-	var p = new(TemporalContext)
+func NewEmptyOptimizeContext() *OptimizeContext {
+	var p = new(OptimizeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FaultParserRULE_temporal
+	p.RuleIndex = FaultParserRULE_optimize
 	return p
 }
 
-func (*TemporalContext) IsTemporalContext() {}
+func (*OptimizeContext) IsOptimizeContext() {}
 
-func NewTemporalContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemporalContext {
-	var p = new(TemporalContext)
+func NewOptimizeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptimizeContext {
+	var p = new(OptimizeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FaultParserRULE_temporal
+	p.RuleIndex = FaultParserRULE_optimize
 
 	return p
 }
 
-func (s *TemporalContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptimizeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TemporalContext) EVENTUALLY() antlr.TerminalNode {
-	return s.GetToken(FaultParserEVENTUALLY, 0)
-}
+func (s *OptimizeContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *TemporalContext) ALWAYS() antlr.TerminalNode {
-	return s.GetToken(FaultParserALWAYS, 0)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *TemporalContext) EVENTUALLYALWAYS() antlr.TerminalNode {
-	return s.GetToken(FaultParserEVENTUALLYALWAYS, 0)
+	return t.(IExpressionContext)
 }
 
-func (s *TemporalContext) Integer() IIntegerContext {
+func (s *OptimizeContext) Eos() IEosContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIntegerContext); ok {
+		if _, ok := ctx.(IEosContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8699,23 +8793,218 @@ func (s *TemporalContext) Integer() IIntegerContext {
 		return nil
 	}
 
-	return t.(IIntegerContext)
+	return t.(IEosContext)
 }
 
-func (s *TemporalContext) NMT() antlr.TerminalNode {
-	return s.GetToken(FaultParserNMT, 0)
+func (s *OptimizeContext) MAXIMIZE() antlr.TerminalNode {
+	return s.GetToken(FaultParserMAXIMIZE, 0)
 }
 
-func (s *TemporalContext) NFT() antlr.TerminalNode {
-	return s.GetToken(FaultParserNFT, 0)
+func (s *OptimizeContext) MINIMIZE() antlr.TerminalNode {
+	return s.GetToken(FaultParserMINIMIZE, 0)
 }
 
-func (s *TemporalContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *OptimizeContext) AT() antlr.TerminalNode {
+	return s.GetToken(FaultParserAT, 0)
 }
 
-func (s *TemporalContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *OptimizeContext) Integer() IIntegerContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIntegerContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntegerContext)
+}
+
+func (s *OptimizeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OptimizeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OptimizeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterOptimize(s)
+	}
+}
+
+func (s *OptimizeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitOptimize(s)
+	}
+}
+
+func (s *OptimizeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitOptimize(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FaultParser) Optimize() (localctx IOptimizeContext) {
+	this := p
+	_ = this
+
+	localctx = NewOptimizeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, FaultParserRULE_optimize)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(507)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FaultParserMAXIMIZE || _la == FaultParserMINIMIZE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(508)
+		p.expression(0)
+	}
+	p.SetState(511)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FaultParserAT {
+		{
+			p.SetState(509)
+			p.Match(FaultParserAT)
+		}
+		{
+			p.SetState(510)
+			p.Integer()
+		}
+
+	}
+	{
+		p.SetState(513)
+		p.Eos()
+	}
+
+	return localctx
+}
+
+// ITemporalContext is an interface to support dynamic dispatch.
+type ITemporalContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EVENTUALLY() antlr.TerminalNode
+	ALWAYS() antlr.TerminalNode
+	EVENTUALLYALWAYS() antlr.TerminalNode
+	Integer() IIntegerContext
+	NMT() antlr.TerminalNode
+	NFT() antlr.TerminalNode
+
+	// IsTemporalContext differentiates from other interfaces.
+	IsTemporalContext()
+}
+
+type TemporalContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTemporalContext() *TemporalContext {
+	var p = new(TemporalContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FaultParserRULE_temporal
+	return p
+}
+
+func (*TemporalContext) IsTemporalContext() {}
+
+func NewTemporalContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemporalContext {
+	var p = new(TemporalContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FaultParserRULE_temporal
+
+	return p
+}
+
+func (s *TemporalContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TemporalContext) EVENTUALLY() antlr.TerminalNode {
+	return s.GetToken(FaultParserEVENTUALLY, 0)
+}
+
+func (s *TemporalContext) ALWAYS() antlr.TerminalNode {
+	return s.GetToken(FaultParserALWAYS, 0)
+}
+
+func (s *TemporalContext) EVENTUALLYALWAYS() antlr.TerminalNode {
+	return s.GetToken(FaultParserEVENTUALLYALWAYS, 0)
+}
+
+func (s *TemporalContext) Integer() IIntegerContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIntegerContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntegerContext)
+}
+
+func (s *TemporalContext) NMT() antlr.TerminalNode {
+	return s.GetToken(FaultParserNMT, 0)
+}
+
+func (s *TemporalContext) NFT() antlr.TerminalNode {
+	return s.GetToken(FaultParserNFT, 0)
+}
+
+func (s *TemporalContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TemporalContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 func (s *TemporalContext) EnterRule(listener antlr.ParseTreeListener) {
@@ -8745,7 +9034,7 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 	_ = this
 
 	localctx = NewTemporalContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, FaultParserRULE_temporal)
+	p.EnterRule(localctx, 76, FaultParserRULE_temporal)
 	var _la int
 
 	defer func() {
@@ -8764,14 +9053,14 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 		}
 	}()
 
-	p.SetState(503)
+	p.SetState(518)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserEVENTUALLY, FaultParserEVENTUALLYALWAYS, FaultParserALWAYS:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(500)
+			p.SetState(515)
 			_la = p.GetTokenStream().LA(1)
 
 			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&29360128) != 0) {
@@ -8785,7 +9074,7 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 	case FaultParserNMT, FaultParserNFT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(501)
+			p.SetState(516)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserNMT || _la == FaultParserNFT) {
@@ -8796,7 +9085,7 @@ func (p *FaultParser) Temporal() (localctx ITemporalContext) {
 			}
 		}
 		{
-			p.SetState(502)
+			p.SetState(517)
 			p.Integer()
 		}
 
@@ -8890,6 +9179,22 @@ func (s *InvarContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
+func (s *InvarContext) Window() IWindowContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWindowContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowContext)
+}
+
 func (s *InvarContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
 		listenerT.EnterInvar(s)
@@ -8946,8 +9251,223 @@ func (s *StageInvariantContext) AllExpression() []IExpressionContext {
 	tst := make([]IExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IExpressionContext); ok {
-			tst[i] = t.(IExpressionContext)
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StageInvariantContext) Expression(i int) IExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *StageInvariantContext) THEN() antlr.TerminalNode {
+	return s.GetToken(FaultParserTHEN, 0)
+}
+
+func (s *StageInvariantContext) Window() IWindowContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWindowContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowContext)
+}
+
+func (s *StageInvariantContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterStageInvariant(s)
+	}
+}
+
+func (s *StageInvariantContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitStageInvariant(s)
+	}
+}
+
+func (s *StageInvariantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitStageInvariant(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FaultParser) Invariant() (localctx IInvariantContext) {
+	this := p
+	_ = this
+
+	localctx = NewInvariantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, FaultParserRULE_invariant)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(531)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewInvarContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(520)
+			p.expression(0)
+		}
+		p.SetState(522)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&15032385536) != 0 {
+			{
+				p.SetState(521)
+				p.Window()
+			}
+
+		}
+
+	case 2:
+		localctx = NewStageInvariantContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(524)
+			p.Match(FaultParserWHEN)
+		}
+		{
+			p.SetState(525)
+			p.expression(0)
+		}
+		{
+			p.SetState(526)
+			p.Match(FaultParserTHEN)
+		}
+		{
+			p.SetState(527)
+			p.expression(0)
+		}
+		p.SetState(529)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&15032385536) != 0 {
+			{
+				p.SetState(528)
+				p.Window()
+			}
+
+		}
+
+	}
+
+	return localctx
+}
+
+// IWindowContext is an interface to support dynamic dispatch.
+type IWindowContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	WITHIN() antlr.TerminalNode
+	AllInteger() []IIntegerContext
+	Integer(i int) IIntegerContext
+	AFTER() antlr.TerminalNode
+	BETWEEN() antlr.TerminalNode
+	WINDOW_AND() antlr.TerminalNode
+
+	// IsWindowContext differentiates from other interfaces.
+	IsWindowContext()
+}
+
+type WindowContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWindowContext() *WindowContext {
+	var p = new(WindowContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FaultParserRULE_window
+	return p
+}
+
+func (*WindowContext) IsWindowContext() {}
+
+func NewWindowContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WindowContext {
+	var p = new(WindowContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FaultParserRULE_window
+
+	return p
+}
+
+func (s *WindowContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WindowContext) WITHIN() antlr.TerminalNode {
+	return s.GetToken(FaultParserWITHIN, 0)
+}
+
+func (s *WindowContext) AllInteger() []IIntegerContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IIntegerContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IIntegerContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IIntegerContext); ok {
+			tst[i] = t.(IIntegerContext)
 			i++
 		}
 	}
@@ -8955,11 +9475,11 @@ func (s *StageInvariantContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *StageInvariantContext) Expression(i int) IExpressionContext {
+func (s *WindowContext) Integer(i int) IIntegerContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExpressionContext); ok {
+		if _, ok := ctx.(IIntegerContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -8972,41 +9492,57 @@ func (s *StageInvariantContext) Expression(i int) IExpressionContext {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IIntegerContext)
 }
 
-func (s *StageInvariantContext) THEN() antlr.TerminalNode {
-	return s.GetToken(FaultParserTHEN, 0)
+func (s *WindowContext) AFTER() antlr.TerminalNode {
+	return s.GetToken(FaultParserAFTER, 0)
 }
 
-func (s *StageInvariantContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WindowContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(FaultParserBETWEEN, 0)
+}
+
+func (s *WindowContext) WINDOW_AND() antlr.TerminalNode {
+	return s.GetToken(FaultParserWINDOW_AND, 0)
+}
+
+func (s *WindowContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WindowContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WindowContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
-		listenerT.EnterStageInvariant(s)
+		listenerT.EnterWindow(s)
 	}
 }
 
-func (s *StageInvariantContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WindowContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
-		listenerT.ExitStageInvariant(s)
+		listenerT.ExitWindow(s)
 	}
 }
 
-func (s *StageInvariantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WindowContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FaultParserVisitor:
-		return t.VisitStageInvariant(s)
+		return t.VisitWindow(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FaultParser) Invariant() (localctx IInvariantContext) {
+func (p *FaultParser) Window() (localctx IWindowContext) {
 	this := p
 	_ = this
 
-	localctx = NewInvariantContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, FaultParserRULE_invariant)
+	localctx = NewWindowContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, FaultParserRULE_window)
 
 	defer func() {
 		p.ExitRule()
@@ -9024,37 +9560,53 @@ func (p *FaultParser) Invariant() (localctx IInvariantContext) {
 		}
 	}()
 
-	p.SetState(511)
+	p.SetState(542)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewInvarContext(p, localctx)
+
+	switch p.GetTokenStream().LA(1) {
+	case FaultParserWITHIN:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(505)
-			p.expression(0)
+			p.SetState(533)
+			p.Match(FaultParserWITHIN)
+		}
+		{
+			p.SetState(534)
+			p.Integer()
 		}
 
-	case 2:
-		localctx = NewStageInvariantContext(p, localctx)
+	case FaultParserAFTER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(506)
-			p.Match(FaultParserWHEN)
+			p.SetState(535)
+			p.Match(FaultParserAFTER)
 		}
 		{
-			p.SetState(507)
-			p.expression(0)
+			p.SetState(536)
+			p.Integer()
 		}
+
+	case FaultParserBETWEEN:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(508)
-			p.Match(FaultParserTHEN)
+			p.SetState(537)
+			p.Match(FaultParserBETWEEN)
 		}
 		{
-			p.SetState(509)
-			p.expression(0)
+			p.SetState(538)
+			p.Integer()
+		}
+		{
+			p.SetState(539)
+			p.Match(FaultParserWINDOW_AND)
+		}
+		{
+			p.SetState(540)
+			p.Integer()
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -9328,7 +9880,7 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 	_ = this
 
 	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, FaultParserRULE_assignment)
+	p.EnterRule(localctx, 82, FaultParserRULE_assignment)
 	var _la int
 
 	defer func() {
@@ -9347,26 +9899,26 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 		}
 	}()
 
-	p.SetState(524)
+	p.SetState(555)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewMiscAssignContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(513)
+			p.SetState(544)
 			p.ExpressionList()
 		}
-		p.SetState(515)
+		p.SetState(546)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2078721) != 0 {
+		if (int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&2078721) != 0 {
 			{
-				p.SetState(514)
+				p.SetState(545)
 				_la = p.GetTokenStream().LA(1)
 
-				if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2078721) != 0) {
+				if !((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&2078721) != 0) {
 					p.GetErrorHandler().RecoverInline(p)
 				} else {
 					p.GetErrorHandler().ReportMatch(p)
@@ -9376,11 +9928,11 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 
 		}
 		{
-			p.SetState(517)
+			p.SetState(548)
 			p.Match(FaultParserASSIGN)
 		}
 		{
-			p.SetState(518)
+			p.SetState(549)
 			p.ExpressionList()
 		}
 
@@ -9388,11 +9940,11 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 		localctx = NewFaultAssignContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(520)
+			p.SetState(551)
 			p.ExpressionList()
 		}
 		{
-			p.SetState(521)
+			p.SetState(552)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == FaultParserASSIGN_FLOW1 || _la == FaultParserASSIGN_FLOW2) {
@@ -9403,7 +9955,7 @@ func (p *FaultParser) Assignment() (localctx IAssignmentContext) {
 			}
 		}
 		{
-			p.SetState(522)
+			p.SetState(553)
 			p.ExpressionList()
 		}
 
@@ -9492,7 +10044,7 @@ func (p *FaultParser) EmptyStmt() (localctx IEmptyStmtContext) {
 	_ = this
 
 	localctx = NewEmptyStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, FaultParserRULE_emptyStmt)
+	p.EnterRule(localctx, 84, FaultParserRULE_emptyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -9512,7 +10064,7 @@ func (p *FaultParser) EmptyStmt() (localctx IEmptyStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(526)
+		p.SetState(557)
 		p.Match(FaultParserSEMI)
 	}
 
@@ -9703,7 +10255,7 @@ func (p *FaultParser) IfStmt() (localctx IIfStmtContext) {
 	_ = this
 
 	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, FaultParserRULE_ifStmt)
+	p.EnterRule(localctx, 86, FaultParserRULE_ifStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -9723,52 +10275,52 @@ func (p *FaultParser) IfStmt() (localctx IIfStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
+		p.SetState(559)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(532)
+	p.SetState(563)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(529)
+			p.SetState(560)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(530)
+			p.SetState(561)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(534)
+		p.SetState(565)
 		p.expression(0)
 	}
 	{
-		p.SetState(535)
+		p.SetState(566)
 		p.Block()
 	}
-	p.SetState(541)
+	p.SetState(572)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(536)
+			p.SetState(567)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(539)
+		p.SetState(570)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(537)
+				p.SetState(568)
 				p.IfStmt()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(538)
+				p.SetState(569)
 				p.Block()
 			}
 
@@ -9965,7 +10517,7 @@ func (p *FaultParser) IfStmtRun() (localctx IIfStmtRunContext) {
 	_ = this
 
 	localctx = NewIfStmtRunContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, FaultParserRULE_ifStmtRun)
+	p.EnterRule(localctx, 88, FaultParserRULE_ifStmtRun)
 
 	defer func() {
 		p.ExitRule()
@@ -9985,52 +10537,52 @@ func (p *FaultParser) IfStmtRun() (localctx IIfStmtRunContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(543)
+		p.SetState(574)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(547)
+	p.SetState(578)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(544)
+			p.SetState(575)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(545)
+			p.SetState(576)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(549)
+		p.SetState(580)
 		p.expression(0)
 	}
 	{
-		p.SetState(550)
+		p.SetState(581)
 		p.RunBlock()
 	}
-	p.SetState(556)
+	p.SetState(587)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(551)
+			p.SetState(582)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(554)
+		p.SetState(585)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(552)
+				p.SetState(583)
 				p.IfStmtRun()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(553)
+				p.SetState(584)
 				p.RunBlock()
 			}
 
@@ -10227,7 +10779,7 @@ func (p *FaultParser) IfStmtState() (localctx IIfStmtStateContext) {
 	_ = this
 
 	localctx = NewIfStmtStateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, FaultParserRULE_ifStmtState)
+	p.EnterRule(localctx, 90, FaultParserRULE_ifStmtState)
 	var _la int
 
 	defer func() {
@@ -10248,53 +10800,53 @@ func (p *FaultParser) IfStmtState() (localctx IIfStmtStateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(558)
+		p.SetState(589)
 		p.Match(FaultParserIF)
 	}
-	p.SetState(562)
+	p.SetState(593)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(559)
+			p.SetState(590)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(560)
+			p.SetState(591)
 			p.Match(FaultParserSEMI)
 		}
 
 	}
 	{
-		p.SetState(564)
+		p.SetState(595)
 		p.expression(0)
 	}
 	{
-		p.SetState(565)
+		p.SetState(596)
 		p.StateBlock()
 	}
-	p.SetState(571)
+	p.SetState(602)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserELSE {
 		{
-			p.SetState(566)
+			p.SetState(597)
 			p.Match(FaultParserELSE)
 		}
-		p.SetState(569)
+		p.SetState(600)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case FaultParserIF:
 			{
-				p.SetState(567)
+				p.SetState(598)
 				p.IfStmtState()
 			}
 
 		case FaultParserLCURLY:
 			{
-				p.SetState(568)
+				p.SetState(599)
 				p.StateBlock()
 			}
 
@@ -10465,7 +11017,7 @@ func (p *FaultParser) ForStmt() (localctx IForStmtContext) {
 	_ = this
 
 	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, FaultParserRULE_forStmt)
+	p.EnterRule(localctx, 92, FaultParserRULE_forStmt)
 	var _la int
 
 	defer func() {
@@ -10486,43 +11038,43 @@ func (p *FaultParser) ForStmt() (localctx IForStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(573)
+		p.SetState(604)
 		p.Match(FaultParserFOR)
 	}
 	{
-		p.SetState(574)
+		p.SetState(605)
 		p.Rounds()
 	}
-	p.SetState(577)
+	p.SetState(608)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserINIT {
 		{
-			p.SetState(575)
+			p.SetState(606)
 			p.Match(FaultParserINIT)
 		}
 		{
-			p.SetState(576)
+			p.SetState(607)
 			p.InitBlock()
 		}
 
 	}
 	{
-		p.SetState(579)
+		p.SetState(610)
 		p.Match(FaultParserRUN)
 	}
 	{
-		p.SetState(580)
+		p.SetState(611)
 		p.RunBlock()
 	}
-	p.SetState(582)
+	p.SetState(613)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FaultParserSEMI {
 		{
-			p.SetState(581)
+			p.SetState(612)
 			p.Eos()
 		}
 
@@ -10623,7 +11175,7 @@ func (p *FaultParser) Rounds() (localctx IRoundsContext) {
 	_ = this
 
 	localctx = NewRoundsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, FaultParserRULE_rounds)
+	p.EnterRule(localctx, 94, FaultParserRULE_rounds)
 
 	defer func() {
 		p.ExitRule()
@@ -10643,7 +11195,7 @@ func (p *FaultParser) Rounds() (localctx IRoundsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(584)
+		p.SetState(615)
 		p.Integer()
 	}
 
@@ -10663,6 +11215,8 @@ type IParamCallContext interface {
 	AllIDENT() []antlr.TerminalNode
 	IDENT(i int) antlr.TerminalNode
 	THIS() antlr.TerminalNode
+	AllNEXT() []antlr.TerminalNode
+	NEXT(i int) antlr.TerminalNode
 
 	// IsParamCallContext differentiates from other interfaces.
 	IsParamCallContext()
@@ -10715,6 +11269,14 @@ func (s *ParamCallContext) THIS() antlr.TerminalNode {
 	return s.GetToken(FaultParserTHIS, 0)
 }
 
+func (s *ParamCallContext) AllNEXT() []antlr.TerminalNode {
+	return s.GetTokens(FaultParserNEXT)
+}
+
+func (s *ParamCallContext) NEXT(i int) antlr.TerminalNode {
+	return s.GetToken(FaultParserNEXT, i)
+}
+
 func (s *ParamCallContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -10750,7 +11312,7 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 	_ = this
 
 	localctx = NewParamCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, FaultParserRULE_paramCall)
+	p.EnterRule(localctx, 96, FaultParserRULE_paramCall)
 	var _la int
 
 	defer func() {
@@ -10773,7 +11335,7 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(586)
+		p.SetState(617)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserTHIS || _la == FaultParserIDENT) {
@@ -10784,32 +11346,46 @@ func (p *FaultParser) ParamCall() (localctx IParamCallContext) {
 		}
 	}
 	{
-		p.SetState(587)
+		p.SetState(618)
 		p.Match(FaultParserDOT)
 	}
 	{
-		p.SetState(588)
-		p.Match(FaultParserIDENT)
+		p.SetState(619)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FaultParserNEXT || _la == FaultParserIDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
-	p.SetState(593)
+	p.SetState(624)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(589)
+				p.SetState(620)
 				p.Match(FaultParserDOT)
 			}
 			{
-				p.SetState(590)
-				p.Match(FaultParserIDENT)
+				p.SetState(621)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == FaultParserNEXT || _la == FaultParserIDENT) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
 			}
 
 		}
-		p.SetState(595)
+		p.SetState(626)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10943,7 +11519,7 @@ func (p *FaultParser) StateBlock() (localctx IStateBlockContext) {
 	_ = this
 
 	localctx = NewStateBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, FaultParserRULE_stateBlock)
+	p.EnterRule(localctx, 98, FaultParserRULE_stateBlock)
 	var _la int
 
 	defer func() {
@@ -10964,25 +11540,25 @@ func (p *FaultParser) StateBlock() (localctx IStateBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(596)
+		p.SetState(627)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(600)
+	p.SetState(631)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17661981362176) != 0 {
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&72343467063248896) != 0 {
 		{
-			p.SetState(597)
+			p.SetState(628)
 			p.StateStep()
 		}
 
-		p.SetState(602)
+		p.SetState(633)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(603)
+		p.SetState(634)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -11272,7 +11848,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 	_ = this
 
 	localctx = NewStateStepContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, FaultParserRULE_stateStep)
+	p.EnterRule(localctx, 100, FaultParserRULE_stateStep)
 	var _la int
 
 	defer func() {
@@ -11291,7 +11867,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		}
 	}()
 
-	p.SetState(616)
+	p.SetState(647)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
@@ -11299,26 +11875,26 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateStepExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(605)
+			p.SetState(636)
 			p.ParamCall()
 		}
-		p.SetState(608)
+		p.SetState(639)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == FaultParserPIPE {
 			{
-				p.SetState(606)
+				p.SetState(637)
 				p.Match(FaultParserPIPE)
 			}
 			{
-				p.SetState(607)
+				p.SetState(638)
 				p.ParamCall()
 			}
 
 		}
 		{
-			p.SetState(610)
+			p.SetState(641)
 			p.Eos()
 		}
 
@@ -11326,11 +11902,11 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateChainContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(612)
+			p.SetState(643)
 			p.stateChange(0)
 		}
 		{
-			p.SetState(613)
+			p.SetState(644)
 			p.Eos()
 		}
 
@@ -11338,7 +11914,7 @@ func (p *FaultParser) StateStep() (localctx IStateStepContext) {
 		localctx = NewStateExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(615)
+			p.SetState(646)
 			p.IfStmtState()
 		}
 
@@ -11477,7 +12053,7 @@ func (p *FaultParser) RunBlock() (localctx IRunBlockContext) {
 	_ = this
 
 	localctx = NewRunBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, FaultParserRULE_runBlock)
+	p.EnterRule(localctx, 102, FaultParserRULE_runBlock)
 
 	defer func() {
 		p.ExitRule()
@@ -11499,27 +12075,27 @@ func (p *FaultParser) RunBlock() (localctx IRunBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(618)
+		p.SetState(649)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(622)
+	p.SetState(653)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(619)
+				p.SetState(650)
 				p.RunStep()
 			}
 
 		}
-		p.SetState(624)
+		p.SetState(655)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(625)
+		p.SetState(656)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -11654,7 +12230,7 @@ func (p *FaultParser) InitBlock() (localctx IInitBlockContext) {
 	_ = this
 
 	localctx = NewInitBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, FaultParserRULE_initBlock)
+	p.EnterRule(localctx, 104, FaultParserRULE_initBlock)
 	var _la int
 
 	defer func() {
@@ -11675,25 +12251,25 @@ func (p *FaultParser) InitBlock() (localctx IInitBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(627)
+		p.SetState(658)
 		p.Match(FaultParserLCURLY)
 	}
-	p.SetState(631)
+	p.SetState(662)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserIDENT {
 		{
-			p.SetState(628)
+			p.SetState(659)
 			p.InitStep()
 		}
 
-		p.SetState(633)
+		p.SetState(664)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(634)
+		p.SetState(665)
 		p.Match(FaultParserRCURLY)
 	}
 
@@ -11908,7 +12484,7 @@ func (p *FaultParser) InitStep() (localctx IInitStepContext) {
 	_ = this
 
 	localctx = NewInitStepContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, FaultParserRULE_initStep)
+	p.EnterRule(localctx, 106, FaultParserRULE_initStep)
 
 	defer func() {
 		p.ExitRule()
@@ -11931,56 +12507,56 @@ func (p *FaultParser) InitStep() (localctx IInitStepContext) {
 	localctx = NewRunInitContext(p, localctx)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(636)
+		p.SetState(667)
 		p.Match(FaultParserIDENT)
 	}
 	{
-		p.SetState(637)
+		p.SetState(668)
 		p.Match(FaultParserASSIGN)
 	}
 	{
-		p.SetState(638)
+		p.SetState(669)
 		p.Match(FaultParserNEW)
 	}
-	p.SetState(641)
+	p.SetState(672)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(639)
+			p.SetState(670)
 			p.ParamCall()
 		}
 
 	case 2:
 		{
-			p.SetState(640)
+			p.SetState(671)
 			p.Match(FaultParserIDENT)
 		}
 
 	}
 	{
-		p.SetState(643)
+		p.SetState(674)
 		p.Eos()
 	}
-	p.SetState(649)
+	p.SetState(680)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(644)
+				p.SetState(675)
 				p.Swap()
 			}
 			{
-				p.SetState(645)
+				p.SetState(676)
 				p.Eos()
 			}
 
 		}
-		p.SetState(651)
+		p.SetState(682)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -12233,7 +12809,7 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 	_ = this
 
 	localctx = NewRunStepContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, FaultParserRULE_runStep)
+	p.EnterRule(localctx, 108, FaultParserRULE_runStep)
 	var _la int
 
 	defer func() {
@@ -12252,36 +12828,36 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		}
 	}()
 
-	p.SetState(666)
+	p.SetState(697)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewRunStepExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(652)
+			p.SetState(683)
 			p.ParamCall()
 		}
-		p.SetState(657)
+		p.SetState(688)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FaultParserPIPE {
 			{
-				p.SetState(653)
+				p.SetState(684)
 				p.Match(FaultParserPIPE)
 			}
 			{
-				p.SetState(654)
+				p.SetState(685)
 				p.ParamCall()
 			}
 
-			p.SetState(659)
+			p.SetState(690)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(660)
+			p.SetState(691)
 			p.Eos()
 		}
 
@@ -12289,11 +12865,11 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		localctx = NewRunExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(662)
+			p.SetState(693)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(663)
+			p.SetState(694)
 			p.Eos()
 		}
 
@@ -12301,7 +12877,7 @@ func (p *FaultParser) RunStep() (localctx IRunStepContext) {
 		localctx = NewRunExprContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(665)
+			p.SetState(696)
 			p.IfStmtRun()
 		}
 
@@ -12420,7 +12996,7 @@ func (p *FaultParser) FaultType() (localctx IFaultTypeContext) {
 	_ = this
 
 	localctx = NewFaultTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, FaultParserRULE_faultType)
+	p.EnterRule(localctx, 110, FaultParserRULE_faultType)
 	var _la int
 
 	defer func() {
@@ -12441,10 +13017,10 @@ func (p *FaultParser) FaultType() (localctx IFaultTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(668)
+		p.SetState(699)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17454747090944) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&71494644084506624) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -12610,7 +13186,7 @@ func (p *FaultParser) Solvable() (localctx ISolvableContext) {
 	_ = this
 
 	localctx = NewSolvableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, FaultParserRULE_solvable)
+	p.EnterRule(localctx, 112, FaultParserRULE_solvable)
 	var _la int
 
 	defer func() {
@@ -12631,44 +13207,44 @@ func (p *FaultParser) Solvable() (localctx ISolvableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(670)
+		p.SetState(701)
 		p.FaultType()
 	}
 	{
-		p.SetState(671)
+		p.SetState(702)
 		p.Match(FaultParserLPAREN)
 	}
-	p.SetState(673)
+	p.SetState(704)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2269392941367312) != 0) || ((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&32257) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9151310560164298736) != 0) || ((int64((_la-84)) & ^0x3f) == 0 && ((int64(1)<<(_la-84))&32257) != 0) {
 		{
-			p.SetState(672)
+			p.SetState(703)
 			p.Operand()
 		}
 
 	}
-	p.SetState(679)
+	p.SetState(710)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FaultParserCOMMA {
 		{
-			p.SetState(675)
+			p.SetState(706)
 			p.Match(FaultParserCOMMA)
 		}
 		{
-			p.SetState(676)
+			p.SetState(707)
 			p.Operand()
 		}
 
-		p.SetState(681)
+		p.SetState(712)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(682)
+		p.SetState(713)
 		p.Match(FaultParserRPAREN)
 	}
 
@@ -12850,16 +13426,165 @@ func NewExprPrefixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Exp
 	return p
 }
 
-func (s *ExprPrefixContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ExprPrefixContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprPrefixContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ExprPrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterExprPrefix(s)
+	}
+}
+
+func (s *ExprPrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitExprPrefix(s)
+	}
+}
+
+func (s *ExprPrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitExprPrefix(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TemporalPrefixContext struct {
+	*ExpressionContext
+}
+
+func NewTemporalPrefixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TemporalPrefixContext {
+	var p = new(TemporalPrefixContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *TemporalPrefixContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TemporalPrefixContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *TemporalPrefixContext) NEXT() antlr.TerminalNode {
+	return s.GetToken(FaultParserNEXT, 0)
+}
+
+func (s *TemporalPrefixContext) ALWAYS() antlr.TerminalNode {
+	return s.GetToken(FaultParserALWAYS, 0)
+}
+
+func (s *TemporalPrefixContext) EVENTUALLY() antlr.TerminalNode {
+	return s.GetToken(FaultParserEVENTUALLY, 0)
+}
+
+func (s *TemporalPrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterTemporalPrefix(s)
+	}
+}
+
+func (s *TemporalPrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitTemporalPrefix(s)
+	}
+}
+
+func (s *TemporalPrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitTemporalPrefix(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TemporalInfixContext struct {
+	*ExpressionContext
+}
+
+func NewTemporalInfixContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TemporalInfixContext {
+	var p = new(TemporalInfixContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *TemporalInfixContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TemporalInfixContext) AllExpression() []IExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExpressionContext); ok {
+			tst[i] = t.(IExpressionContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *ExprPrefixContext) Prefix() IPrefixContext {
+func (s *TemporalInfixContext) Expression(i int) IExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -12867,25 +13592,37 @@ func (s *ExprPrefixContext) Prefix() IPrefixContext {
 		return nil
 	}
 
-	return t.(IPrefixContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ExprPrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TemporalInfixContext) UNTIL() antlr.TerminalNode {
+	return s.GetToken(FaultParserUNTIL, 0)
+}
+
+func (s *TemporalInfixContext) WEAK_UNTIL() antlr.TerminalNode {
+	return s.GetToken(FaultParserWEAK_UNTIL, 0)
+}
+
+func (s *TemporalInfixContext) RELEASE() antlr.TerminalNode {
+	return s.GetToken(FaultParserRELEASE, 0)
+}
+
+func (s *TemporalInfixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
-		listenerT.EnterExprPrefix(s)
+		listenerT.EnterTemporalInfix(s)
 	}
 }
 
-func (s *ExprPrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TemporalInfixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FaultParserListener); ok {
-		listenerT.ExitExprPrefix(s)
+		listenerT.ExitTemporalInfix(s)
 	}
 }
 
-func (s *ExprPrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TemporalInfixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FaultParserVisitor:
-		return t.VisitExprPrefix(s)
+		return t.VisitTemporalInfix(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -13062,8 +13799,8 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 110
-	p.EnterRecursionRule(localctx, 110, FaultParserRULE_expression, _p)
+	_startState := 114
+	p.EnterRecursionRule(localctx, 114, FaultParserRULE_expression, _p)
 	var _la int
 
 	defer func() {
@@ -13085,16 +13822,16 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(688)
+	p.SetState(721)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewExprContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(685)
+			p.SetState(716)
 			p.Operand()
 		}
 
@@ -13103,7 +13840,7 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(686)
+			p.SetState(717)
 			p.Solvable()
 		}
 
@@ -13112,15 +13849,35 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(687)
+			p.SetState(718)
 			p.Prefix()
 		}
 
+	case 4:
+		localctx = NewTemporalPrefixContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(719)
+			_la = p.GetTokenStream().LA(1)
+
+			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&155189248) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(720)
+			p.expression(2)
+		}
+
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(710)
+	p.SetState(746)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -13128,39 +13885,39 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(708)
+			p.SetState(744)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(690)
+				p.SetState(723)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(691)
+					p.SetState(724)
 					p.Match(FaultParserEXPO)
 				}
 				{
-					p.SetState(692)
-					p.expression(7)
+					p.SetState(725)
+					p.expression(9)
 				}
 
 			case 2:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(693)
+				p.SetState(726)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(694)
+					p.SetState(727)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&2064385) != 0) {
+					if !((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&2064385) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -13168,23 +13925,23 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(695)
-					p.expression(6)
+					p.SetState(728)
+					p.expression(8)
 				}
 
 			case 3:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(696)
+				p.SetState(729)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(697)
+					p.SetState(730)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&7) != 0) {
+					if !((int64((_la-83)) & ^0x3f) == 0 && ((int64(1)<<(_la-83))&7) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -13192,23 +13949,23 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(698)
-					p.expression(5)
+					p.SetState(731)
+					p.expression(7)
 				}
 
 			case 4:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(699)
+				p.SetState(732)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(700)
+					p.SetState(733)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64((_la-63)) & ^0x3f) == 0 && ((int64(1)<<(_la-63))&63) != 0) {
+					if !((int64((_la-75)) & ^0x3f) == 0 && ((int64(1)<<(_la-75))&63) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -13216,50 +13973,74 @@ func (p *FaultParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(701)
-					p.expression(4)
+					p.SetState(734)
+					p.expression(6)
 				}
 
 			case 5:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(702)
+				p.SetState(735)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(703)
+					p.SetState(736)
 					p.Match(FaultParserAND)
 				}
 				{
-					p.SetState(704)
-					p.expression(3)
+					p.SetState(737)
+					p.expression(5)
 				}
 
 			case 6:
 				localctx = NewLrExprContext(p, NewExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
-				p.SetState(705)
+				p.SetState(738)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				{
+					p.SetState(739)
+					p.Match(FaultParserOR)
+				}
+				{
+					p.SetState(740)
+					p.expression(4)
+				}
+
+			case 7:
+				localctx = NewTemporalInfixContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, FaultParserRULE_expression)
+				p.SetState(741)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(706)
-					p.Match(FaultParserOR)
+					p.SetState(742)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1879048192) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
 				}
 				{
-					p.SetState(707)
+					p.SetState(743)
 					p.expression(2)
 				}
 
 			}
 
 		}
-		p.SetState(712)
+		p.SetState(748)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13279,6 +14060,7 @@ type IOperandContext interface {
 	Bool_() IBool_Context
 	OperandName() IOperandNameContext
 	AccessHistory() IAccessHistoryContext
+	Probability() IProbabilityContext
 	LPAREN() antlr.TerminalNode
 	Expression() IExpressionContext
 	RPAREN() antlr.TerminalNode
@@ -13410,6 +14192,22 @@ func (s *OperandContext) AccessHistory() IAccessHistoryContext {
 	return t.(IAccessHistoryContext)
 }
 
+func (s *OperandContext) Probability() IProbabilityContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IProbabilityContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IProbabilityContext)
+}
+
 func (s *OperandContext) LPAREN() antlr.TerminalNode {
 	return s.GetToken(FaultParserLPAREN, 0)
 }
@@ -13469,7 +14267,7 @@ func (p *FaultParser) Operand() (localctx IOperandContext) {
 	_ = this
 
 	localctx = NewOperandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, FaultParserRULE_operand)
+	p.EnterRule(localctx, 116, FaultParserRULE_operand)
 
 	defer func() {
 		p.ExitRule()
@@ -13487,63 +14285,70 @@ func (p *FaultParser) Operand() (localctx IOperandContext) {
 		}
 	}()
 
-	p.SetState(723)
+	p.SetState(760)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(713)
+			p.SetState(749)
 			p.Nil_()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(714)
+			p.SetState(750)
 			p.Numeric()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(715)
+			p.SetState(751)
 			p.String_()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(716)
+			p.SetState(752)
 			p.Bool_()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(717)
+			p.SetState(753)
 			p.OperandName()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(718)
+			p.SetState(754)
 			p.AccessHistory()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(719)
+			p.SetState(755)
+			p.Probability()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(756)
 			p.Match(FaultParserLPAREN)
 		}
 		{
-			p.SetState(720)
+			p.SetState(757)
 			p.expression(0)
 		}
 		{
-			p.SetState(721)
+			p.SetState(758)
 			p.Match(FaultParserRPAREN)
 		}
 
@@ -13552,6 +14357,152 @@ func (p *FaultParser) Operand() (localctx IOperandContext) {
 	return localctx
 }
 
+// IProbabilityContext is an interface to support dynamic dispatch.
+type IProbabilityContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PROB() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	Expression() IExpressionContext
+	RPAREN() antlr.TerminalNode
+
+	// IsProbabilityContext differentiates from other interfaces.
+	IsProbabilityContext()
+}
+
+type ProbabilityContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyProbabilityContext() *ProbabilityContext {
+	var p = new(ProbabilityContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FaultParserRULE_probability
+	return p
+}
+
+func (*ProbabilityContext) IsProbabilityContext() {}
+
+func NewProbabilityContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProbabilityContext {
+	var p = new(ProbabilityContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FaultParserRULE_probability
+
+	return p
+}
+
+func (s *ProbabilityContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ProbabilityContext) PROB() antlr.TerminalNode {
+	return s.GetToken(FaultParserPROB, 0)
+}
+
+func (s *ProbabilityContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(FaultParserLPAREN, 0)
+}
+
+func (s *ProbabilityContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ProbabilityContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(FaultParserRPAREN, 0)
+}
+
+func (s *ProbabilityContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ProbabilityContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ProbabilityContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.EnterProbability(s)
+	}
+}
+
+func (s *ProbabilityContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FaultParserListener); ok {
+		listenerT.ExitProbability(s)
+	}
+}
+
+func (s *ProbabilityContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FaultParserVisitor:
+		return t.VisitProbability(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FaultParser) Probability() (localctx IProbabilityContext) {
+	this := p
+	_ = this
+
+	localctx = NewProbabilityContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, FaultParserRULE_probability)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(762)
+		p.Match(FaultParserPROB)
+	}
+	{
+		p.SetState(763)
+		p.Match(FaultParserLPAREN)
+	}
+	{
+		p.SetState(764)
+		p.expression(0)
+	}
+	{
+		p.SetState(765)
+		p.Match(FaultParserRPAREN)
+	}
+
+	return localctx
+}
+
 // IOperandNameContext is an interface to support dynamic dispatch.
 type IOperandNameContext interface {
 	antlr.ParserRuleContext
@@ -13850,7 +14801,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 	_ = this
 
 	localctx = NewOperandNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, FaultParserRULE_operandName)
+	p.EnterRule(localctx, 120, FaultParserRULE_operandName)
 
 	defer func() {
 		p.ExitRule()
@@ -13868,14 +14819,14 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		}
 	}()
 
-	p.SetState(735)
+	p.SetState(777)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewOpNameContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(725)
+			p.SetState(767)
 			p.Match(FaultParserIDENT)
 		}
 
@@ -13883,7 +14834,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpParamContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(726)
+			p.SetState(768)
 			p.ParamCall()
 		}
 
@@ -13891,7 +14842,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpThisContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(727)
+			p.SetState(769)
 			p.Match(FaultParserTHIS)
 		}
 
@@ -13899,7 +14850,7 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpClockContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(728)
+			p.SetState(770)
 			p.Match(FaultParserCLOCK)
 		}
 
@@ -13907,23 +14858,23 @@ func (p *FaultParser) OperandName() (localctx IOperandNameContext) {
 		localctx = NewOpInstanceContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(729)
+			p.SetState(771)
 			p.Match(FaultParserNEW)
 		}
 		{
-			p.SetState(730)
+			p.SetState(772)
 			p.Match(FaultParserIDENT)
 		}
-		p.SetState(733)
+		p.SetState(775)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(731)
+				p.SetState(773)
 				p.Match(FaultParserDOT)
 			}
 			{
-				p.SetState(732)
+				p.SetState(774)
 				p.Match(FaultParserIDENT)
 			}
 
@@ -14056,7 +15007,7 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 	_ = this
 
 	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, FaultParserRULE_prefix)
+	p.EnterRule(localctx, 122, FaultParserRULE_prefix)
 	var _la int
 
 	defer func() {
@@ -14075,19 +15026,19 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 		}
 	}()
 
-	p.SetState(740)
+	p.SetState(782)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(738)
+			p.SetState(780)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&47109) != 0) {
+			if !((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&47109) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -14095,7 +15046,7 @@ func (p *FaultParser) Prefix() (localctx IPrefixContext) {
 			}
 		}
 		{
-			p.SetState(739)
+			p.SetState(781)
 			p.expression(0)
 		}
 
@@ -14230,7 +15181,7 @@ func (p *FaultParser) Numeric() (localctx INumericContext) {
 	_ = this
 
 	localctx = NewNumericContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, FaultParserRULE_numeric)
+	p.EnterRule(localctx, 124, FaultParserRULE_numeric)
 
 	defer func() {
 		p.ExitRule()
@@ -14248,28 +15199,28 @@ func (p *FaultParser) Numeric() (localctx INumericContext) {
 		}
 	}()
 
-	p.SetState(745)
+	p.SetState(787)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FaultParserDECIMAL_LIT, FaultParserOCTAL_LIT, FaultParserHEX_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(742)
+			p.SetState(784)
 			p.Integer()
 		}
 
 	case FaultParserMINUS:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(743)
+			p.SetState(785)
 			p.Negative()
 		}
 
 	case FaultParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(744)
+			p.SetState(786)
 			p.Float_()
 		}
 
@@ -14370,7 +15321,7 @@ func (p *FaultParser) Integer() (localctx IIntegerContext) {
 	_ = this
 
 	localctx = NewIntegerContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, FaultParserRULE_integer)
+	p.EnterRule(localctx, 126, FaultParserRULE_integer)
 	var _la int
 
 	defer func() {
@@ -14391,10 +15342,10 @@ func (p *FaultParser) Integer() (localctx IIntegerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(747)
+		p.SetState(789)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&7) != 0) {
+		if !((int64((_la-93)) & ^0x3f) == 0 && ((int64(1)<<(_la-93))&7) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14519,7 +15470,7 @@ func (p *FaultParser) Negative() (localctx INegativeContext) {
 	_ = this
 
 	localctx = NewNegativeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, FaultParserRULE_negative)
+	p.EnterRule(localctx, 128, FaultParserRULE_negative)
 
 	defer func() {
 		p.ExitRule()
@@ -14537,28 +15488,28 @@ func (p *FaultParser) Negative() (localctx INegativeContext) {
 		}
 	}()
 
-	p.SetState(753)
+	p.SetState(795)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(749)
+			p.SetState(791)
 			p.Match(FaultParserMINUS)
 		}
 		{
-			p.SetState(750)
+			p.SetState(792)
 			p.Integer()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(751)
+			p.SetState(793)
 			p.Match(FaultParserMINUS)
 		}
 		{
-			p.SetState(752)
+			p.SetState(794)
 			p.Float_()
 		}
 
@@ -14647,7 +15598,7 @@ func (p *FaultParser) Float_() (localctx IFloat_Context) {
 	_ = this
 
 	localctx = NewFloat_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, FaultParserRULE_float_)
+	p.EnterRule(localctx, 130, FaultParserRULE_float_)
 
 	defer func() {
 		p.ExitRule()
@@ -14667,7 +15618,7 @@ func (p *FaultParser) Float_() (localctx IFloat_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(755)
+		p.SetState(797)
 		p.Match(FaultParserFLOAT_LIT)
 	}
 
@@ -14759,7 +15710,7 @@ func (p *FaultParser) String_() (localctx IString_Context) {
 	_ = this
 
 	localctx = NewString_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, FaultParserRULE_string_)
+	p.EnterRule(localctx, 132, FaultParserRULE_string_)
 	var _la int
 
 	defer func() {
@@ -14780,7 +15731,7 @@ func (p *FaultParser) String_() (localctx IString_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(757)
+		p.SetState(799)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserRAW_STRING_LIT || _la == FaultParserINTERPRETED_STRING_LIT) {
@@ -14879,7 +15830,7 @@ func (p *FaultParser) Bool_() (localctx IBool_Context) {
 	_ = this
 
 	localctx = NewBool_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, FaultParserRULE_bool_)
+	p.EnterRule(localctx, 134, FaultParserRULE_bool_)
 	var _la int
 
 	defer func() {
@@ -14900,7 +15851,7 @@ func (p *FaultParser) Bool_() (localctx IBool_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(759)
+		p.SetState(801)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FaultParserTRUE || _la == FaultParserFALSE) {
@@ -15011,7 +15962,7 @@ func (p *FaultParser) FunctionLit() (localctx IFunctionLitContext) {
 	_ = this
 
 	localctx = NewFunctionLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, FaultParserRULE_functionLit)
+	p.EnterRule(localctx, 136, FaultParserRULE_functionLit)
 
 	defer func() {
 		p.ExitRule()
@@ -15031,11 +15982,11 @@ func (p *FaultParser) FunctionLit() (localctx IFunctionLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(761)
+		p.SetState(803)
 		p.Match(FaultParserFUNC)
 	}
 	{
-		p.SetState(762)
+		p.SetState(804)
 		p.Block()
 	}
 
@@ -15139,7 +16090,7 @@ func (p *FaultParser) StateLit() (localctx IStateLitContext) {
 	_ = this
 
 	localctx = NewStateLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, FaultParserRULE_stateLit)
+	p.EnterRule(localctx, 138, FaultParserRULE_stateLit)
 
 	defer func() {
 		p.ExitRule()
@@ -15159,11 +16110,11 @@ func (p *FaultParser) StateLit() (localctx IStateLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(764)
+		p.SetState(806)
 		p.Match(FaultParserFUNC)
 	}
 	{
-		p.SetState(765)
+		p.SetState(807)
 		p.StateBlock()
 	}
 
@@ -15250,7 +16201,7 @@ func (p *FaultParser) Eos() (localctx IEosContext) {
 	_ = this
 
 	localctx = NewEosContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, FaultParserRULE_eos)
+	p.EnterRule(localctx, 140, FaultParserRULE_eos)
 
 	defer func() {
 		p.ExitRule()
@@ -15270,7 +16221,7 @@ func (p *FaultParser) Eos() (localctx IEosContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(767)
+		p.SetState(809)
 		p.Match(FaultParserSEMI)
 	}
 
@@ -15293,7 +16244,7 @@ func (p *FaultParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex i
 		}
 		return p.StateChange_Sempred(t, predIndex)
 
-	case 55:
+	case 57:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
@@ -15343,21 +16294,24 @@ func (p *FaultParser) Expression_Sempred(localctx antlr.RuleContext, predIndex i
 
 	switch predIndex {
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 7:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 5)
 
 	case 8:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+		return p.Precpred(p.GetParserRuleContext(), 4)
 
 	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 10:
 		return p.Precpred(p.GetParserRuleContext(), 1)
 
 	default:
@@ -291,6 +291,12 @@ func (s *BaseFaultParserListener) EnterAssumption(ctx *AssumptionContext) {}
 // ExitAssumption is called when production assumption is exited.
 func (s *BaseFaultParserListener) ExitAssumption(ctx *AssumptionContext) {}
 
+// EnterOptimize is called when production optimize is entered.
+func (s *BaseFaultParserListener) EnterOptimize(ctx *OptimizeContext) {}
+
+// ExitOptimize is called when production optimize is exited.
+func (s *BaseFaultParserListener) ExitOptimize(ctx *OptimizeContext) {}
+
 // EnterTemporal is called when production temporal is entered.
 func (s *BaseFaultParserListener) EnterTemporal(ctx *TemporalContext) {}
 
@@ -309,6 +315,12 @@ func (s *BaseFaultParserListener) EnterStageInvariant(ctx *StageInvariantContext
 // ExitStageInvariant is called when production stageInvariant is exited.
 func (s *BaseFaultParserListener) ExitStageInvariant(ctx *StageInvariantContext) {}
 
+// EnterWindow is called when production window is entered.
+func (s *BaseFaultParserListener) EnterWindow(ctx *WindowContext) {}
+
+// ExitWindow is called when production window is exited.
+func (s *BaseFaultParserListener) ExitWindow(ctx *WindowContext) {}
+
 // EnterMiscAssign is called when production MiscAssign is entered.
 func (s *BaseFaultParserListener) EnterMiscAssign(ctx *MiscAssignContext) {}
 
@@ -447,6 +459,18 @@ func (s *BaseFaultParserListener) EnterExprPrefix(ctx *ExprPrefixContext) {}
 // ExitExprPrefix is called when production ExprPrefix is exited.
 func (s *BaseFaultParserListener) ExitExprPrefix(ctx *ExprPrefixContext) {}
 
+// EnterTemporalPrefix is called when production temporalPrefix is entered.
+func (s *BaseFaultParserListener) EnterTemporalPrefix(ctx *TemporalPrefixContext) {}
+
+// ExitTemporalPrefix is called when production temporalPrefix is exited.
+func (s *BaseFaultParserListener) ExitTemporalPrefix(ctx *TemporalPrefixContext) {}
+
+// EnterTemporalInfix is called when production temporalInfix is entered.
+func (s *BaseFaultParserListener) EnterTemporalInfix(ctx *TemporalInfixContext) {}
+
+// ExitTemporalInfix is called when production temporalInfix is exited.
+func (s *BaseFaultParserListener) ExitTemporalInfix(ctx *TemporalInfixContext) {}
+
 // EnterLrExpr is called when production lrExpr is entered.
 func (s *BaseFaultParserListener) EnterLrExpr(ctx *LrExprContext) {}
 
@@ -459,6 +483,12 @@ func (s *BaseFaultParserListener) EnterOperand(ctx *OperandContext) {}
 // ExitOperand is called when production operand is exited.
 func (s *BaseFaultParserListener) ExitOperand(ctx *OperandContext) {}
 
+// EnterProbability is called when production probability is entered.
+func (s *BaseFaultParserListener) EnterProbability(ctx *ProbabilityContext) {}
+
+// ExitProbability is called when production probability is exited.
+func (s *BaseFaultParserListener) ExitProbability(ctx *ProbabilityContext) {}
+
 // EnterOpName is called when production OpName is entered.
 func (s *BaseFaultParserListener) EnterOpName(ctx *OpNameContext) {}
 
@@ -188,6 +188,10 @@ func (v *BaseFaultParserVisitor) VisitAssumption(ctx *AssumptionContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseFaultParserVisitor) VisitOptimize(ctx *OptimizeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseFaultParserVisitor) VisitTemporal(ctx *TemporalContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -200,6 +204,10 @@ func (v *BaseFaultParserVisitor) VisitStageInvariant(ctx *StageInvariantContext)
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseFaultParserVisitor) VisitWindow(ctx *WindowContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseFaultParserVisitor) VisitMiscAssign(ctx *MiscAssignContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -292,6 +300,14 @@ func (v *BaseFaultParserVisitor) VisitExprPrefix(ctx *ExprPrefixContext) interfa
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseFaultParserVisitor) VisitTemporalPrefix(ctx *TemporalPrefixContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseFaultParserVisitor) VisitTemporalInfix(ctx *TemporalInfixContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseFaultParserVisitor) VisitLrExpr(ctx *LrExprContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -300,6 +316,10 @@ func (v *BaseFaultParserVisitor) VisitOperand(ctx *OperandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseFaultParserVisitor) VisitProbability(ctx *ProbabilityContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseFaultParserVisitor) VisitOpName(ctx *OpNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -143,6 +143,9 @@ type FaultParserListener interface {
 	// EnterAssumption is called when entering the assumption production.
 	EnterAssumption(c *AssumptionContext)
 
+	// EnterOptimize is called when entering the optimize production.
+	EnterOptimize(c *OptimizeContext)
+
 	// EnterTemporal is called when entering the temporal production.
 	EnterTemporal(c *TemporalContext)
 
@@ -152,6 +155,9 @@ type FaultParserListener interface {
 	// EnterStageInvariant is called when entering the stageInvariant production.
 	EnterStageInvariant(c *StageInvariantContext)
 
+	// EnterWindow is called when entering the window production.
+	EnterWindow(c *WindowContext)
+
 	// EnterMiscAssign is called when entering the MiscAssign production.
 	EnterMiscAssign(c *MiscAssignContext)
 
@@ -221,12 +227,21 @@ type FaultParserListener interface {
 	// EnterExprPrefix is called when entering the ExprPrefix production.
 	EnterExprPrefix(c *ExprPrefixContext)
 
+	// EnterTemporalPrefix is called when entering the temporalPrefix production.
+	EnterTemporalPrefix(c *TemporalPrefixContext)
+
+	// EnterTemporalInfix is called when entering the temporalInfix production.
+	EnterTemporalInfix(c *TemporalInfixContext)
+
 	// EnterLrExpr is called when entering the lrExpr production.
 	EnterLrExpr(c *LrExprContext)
 
 	// EnterOperand is called when entering the operand production.
 	EnterOperand(c *OperandContext)
 
+	// EnterProbability is called when entering the probability production.
+	EnterProbability(c *ProbabilityContext)
+
 	// EnterOpName is called when entering the OpName production.
 	EnterOpName(c *OpNameContext)
 
@@ -407,6 +422,9 @@ type FaultParserListener interface {
 	// ExitAssumption is called when exiting the assumption production.
 	ExitAssumption(c *AssumptionContext)
 
+	// ExitOptimize is called when exiting the optimize production.
+	ExitOptimize(c *OptimizeContext)
+
 	// ExitTemporal is called when exiting the temporal production.
 	ExitTemporal(c *TemporalContext)
 
@@ -416,6 +434,9 @@ type FaultParserListener interface {
 	// ExitStageInvariant is called when exiting the stageInvariant production.
 	ExitStageInvariant(c *StageInvariantContext)
 
+	// ExitWindow is called when exiting the window production.
+	ExitWindow(c *WindowContext)
+
 	// ExitMiscAssign is called when exiting the MiscAssign production.
 	ExitMiscAssign(c *MiscAssignContext)
 
@@ -485,12 +506,21 @@ type FaultParserListener interface {
 	// ExitExprPrefix is called when exiting the ExprPrefix production.
 	ExitExprPrefix(c *ExprPrefixContext)
 
+	// ExitTemporalPrefix is called when exiting the temporalPrefix production.
+	ExitTemporalPrefix(c *TemporalPrefixContext)
+
+	// ExitTemporalInfix is called when exiting the temporalInfix production.
+	ExitTemporalInfix(c *TemporalInfixContext)
+
 	// ExitLrExpr is called when exiting the lrExpr production.
 	ExitLrExpr(c *LrExprContext)
 
 	// ExitOperand is called when exiting the operand production.
 	ExitOperand(c *OperandContext)
 
+	// ExitProbability is called when exiting the probability production.
+	ExitProbability(c *ProbabilityContext)
+
 	// ExitOpName is called when exiting the OpName production.
 	ExitOpName(c *OpNameContext)
 
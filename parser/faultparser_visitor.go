@@ -143,6 +143,9 @@ type FaultParserVisitor interface {
 	// Visit a parse tree produced by FaultParser#assumption.
 	VisitAssumption(ctx *AssumptionContext) interface{}
 
+	// Visit a parse tree produced by FaultParser#optimize.
+	VisitOptimize(ctx *OptimizeContext) interface{}
+
 	// Visit a parse tree produced by FaultParser#temporal.
 	VisitTemporal(ctx *TemporalContext) interface{}
 
@@ -152,6 +155,9 @@ type FaultParserVisitor interface {
 	// Visit a parse tree produced by FaultParser#stageInvariant.
 	VisitStageInvariant(ctx *StageInvariantContext) interface{}
 
+	// Visit a parse tree produced by FaultParser#window.
+	VisitWindow(ctx *WindowContext) interface{}
+
 	// Visit a parse tree produced by FaultParser#MiscAssign.
 	VisitMiscAssign(ctx *MiscAssignContext) interface{}
 
@@ -221,12 +227,21 @@ type FaultParserVisitor interface {
 	// Visit a parse tree produced by FaultParser#ExprPrefix.
 	VisitExprPrefix(ctx *ExprPrefixContext) interface{}
 
+	// Visit a parse tree produced by FaultParser#temporalPrefix.
+	VisitTemporalPrefix(ctx *TemporalPrefixContext) interface{}
+
+	// Visit a parse tree produced by FaultParser#temporalInfix.
+	VisitTemporalInfix(ctx *TemporalInfixContext) interface{}
+
 	// Visit a parse tree produced by FaultParser#lrExpr.
 	VisitLrExpr(ctx *LrExprContext) interface{}
 
 	// Visit a parse tree produced by FaultParser#operand.
 	VisitOperand(ctx *OperandContext) interface{}
 
+	// Visit a parse tree produced by FaultParser#probability.
+	VisitProbability(ctx *ProbabilityContext) interface{}
+
 	// Visit a parse tree produced by FaultParser#OpName.
 	VisitOpName(ctx *OpNameContext) interface{}
 
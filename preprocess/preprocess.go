@@ -40,24 +40,26 @@ func NewProcesser() *Processor {
 func Execute(l *listener.FaultListener) *Processor {
 	pre := NewProcesser()
 	pre.StructsPropertyOrder = l.StructsPropertyOrder
-	pre.Run(l.AST)
+	if _, err := pre.Run(l.AST); err != nil {
+		panic(err)
+	}
 	return pre
 }
 
-func (p *Processor) Run(n *ast.Spec) *ast.Spec {
+func (p *Processor) Run(n *ast.Spec) (*ast.Spec, error) {
 	tree, err := p.walk(n)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	p.initialPass = false
 
 	tree, err = p.walk(tree)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	spec := tree.(*ast.Spec)
 	p.Processed = spec
-	return spec
+	return spec, nil
 }
 
 func (p *Processor) Partial(spec string, node ast.Node) (ast.Node, error) {
@@ -156,20 +158,20 @@ func (p *Processor) attachElif(n *ast.IfExpression, el *ast.IfExpression) *ast.I
 	return n
 }
 
-func (p *Processor) formatIndex(n *ast.InfixExpression) string {
+func (p *Processor) formatIndex(n *ast.InfixExpression) (string, error) {
 	_, okR := n.Right.(*ast.Clock)
 	_, okL := n.Left.(*ast.Clock)
 
 	if okR && n.Operator == "-" {
-		panic("negative indexes not possible")
+		return "", ast.Errorf(n.Position(), "negative indexes not possible")
 	}
 
 	if (okR || okL) && n.Operator == "+" {
-		panic("index out of range")
+		return "", ast.Errorf(n.Position(), "index out of range")
 	}
 
 	if (okR || okL) && n.Operator == "*" {
-		panic("index out of range")
+		return "", ast.Errorf(n.Position(), "index out of range")
 	}
 
 	// If left and right are numeric it will evaluate
@@ -177,11 +179,11 @@ func (p *Processor) formatIndex(n *ast.InfixExpression) string {
 	// same node
 	val := ast.Evaluate(n)
 	if _, okF := val.(*ast.FloatLiteral); okF {
-		panic("index must be a whole number")
+		return "", ast.Errorf(n.Position(), "index must be a whole number")
 	}
 
 	if _, ok := val.(*ast.InfixExpression); !ok {
-		return val.String()
+		return val.String(), nil
 	}
 
 	var left, right string
@@ -203,7 +205,7 @@ func (p *Processor) formatIndex(n *ast.InfixExpression) string {
 	case ast.Nameable:
 		right = r.IdString()
 	}
-	return fmt.Sprintf("(%s %s %s)", left, inf.Operator, right)
+	return fmt.Sprintf("(%s %s %s)", left, inf.Operator, right), nil
 }
 
 func (p *Processor) walk(n ast.Node) (ast.Node, error) {
@@ -249,12 +251,15 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			return node, err
 		}
 
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 
 		// Has this already been defined?
-		_, err := spec.FetchConstant(node.Name.Value)
+		_, err = spec.FetchConstant(node.Name.Value)
 		if err == nil {
-			return node, fmt.Errorf("variable %s is a constant and cannot be modified", node.Name.Value)
+			return node, ast.Errorf(node.Position(), "variable %s is a constant and cannot be modified", node.Name.Value)
 		}
 
 		pronm, err := p.walk(node.Name)
@@ -298,7 +303,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		if _, ok := node.Value.(*ast.StringLiteral); ok || node.Value.TokenLiteral() == "COMPOUND_STRING" {
 			id := node.Name.Id()
-			spec := p.getSpec(id[0])
+			spec, err := p.getSpec(id[0])
+			if err != nil {
+				return n, err
+			}
 			spec.AddGlobal(id[1], node.Value)
 		}
 
@@ -317,7 +325,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		var properties map[string]ast.Node
 		var idx []string
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 
 		if p.initialPass {
 			node.Pairs, idx = p.namePairs(node.Pairs)
@@ -369,7 +380,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		var properties map[string]ast.Node
 		var idx []string
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 
 		if p.initialPass {
 			node.Pairs, idx = p.namePairs(node.Pairs)
@@ -421,7 +435,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		var properties map[string]ast.Node
 		var idx []string
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 
 		if p.initialPass {
 			node.Pairs, idx = p.namePairs(node.Pairs)
@@ -471,6 +488,13 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		}
 		node.Constraint = pro.(*ast.InvariantClause)
 		return node, err
+	case *ast.OptimizeStatement:
+		pro, err = p.walk(node.Target)
+		if err != nil {
+			return node, err
+		}
+		node.Target = pro.(ast.Expression)
+		return node, err
 	case *ast.InvariantClause:
 		l, err := p.walk(node.Left)
 		if err != nil {
@@ -544,7 +568,7 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		l, err := p.walk(node.Left)
 		if err != nil {
 			if node.Token.Type == "ASSIGN" {
-				return node, fmt.Errorf("illegal assignment %s", node.String())
+				return node, ast.Errorf(node.Position(), "illegal assignment %s", node.String())
 			}
 			return node, err
 		}
@@ -574,13 +598,16 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		switch ex := node.Index.(type) {
 		case *ast.InfixExpression:
 			rawid = node.Left.(ast.Nameable).RawId()
-			idx := p.formatIndex(ex)
+			idx, err := p.formatIndex(ex)
+			if err != nil {
+				return node, err
+			}
 			rawid = append(rawid, idx)
 		case *ast.IntegerLiteral:
 			rawid = node.Left.(ast.Nameable).RawId()
 			rawid = append(rawid, node.Index.String())
 		default:
-			panic("unsupported syntax for index")
+			return node, ast.Errorf(node.Position(), "unsupported syntax for index")
 		}
 		node.ProcessedName = rawid
 		return node, err
@@ -595,6 +622,49 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		return node, err
 
+	case *ast.TemporalPrefix:
+		r, err := p.walk(node.Right)
+		if err != nil {
+			return node, err
+		}
+		node.Right = r.(ast.Expression)
+
+		return node, err
+
+	case *ast.ProbabilityExpression:
+		ev, err := p.walk(node.Event)
+		if err != nil {
+			return node, err
+		}
+		node.Event = ev.(ast.Expression)
+
+		return node, err
+
+	case *ast.TemporalWindow:
+		r, err := p.walk(node.Right)
+		if err != nil {
+			return node, err
+		}
+		node.Right = r.(ast.Expression)
+
+		return node, err
+
+	case *ast.TemporalInfix:
+		l, err := p.walk(node.Left)
+		if err != nil {
+			return node, err
+		}
+
+		r, err := p.walk(node.Right)
+		if err != nil {
+			return node, err
+		}
+
+		node.Left = l.(ast.Expression)
+		node.Right = r.(ast.Expression)
+
+		return node, err
+
 	case *ast.IfExpression:
 		pro := &ast.IfExpression{}
 		cond, err := p.walk(node.Condition)
@@ -671,9 +741,15 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		}
 
 		var key string
-		importSpec := p.getSpec(node.Value.Spec) //Where the struct definition lives
+		importSpec, err := p.getSpec(node.Value.Spec) //Where the struct definition lives
+		if err != nil {
+			return n, err
+		}
 
-		spec := p.getSpec(p.trail.CurrentSpec()) //Where the instance is being declared
+		spec, err := p.getSpec(p.trail.CurrentSpec()) //Where the instance is being declared
+		if err != nil {
+			return n, err
+		}
 
 		if node.ComplexScope != "" {
 			key = strings.Join([]string{node.ComplexScope, node.Name}, "_")
@@ -705,7 +781,9 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 				return node, err
 			}
 
-			spec.AddInstance(key, reference, ty)
+			if err := spec.AddInstance(key, reference, ty); err != nil {
+				return n, err
+			}
 			properties, err = spec.FetchStock(key)
 			if err != nil {
 				return node, err
@@ -780,7 +858,9 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 				return node, err
 			}
 
-			spec.AddInstance(key, reference, ty)
+			if err := spec.AddInstance(key, reference, ty); err != nil {
+				return n, err
+			}
 			properties, err = spec.FetchFlow(key)
 			if err != nil {
 				return node, err
@@ -850,7 +930,7 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			p.scope = oldScope
 			return pro, err
 		default:
-			return node, fmt.Errorf("can't find an instance named %s", node.Value.Value)
+			return node, ast.Errorf(node.Position(), "can't find an instance named %s", node.Value.Value)
 		}
 	case *ast.StructInstance:
 		if p.Specs[p.trail.CurrentSpec()] == nil {
@@ -860,9 +940,15 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		order := node.Order
 
 		var key string
-		importSpec := p.getSpec(node.Parent[0]) //Where the struct definition lives
+		importSpec, err := p.getSpec(node.Parent[0]) //Where the struct definition lives
+		if err != nil {
+			return n, err
+		}
 
-		spec := p.getSpec(p.trail.CurrentSpec()) //Where the instance is being declared
+		spec, err := p.getSpec(p.trail.CurrentSpec()) //Where the instance is being declared
+		if err != nil {
+			return n, err
+		}
 
 		if node.ComplexScope != "" {
 			key = strings.Join([]string{node.ComplexScope, node.Name}, "_")
@@ -883,7 +969,9 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			if err != nil {
 				return node, err
 			}
-			spec.AddInstance(key, reference, ty)
+			if err := spec.AddInstance(key, reference, ty); err != nil {
+				return n, err
+			}
 
 			if len(node.Properties) > 0 {
 				properties = ast.ExtractBranches(node.Properties)
@@ -947,7 +1035,9 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 				return node, err
 			}
 
-			spec.AddInstance(key, reference, ty)
+			if err := spec.AddInstance(key, reference, ty); err != nil {
+				return n, err
+			}
 			if len(node.Properties) > 0 {
 				properties = ast.ExtractBranches(node.Properties)
 			} else {
@@ -1004,20 +1094,26 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 			return node, err
 		default:
-			return node, fmt.Errorf("can't find a struct instance named %s", node.Parent)
+			return node, ast.Errorf(node.Position(), "can't find a struct instance named %s", node.Parent)
 		}
 	case *ast.Identifier:
 		var spec *SpecRecord
 
 		// Check to see if this is a constant from
 		// an import
-		im := p.getSpec(node.Spec)
+		im, err := p.getSpec(node.Spec)
+		if err != nil {
+			return n, err
+		}
 		_, check := im.FetchConstant(node.Value)
 		_, check2 := im.FetchGlobal(node.Value)
 		if check == nil || check2 == nil {
 			spec = im
 		} else {
-			spec = p.getSpec(p.trail.CurrentSpec())
+			spec, err = p.getSpec(p.trail.CurrentSpec())
+			if err != nil {
+				return n, err
+			}
 		}
 		rawid := p.buildIdContext(spec.Id())
 
@@ -1043,7 +1139,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			return node, err
 		}
 
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 		rawid := p.buildIdContext(spec.Id())
 
 		rawid = append(rawid, node.Name.Value)
@@ -1073,10 +1172,16 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 		}
 
 		if p.inGlobal {
-			spec = p.getSpec(p.trail.CurrentSpec())
+			spec, err = p.getSpec(p.trail.CurrentSpec())
+			if err != nil {
+				return n, err
+			}
 			rawid = p.buildIdContext(p.trail.CurrentSpec())
 		} else {
-			spec = p.getSpec(node.Spec)
+			spec, err = p.getSpec(node.Spec)
+			if err != nil {
+				return n, err
+			}
 			rawid = p.buildIdContext(node.Spec)
 		}
 
@@ -1101,7 +1206,7 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 
 		branch, err := spec.FetchVar(rawid, ty)
 		if err != nil {
-			return node, err
+			return node, ast.Errorf(node.Position(), "%s", err)
 		}
 
 		// State charts tend to create endless loops by design
@@ -1158,7 +1263,10 @@ func (p *Processor) walk(n ast.Node) (ast.Node, error) {
 			return node, err
 		}
 
-		spec := p.getSpec(p.trail.CurrentSpec())
+		spec, err := p.getSpec(p.trail.CurrentSpec())
+		if err != nil {
+			return n, err
+		}
 		rawid := []string{spec.Id()}
 		rawid = append(rawid, p.scope, p.inState, node.Function)
 		node.FromState = p.inState
@@ -1201,7 +1309,7 @@ func (p *Processor) swapNode(node ast.Node) (ast.Node, error) {
 			return n, err
 		}
 		if n.Value[0] == "this" {
-			return nil, fmt.Errorf("incorrect left side value %s", n.Value[0])
+			return nil, ast.Errorf(n.Position(), "incorrect left side value %s", n.Value[0])
 		}
 
 		var rawid []string
@@ -1215,12 +1323,12 @@ func (p *Processor) swapNode(node ast.Node) (ast.Node, error) {
 	return p.walk(node)
 }
 
-func (p *Processor) getSpec(name string) *SpecRecord {
+func (p *Processor) getSpec(name string) (*SpecRecord, error) {
 	ret := p.Specs[name]
 	if ret == nil {
-		panic(fmt.Sprintf("no spec named %s", name))
+		return nil, fmt.Errorf("no spec named %s", name)
 	}
-	return ret
+	return ret, nil
 }
 
 func alreadyNamed(n1 []string, n2 []string) bool {
@@ -54,13 +54,13 @@ func (sr *SpecRecord) AddGlobal(name string, v ast.Node) {
 	sr.Globals[name] = v
 }
 
-func (sr *SpecRecord) AddInstance(name string, v map[string]ast.Node, ty string) {
+func (sr *SpecRecord) AddInstance(name string, v map[string]ast.Node, ty string) error {
 
 	// When creating an instance of a struct need to deep copy the data
 	v2, err := deepcopy.Anything(v)
 
 	if err != nil {
-		panic(fmt.Sprintf("failed to clone struct into instance %s", name))
+		return fmt.Errorf("failed to clone struct into instance %s", name)
 	}
 
 	switch ty {
@@ -71,6 +71,7 @@ func (sr *SpecRecord) AddInstance(name string, v map[string]ast.Node, ty string)
 	case "COMPONENT":
 		sr.AddComponent(name, v2.(map[string]ast.Node))
 	}
+	return nil
 }
 
 func (sr *SpecRecord) GetStructType(rawid []string) (string, []string) {
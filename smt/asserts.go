@@ -10,6 +10,10 @@ import (
 )
 
 func (g *Generator) parseAssert(a *ast.AssertionStatement) string {
+	if a.IsLTL() {
+		return g.parseLTL(a)
+	}
+
 	stateRange := a.Constraint.Operator == "then"
 	if stateRange && (a.TemporalFilter != "" || a.Temporal != "") {
 		panic("cannot mix temporal logic with when/then assertions")
@@ -460,6 +464,9 @@ func (g *Generator) flattenStates(sg *rules.StateGroup) ([]string, []int) {
 	var chains []int
 	for _, w := range sg.Wraps {
 		for i := 0; i <= g.Rounds; i++ {
+			if g.assertRounds != nil && !g.assertRounds(i) {
+				continue
+			}
 			if s, ok := w.States[i]; ok {
 				asserts = append(asserts, s.Values...)
 				chains = append(chains, s.Chain...)
@@ -471,6 +478,9 @@ func (g *Generator) flattenStates(sg *rules.StateGroup) ([]string, []int) {
 
 func (g *Generator) joinStates(sg *rules.StateGroup, operator string) string {
 	asserts, chains := g.flattenStates(sg)
+	if len(asserts) == 0 {
+		return ""
+	}
 	if len(asserts) == 1 {
 		return asserts[0]
 	}
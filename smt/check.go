@@ -0,0 +1,131 @@
+package smt
+
+import (
+	"fault/ast"
+	"strconv"
+)
+
+// Checks on the asserts and assumes before they're encoded.
+// Mistakes in the spec are reported here with their position
+// so that the encoders can take the expressions as well formed.
+
+func (g *Generator) checkAsserts() error {
+	for _, a := range append(g.compiledAsserts, g.compiledAssumes...) {
+		if a.Constraint.Operator == "then" && (a.TemporalFilter != "" || a.Temporal != "") {
+			return ast.Errorf(a.Position(), "cannot mix temporal logic with when/then assertions")
+		}
+		ltl := a.IsLTL()
+		if err := g.checkExpression(a.Constraint.Left, ltl); err != nil {
+			return err
+		}
+		if err := g.checkExpression(a.Constraint.Right, ltl); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range g.compiledProbs {
+		if err := g.checkExpression(a.Constraint.Left, true); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range g.objectives {
+		if err := g.checkExpression(o.Target, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkExpression looks for nodes the encoders can't handle and
+// indexes into states the variable never reaches. Temporal
+// formulas take fewer kinds of node than plain invariants.
+func (g *Generator) checkExpression(ex ast.Expression, ltl bool) error {
+	switch e := ex.(type) {
+	case *ast.InvariantClause:
+		if err := g.checkExpression(e.Left, ltl); err != nil {
+			return err
+		}
+		return g.checkExpression(e.Right, ltl)
+	case *ast.InfixExpression:
+		if err := g.checkExpression(e.Left, ltl); err != nil {
+			return err
+		}
+		return g.checkExpression(e.Right, ltl)
+	case *ast.TemporalInfix:
+		if err := g.checkExpression(e.Left, ltl); err != nil {
+			return err
+		}
+		return g.checkExpression(e.Right, ltl)
+	case *ast.PrefixExpression:
+		return g.checkExpression(e.Right, ltl)
+	case *ast.TemporalPrefix:
+		return g.checkExpression(e.Right, ltl)
+	case *ast.TemporalWindow:
+		if err := g.checkWindow(e); err != nil {
+			return err
+		}
+		return g.checkExpression(e.Right, ltl)
+	case *ast.ProbabilityExpression:
+		return g.checkExpression(e.Event, ltl)
+	case *ast.IndexExpression:
+		return g.checkIndex(e)
+	case *ast.AssertVar, *ast.IntegerLiteral, *ast.FloatLiteral, *ast.Boolean:
+		return nil
+	case *ast.StringLiteral, *ast.Nil:
+		if !ltl {
+			return nil
+		}
+		return ast.Errorf(e.Position(), "illegal node %T in temporal assert or assume", e)
+	case nil:
+		return nil
+	default:
+		return ast.Errorf(e.Position(), "illegal node %T in assert or assume", e)
+	}
+}
+
+// checkWindow rejects windows the run is too short for, they'd
+// never be due and the assert would hold whatever happens
+func (g *Generator) checkWindow(e *ast.TemporalWindow) error {
+	last := g.currentRound()
+	if last < 0 {
+		last = 0
+	}
+
+	end := e.To
+	if e.To < 0 {
+		end = e.From
+	}
+	if end > last {
+		return ast.Errorf(e.Position(), "%s window needs round %d but the last round is %d", e.Operator, end, last)
+	}
+	return nil
+}
+
+func (g *Generator) checkIndex(e *ast.IndexExpression) error {
+	v, ok := e.Left.(*ast.AssertVar)
+	if !ok {
+		return ast.Errorf(e.Position(), "illegal node %T in index", e.Left)
+	}
+
+	state, err := strconv.Atoi(e.Index.String())
+	if err != nil {
+		return ast.Errorf(e.Position(), "index %s is not a state", e.Index)
+	}
+
+	for _, base := range v.Instances {
+		if !g.hasState(base, state) {
+			return ast.Errorf(e.Position(), "state %d of variable %s is missing", state, base)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) hasState(base string, state int) bool {
+	for _, b := range g.RVarLookup[base] {
+		if b[0] == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package smt
+
+import (
+	"bytes"
+	"fault/ast"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Iterative deepening. Rather than one check over every round
+// the violations are split up by the round they happen in and
+// left out of the SMT. The model checker asks about round 0,
+// then round 1 and so on, and stops at the first round that
+// fails, so the scenario it finds is the shortest there is.
+// The rules and assumes are split up the same way, so each
+// check only adds the round it's about to the solver's context.
+
+func (g *Generator) newRoundAsserts(asserts []*ast.AssertionStatement) error {
+	for _, a := range asserts {
+		if a.Temporal != "" || a.TemporalFilter != "" || a.Constraint.Operator == "then" || a.IsLTL() {
+			return ast.Errorf(a.Position(), "iterative deepening checks one round at a time, assert uses temporal logic")
+		}
+	}
+
+	for r := 0; r <= g.currentRound(); r++ {
+		round := r
+		g.assertRounds = func(x int) bool { return x == round }
+
+		var violations []string
+		for idx, a := range asserts {
+			g.currentAssert = idx
+			if v := g.parseAssert(a); v != "" {
+				violations = append(violations, v)
+			}
+		}
+
+		switch len(violations) {
+		case 0: // Nothing the asserts check changes this round
+			g.Log.Rounds = append(g.Log.Rounds, "false")
+		case 1:
+			g.Log.Rounds = append(g.Log.Rounds, violations[0])
+		default:
+			g.Log.Rounds = append(g.Log.Rounds, fmt.Sprintf("(or %s)", strings.Join(violations, " ")))
+		}
+	}
+	g.assertRounds = nil
+	return nil
+}
+
+// splitRounds logs the rules and assumes under the last round
+// they mention. Anything that mentions no round, the
+// constants and schedules, goes in with round 0.
+func (g *Generator) splitRounds() {
+	g.Log.RoundRules = make([][]string, len(g.Log.Rounds))
+	for _, r := range append(append([]string{}, g.rules...), g.assumes...) {
+		round := g.roundOf(r)
+		g.Log.RoundRules[round] = append(g.Log.RoundRules[round], r)
+	}
+}
+
+func (g *Generator) roundOf(rule string) int {
+	last := 0
+	for _, id := range strings.FieldsFunc(rule, func(c rune) bool { return c == '(' || c == ')' || c == ' ' }) {
+		i := strings.LastIndex(id, "_")
+		if i < 1 {
+			continue
+		}
+		num, err := strconv.Atoi(id[i+1:])
+		if err != nil {
+			continue
+		}
+		for _, b := range g.RVarLookup[id[:i]] {
+			if b[0] == num && b[1] > last {
+				last = b[1]
+			}
+		}
+	}
+	if last >= len(g.Log.RoundRules) {
+		return len(g.Log.RoundRules) - 1
+	}
+	return last
+}
+
+// Prefix is the SMT without any rules, what deepening loads
+// before adding the rounds one at a time
+func (g *Generator) Prefix() string {
+	var out bytes.Buffer
+
+	out.WriteString(fmt.Sprintf("(set-logic %s)", g.logic()))
+	out.WriteString(strings.Join(g.inits, "\n"))
+	out.WriteString(strings.Join(g.constants, "\n"))
+
+	return out.String()
+}
@@ -61,6 +61,10 @@ func (f *Fork) MarkedForDeath(id string) bool {
 	return f.ToKill[id]
 }
 
+func (f *Fork) ClearMarks() {
+	f.ToKill = make(map[string]bool)
+}
+
 func (f *Fork) InBranch(branch string, id string) bool {
 	for _, v := range f.Branches[branch] {
 		if id == v {
@@ -28,20 +28,27 @@ type Generator struct {
 	branchId        int
 
 	// Raw input
-	Uncertains      map[string][]float64
+	Uncertains      map[string]*ast.Distribution
 	Unknowns        []string
 	functions       map[string]*ir.Func
 	compiledAsserts []*ast.AssertionStatement
 	compiledAssumes []*ast.AssertionStatement
 	rawAsserts      []*ast.AssertionStatement
 	rawAssumes      []*ast.AssertionStatement
+	compiledProbs   []*ast.AssertionStatement
+	rawProbs        []*ast.AssertionStatement
+	objectives      []*ast.OptimizeStatement
+	rawObjectives   []*ast.OptimizeStatement
 	rawRules        [][]rules.Rule
+	declared        map[string][]int // Where variables get their starting values, for naming terms
+	changed         map[string][]int // Where variables are first changed, for naming terms
 
 	// Generated SMT
 	inits     []string
 	constants []string
 	rules     []string
 	asserts   []string
+	assumes   []string
 
 	variables      *variables.VarData
 	blocks         map[string][]rules.Rule
@@ -53,6 +60,16 @@ type Generator struct {
 	parallelGrouping string
 	parallelRunStart bool            //Flag, make sure all branches with parallel runs begin from the same point
 	returnVoid       *forks.PhiState //Flag, escape parseFunc before moving to next block
+	schedules        int             // Parallel groups encoded with scheduler variables so far
+	assertRounds     func(int) bool  // Rounds asserts are checked in, all of them if nil
+	ltlTerms         map[*resultlog.Formula]int
+	ltlDeclared      map[string]bool
+
+	// Options, set before Run
+	SymbolicInterleaving bool // Let the solver pick the order of parallel steps instead of branching on every ordering
+	Induction            bool // Generate the inductive step of k-induction instead of a bounded run
+	Deepening            bool // Log the violations round by round instead of asserting them all at once
+	NamedTerms           bool // Name every assertion so an unsat result can be explained by its unsat core
 
 	Rounds     int
 	RoundVars  [][][]string
@@ -70,24 +87,37 @@ func NewGenerator() *Generator {
 		storedChoice:    make(map[string]rules.Rule),
 		currentFunction: "@__run",
 		Forks:           forks.InitFork(),
-		Uncertains:      make(map[string][]float64),
+		Uncertains:      make(map[string]*ast.Distribution),
 		inPhiState:      forks.NewPhiState(),
 		returnVoid:      forks.NewPhiState(),
 		Results:         make(map[string][]*variables.VarChange),
 		RVarLookup:      make(map[string][][]int),
 		Log:             resultlog.NewLog(),
+		ltlTerms:        make(map[*resultlog.Formula]int),
+		ltlDeclared:     make(map[string]bool),
 	}
 }
 
 func Execute(compiler *llvm.Compiler) *Generator {
 	generator := NewGenerator()
-	generator.LoadMeta(compiler)
-	generator.States = compiler.States
-	generator.Run(compiler.GetIR())
-	generator.LoadStringRules(compiler.StringRules) // Do last to get SSA values
+	err := generator.Generate(compiler)
+	if err != nil {
+		panic(err)
+	}
 	return generator
 }
 
+// Generate is Execute for a generator with options set
+func (g *Generator) Generate(compiler *llvm.Compiler) error {
+	g.LoadMeta(compiler)
+	g.States = compiler.States
+	if err := g.Run(compiler.GetIR()); err != nil {
+		return err
+	}
+	g.LoadStringRules(compiler.StringRules) // Do last to get SSA values
+	return nil
+}
+
 func (g *Generator) LoadStringRules(sr map[string]string) {
 	g.Log.StringRules = sr
 	for k := range sr {
@@ -99,7 +129,7 @@ func (g *Generator) LoadStringRules(sr map[string]string) {
 	}
 }
 
-func (g *Generator) LoadMeta(compiler *llvm.Compiler /*runs int16, uncertains map[string][]float64, unknowns []string, asserts []*ast.AssertionStatement, assumes []*ast.AssertionStatement*/) {
+func (g *Generator) LoadMeta(compiler *llvm.Compiler /*runs int16, uncertains map[string]*ast.Distribution, unknowns []string, asserts []*ast.AssertionStatement, assumes []*ast.AssertionStatement*/) {
 	if compiler.RunRound == 0 {
 		g.Rounds = 1 //even if runs are zero we need to generate asserts for initialization
 	} else {
@@ -112,15 +142,20 @@ func (g *Generator) LoadMeta(compiler *llvm.Compiler /*runs int16, uncertains ma
 	g.compiledAssumes = compiler.Assumes
 	g.rawAsserts = compiler.RawAsserts
 	g.rawAssumes = compiler.RawAssumes
+	g.compiledProbs = compiler.Probabilities
+	g.rawProbs = compiler.RawProbabilities
+	g.objectives = compiler.Objectives
+	g.rawObjectives = compiler.RawObjectives
+	g.declared = compiler.Declared
+	g.changed = compiler.Changed
 }
 
-func (g *Generator) Run(llopt string) {
+func (g *Generator) Run(llopt string) error {
 	m, err := asm.ParseString("", llopt) //"/" because ParseString has a path variable
 	if err != nil {
-		panic(err)
+		return err
 	}
-	g.newCallgraph(m)
-
+	return g.newCallgraph(m)
 }
 
 func (g *Generator) newRound() {
@@ -277,7 +312,7 @@ func (g *Generator) newConstants(globals []*ir.Global) []string {
 	for _, gl := range globals {
 		id := util.FormatIdent(gl.GlobalIdent.Ident())
 		if !g.variables.IsIndexed(id) && !g.variables.IsClocked(id) {
-			r = append(r, g.constantRule(id, gl.Init))
+			r = append(r, g.nameTerm(g.constantRule(id, gl.Init), "init", fmt.Sprintf("constant %s", id), g.declared[id]))
 		}
 	}
 	return r
@@ -287,7 +322,7 @@ func (g *Generator) newAssumes(asserts []*ast.AssertionStatement) {
 	for _, v := range asserts {
 		a := g.parseAssert(v)
 		rule := g.writeAssert("", a)
-		g.asserts = append(g.asserts, rule)
+		g.assumes = append(g.assumes, g.nameTerm(rule, "assume", describeAssert(v), v.Position()))
 	}
 }
 
@@ -304,9 +339,11 @@ func (g *Generator) newAsserts(asserts []*ast.AssertionStatement) {
 	}
 
 	if len(arule) > 1 {
-		g.asserts = append(g.asserts, g.writeAssert("or", strings.Join(arule, "")))
+		g.Log.Violation = g.writeAssertlessRule("or", strings.Join(arule, ""), "")
+		g.asserts = append(g.asserts, g.nameTerm(g.writeAssert("or", strings.Join(arule, "")), "assert", describeAsserts(asserts), nil))
 	} else {
-		g.asserts = append(g.asserts, g.writeAssert("", arule[0]))
+		g.Log.Violation = arule[0]
+		g.asserts = append(g.asserts, g.nameTerm(g.writeAssert("", arule[0]), "assert", describeAssert(asserts[0]), asserts[0].Position()))
 	}
 }
 
@@ -324,7 +361,7 @@ func (g *Generator) sortFuncs(funcs []*ir.Func) {
 	}
 }
 
-func (g *Generator) newCallgraph(m *ir.Module) {
+func (g *Generator) newCallgraph(m *ir.Module) error {
 	g.constants = g.newConstants(m.Globals)
 	g.sortFuncs(m.Funcs)
 
@@ -333,10 +370,30 @@ func (g *Generator) newCallgraph(m *ir.Module) {
 
 	g.rules = append(g.rules, g.generateRules()...)
 
+	if err := g.checkAsserts(); err != nil {
+		return err
+	}
+
 	g.processAsserts()
-	g.newAsserts(g.compiledAsserts)
+	var err error
+	if g.Induction {
+		err = g.newInductiveAsserts(g.compiledAsserts)
+	} else if g.Deepening {
+		err = g.newRoundAsserts(g.compiledAsserts)
+	} else {
+		g.newAsserts(g.compiledAsserts)
+	}
+	if err != nil {
+		return err
+	}
 	g.newAssumes(g.compiledAssumes)
-
+	if g.Deepening {
+		g.splitRounds()
+	}
+	if err := g.newProbabilities(); err != nil {
+		return err
+	}
+	return g.newObjectives()
 }
 
 func (g *Generator) generateFromCallstack(callstack []string) []rules.Rule {
@@ -344,11 +401,24 @@ func (g *Generator) generateFromCallstack(callstack []string) []rules.Rule {
 		return []rules.Rule{}
 	}
 
+	if len(callstack) > 1 && g.SymbolicInterleaving {
+		return g.runScheduled(callstack)
+	}
+
 	if len(callstack) > 1 {
-		//Generate parallel runs
+		//Generate parallel runs, skipping orderings
+		// that can't change the outcome
+		perm := g.reducedPermutations(callstack)
+		if len(perm) > 1 {
+			return g.runParallel(perm)
+		}
 
-		perm := g.parallelPermutations(callstack)
-		return g.runParallel(perm)
+		// Every step commutes, no need to branch
+		var ru []rules.Rule
+		for _, fname := range perm[0] {
+			ru = append(ru, g.parseFunction(g.functions[fname])...)
+		}
+		return ru
 	} else {
 		fname := callstack[0]
 		v := g.functions[fname]
@@ -358,6 +428,11 @@ func (g *Generator) generateFromCallstack(callstack []string) []rules.Rule {
 
 func (g *Generator) processAsserts() {
 	for i, a := range g.rawAsserts {
+		if a.IsLTL() { // Not negated, nothing to swap back
+			g.Log.ProcessedAsserts = append(g.Log.ProcessedAsserts, a)
+			continue
+		}
+
 		if l, ok := a.Constraint.Left.(*ast.PrefixExpression); ok {
 			l.Right = g.compiledAsserts[i].Constraint.Left
 			a.Constraint.Left = l
@@ -1197,6 +1272,50 @@ func (g *Generator) isASolvable(id string) bool {
 	return false
 }
 
+func (g *Generator) isABernoulli(id string) bool {
+	if !strings.HasSuffix(id, "_0") {
+		return false
+	}
+	d, ok := g.Uncertains[strings.TrimSuffix(id, "_0")]
+	return ok && d.Kind == "bernoulli"
+}
+
+// declareUncertain declares a free value, as an Int if it's drawn
+// from a count distribution, and keeps it inside the bounds of a
+// truncated distribution.
+func (g *Generator) declareUncertain(id string, ty string) string {
+	d, ok := g.Uncertains[strings.TrimSuffix(id, "_0")]
+	if !ok || !strings.HasSuffix(id, "_0") {
+		g.declareVar(id, ty)
+		return ""
+	}
+
+	if d.Kind == "poisson" {
+		ty = "Int"
+	}
+	g.declareVar(id, ty)
+
+	if !d.Truncated {
+		return ""
+	}
+	return g.writeAssert("<=", fmt.Sprintf("%s %s %s", boundLiteral(d.Low, ty), id, boundLiteral(d.High, ty)))
+}
+
+// boundLiteral writes a truncation bound in the sort of the value
+func boundLiteral(v float64, ty string) string {
+	if ty == "Int" {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s = s + ".0"
+	}
+	if v < 0 {
+		return fmt.Sprintf("(- %s)", s[1:])
+	}
+	return s
+}
+
 func (g *Generator) allStateChangesInRule(ru rules.Rule, lasts map[string]int) ([]string, map[string]int) {
 	var wg []string
 	switch r := ru.(type) {
@@ -1297,6 +1416,10 @@ func (g *Generator) writeRule(ru rules.Rule) string {
 		x := g.unpackRule(r.X)
 
 		if y == "0x3DA3CA8CB153A753" { //An uncertain or unknown value
+			return g.declareUncertain(x, r.Ty)
+		}
+
+		if r.Ty == "Bool" && g.isABernoulli(x) { //Boolean uncertain values are left free
 			g.declareVar(x, r.Ty)
 			return ""
 		}
@@ -1436,7 +1559,10 @@ func (g *Generator) generateRules() []string {
 	var rules []string
 	for _, v := range g.rawRules {
 		for _, ru := range v {
-			rules = append(rules, g.writeRule(ru))
+			if g.Induction && g.freeStart(ru) {
+				continue
+			}
+			rules = append(rules, g.nameRule(ru, g.writeRule(ru)))
 		}
 	}
 	return rules
@@ -1813,11 +1939,39 @@ func (g *Generator) tempRule(inst value.Value, r rules.Rule) {
 func (g *Generator) SMT() string {
 	var out bytes.Buffer
 
-	out.WriteString("(set-logic QF_NRA)")
+	if g.NamedTerms {
+		out.WriteString("(set-option :produce-unsat-cores true)")
+	}
+	out.WriteString(fmt.Sprintf("(set-logic %s)", g.logic()))
+	out.WriteString(strings.Join(g.inits, "\n"))
+	out.WriteString(strings.Join(g.constants, "\n"))
+	out.WriteString(strings.Join(g.rules, "\n"))
+	out.WriteString(strings.Join(append(append([]string{}, g.asserts...), g.assumes...), "\n"))
+
+	return out.String()
+}
+
+// logic is QF_NRA unless something is declared an Int
+// (scheduling positions, count distributions).
+func (g *Generator) logic() string {
+	for _, d := range g.inits {
+		if strings.HasSuffix(d, "() Int)") {
+			return "QF_NIRA"
+		}
+	}
+	return "QF_NRA"
+}
+
+// Model is the SMT without the asserts, every run the spec
+// allows. prob() asserts are checked against it.
+func (g *Generator) Model() string {
+	var out bytes.Buffer
+
+	out.WriteString(fmt.Sprintf("(set-logic %s)", g.logic()))
 	out.WriteString(strings.Join(g.inits, "\n"))
 	out.WriteString(strings.Join(g.constants, "\n"))
 	out.WriteString(strings.Join(g.rules, "\n"))
-	out.WriteString(strings.Join(g.asserts, "\n"))
+	out.WriteString(strings.Join(g.assumes, "\n"))
 
 	return out.String()
 }
@@ -129,7 +129,10 @@ func prepLogTest(filepath string, test string, specType bool, testRun bool) *Gen
 	pre := preprocess.Execute(l)
 	ty := types.Execute(pre.Processed, pre)
 	sw := swaps.NewPrecompiler(ty)
-	tree := sw.Swap(ty.Checked)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		panic(err)
+	}
 	compiler := llvm.Execute(tree, ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true)
 
 	//fmt.Println(compiler.GetIR())
@@ -1,6 +1,7 @@
 package smt
 
 import (
+	"fault/ast"
 	"fault/listener"
 	"fault/llvm"
 	"fault/preprocess"
@@ -479,6 +480,542 @@ func TestTestData(t *testing.T) {
 	}
 }
 
+func TestReducedPermutations(t *testing.T) {
+	data, err := os.ReadFile("testdata/bathtub.fspec")
+	if err != nil {
+		panic("spec testdata/bathtub.fspec is not valid")
+	}
+	g := prepTest("testdata/bathtub.fspec", string(data), true, false)
+
+	// Different stocks, order doesn't matter
+	perm := g.reducedPermutations([]string{"@bathtub_pipe_out", "@bathtub_drawn_in"})
+	if len(perm) != 1 || perm[0][0] != "@bathtub_pipe_out" || perm[0][1] != "@bathtub_drawn_in" {
+		t.Fatalf("independent steps not reduced to their written order. got=%s", perm)
+	}
+
+	perm = g.reducedPermutations([]string{"@bathtub_drawn_in", "@bathtub_drawn_in"})
+	if len(perm) != 1 {
+		t.Fatalf("repeated step not reduced to one ordering. got=%s", perm)
+	}
+
+	data, err = os.ReadFile("testdata/bathtub2.fspec")
+	if err != nil {
+		panic("spec testdata/bathtub2.fspec is not valid")
+	}
+	g = prepTest("testdata/bathtub2.fspec", string(data), true, false)
+
+	// Same stock, both orders have to be checked
+	perm = g.reducedPermutations([]string{"@bathtub_drawn_in", "@bathtub_drawn_out"})
+	if len(perm) != 2 {
+		t.Fatalf("conflicting steps reduced. got=%s", perm)
+	}
+}
+
+func TestSymbolicInterleaving(t *testing.T) {
+	data, err := os.ReadFile("testdata/bathtub2.fspec")
+	if err != nil {
+		panic("spec testdata/bathtub2.fspec is not valid")
+	}
+	expecting, err := os.ReadFile("testdata/bathtub2_scheduled.smt2")
+	if err != nil {
+		panic("compiled spec testdata/bathtub2_scheduled.smt2 is not valid")
+	}
+
+	g := NewGenerator()
+	g.SymbolicInterleaving = true
+	if err := g.Generate(prepCompiler("testdata/bathtub2.fspec", string(data), true, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	err = compareResults("testdata/bathtub2.fspec", g.SMT(), string(expecting))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	if len(g.Forks.Choices) != 0 {
+		t.Fatalf("scheduled parallel runs should not branch. got=%d choices", len(g.Forks.Choices))
+	}
+
+	var schedules int
+	for _, e := range g.Log.Events {
+		if e.Type == "SCHEDULE" {
+			schedules++
+		}
+	}
+	if schedules != 3 || len(g.Log.Schedules) != 3 {
+		t.Fatalf("wrong number of schedules logged. got=%d events %d schedules", schedules, len(g.Log.Schedules))
+	}
+
+	s := g.Log.Schedules["__schedule_0"]
+	if len(s.Steps) != 2 || s.Steps[0] != "bathtub_drawn_in" || s.Vars[1] != "__schedule_0_1" {
+		t.Fatalf("schedule not logged correctly. got=%s %s", s.Steps, s.Vars)
+	}
+}
+
+func TestScheduleSingleWriter(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 0,
+		b: 0,
+	};
+	def f = flow{
+		data: new s,
+		copy: func{
+			data.b <- data.a;
+		},
+		inc: func{
+			data.a <- data.a + 10;
+		},
+	};
+	assert s.b < 10;
+	for 1 init{x = new f;} run {
+		x.copy | x.inc;
+	}`
+
+	g := NewGenerator()
+	g.SymbolicInterleaving = true
+	if err := g.Generate(prepCompiler("", test, true, false)); err != nil {
+		t.Fatal(err)
+	}
+	smt := g.SMT()
+
+	for _, rule := range []string{
+		// copy reads inc's output only if inc ran first
+		"(= test1_x_data_a_1 (ite (< __schedule_0_1 __schedule_0_0) test1_x_data_a_3 test1_x_data_a_0))",
+		// inc reads the value going into the group, not its own output
+		"(= test1_x_data_a_2 test1_x_data_a_0)",
+		"(= test1_x_data_a_3 (+ test1_x_data_a_2 (+ test1_x_data_a_2 10.0)))",
+		// and the group ends on inc's output
+		"(= test1_x_data_a_4 test1_x_data_a_3)",
+	} {
+		if !strings.Contains(smt, rule) {
+			t.Fatalf("schedule glue missing %s. got=%s", rule, smt)
+		}
+	}
+}
+
+func TestInduction(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assert s.a >= 0;
+	for 2 init{l = new f;} run {
+		l.fn;
+	}`
+
+	g := NewGenerator()
+	g.Induction = true
+	if err := g.Generate(prepCompiler("", test, true, false)); err != nil {
+		t.Fatal(err)
+	}
+	smt := g.SMT()
+
+	if strings.Contains(smt, "(= test1_l_data_a_0 30.0)") {
+		t.Fatalf("inductive step kept the starting value. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(= test1_l_data_b_0 2.0)") {
+		t.Fatalf("inductive step dropped a value the run never changes. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(assert (not (or (< test1_l_data_a_0 0) (< test1_l_data_a_1 0))))") {
+		t.Fatalf("asserts not assumed before the last round. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(assert (< test1_l_data_a_2 0))") {
+		t.Fatalf("asserts not checked in the last round. got=%s", smt)
+	}
+}
+
+func TestDeepening(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assert s.a >= 0;
+	for 2 init{l = new f;} run {
+		l.fn;
+	}`
+
+	g := NewGenerator()
+	g.Deepening = true
+	if err := g.Generate(prepCompiler("", test, true, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(g.SMT(), "(assert (< test1_l_data_a") {
+		t.Fatalf("violations asserted all at once. got=%s", g.SMT())
+	}
+
+	expected := []string{"(or (< test1_l_data_a_0 0) (< test1_l_data_a_1 0))", "(< test1_l_data_a_2 0)"}
+	if len(g.Log.Rounds) != len(expected) {
+		t.Fatalf("wrong number of rounds logged. got=%s", g.Log.Rounds)
+	}
+	for i, e := range expected {
+		if g.Log.Rounds[i] != e {
+			t.Fatalf("round %d not correct. want=%s got=%s", i, e, g.Log.Rounds[i])
+		}
+	}
+
+	if strings.Contains(g.Prefix(), "(assert") {
+		t.Fatalf("rules loaded before their round. got=%s", g.Prefix())
+	}
+
+	rules := []string{
+		"(assert (= test1_l_data_a_1 (+ test1_l_data_a_0 (- test1_l_data_a_0 test1_l_data_b_0))))(assert (= test1_l_data_a_0 30.0))(assert (= test1_l_data_b_0 2.0))",
+		"(assert (= test1_l_data_a_2 (+ test1_l_data_a_1 (- test1_l_data_a_1 test1_l_data_b_0))))",
+	}
+	if len(g.Log.RoundRules) != len(rules) {
+		t.Fatalf("wrong number of rounds of rules. got=%s", g.Log.RoundRules)
+	}
+	for i, r := range rules {
+		if got := strings.Join(g.Log.RoundRules[i], ""); got != r {
+			t.Fatalf("rules of round %d not correct. want=%s got=%s", i, r, got)
+		}
+	}
+}
+
+func TestViolation(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assert s.a >= 0;
+	assert s.b < 5;
+	for 1 init{l = new f;} run {
+		l.fn;
+	}`
+
+	g := NewGenerator()
+	if err := g.Generate(prepCompiler("", test, true, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "(or (or (< test1_l_data_a_0 0) (< test1_l_data_a_1 0))(>= test1_l_data_b_0 5))"
+	if g.Log.Violation != expected {
+		t.Fatalf("violation not logged. want=%s got=%s", expected, g.Log.Violation)
+	}
+
+	if !strings.Contains(g.SMT(), fmt.Sprintf("(assert %s)", expected)) {
+		t.Fatalf("logged violation is not the one asserted. got=%s", g.SMT())
+	}
+}
+
+func TestNamedTerms(t *testing.T) {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assume s.b > 3;
+	assert s.a >= 0;
+	for 1 init{l = new f;} run {
+		l.fn;
+	}`
+
+	g := NewGenerator()
+	g.NamedTerms = true
+	if err := g.Generate(prepCompiler("", test, true, false)); err != nil {
+		t.Fatal(err)
+	}
+	smt := g.SMT()
+
+	for _, e := range []string{
+		"(set-option :produce-unsat-cores true)(set-logic QF_NRA)",
+		"(assert (! (= test1_l_data_a_0 30.0) :named __init_0))",
+		"(assert (! (> test1_l_data_b_0 3) :named __assume_4))",
+	} {
+		if !strings.Contains(smt, e) {
+			t.Fatalf("named term missing %s. got=%s", e, smt)
+		}
+	}
+
+	if i := g.Log.Terms["__init_0"]; i == nil || i.Kind != "init" || i.Line != 3 {
+		t.Fatalf("init term not correct. got=%+v", i)
+	}
+
+	if r := g.Log.Terms["__rule_2"]; r == nil || r.Kind != "rule" || r.Line != 9 || r.Desc != "change to test1_l_data_a (state test1_l_data_a_1)" {
+		t.Fatalf("rule term not correct. got=%+v", r)
+	}
+
+	a := g.Log.Terms["__assume_4"]
+	if a == nil || a.Kind != "assume" || a.Line != 12 || a.Desc != "assume (test1_l_data_b) > 3;" {
+		t.Fatalf("assume term not correct. got=%+v", a)
+	}
+
+	if a := g.Log.Terms["__assert_3"]; a == nil || a.Kind != "assert" || a.Line != 13 {
+		t.Fatalf("assert term not correct. got=%+v", a)
+	}
+}
+
+// The LTL tests rewrite a plain assert before it's compiled so
+// the encoding is checked apart from the parser
+func prepLTL(rewrite func(*ast.InvariantClause) *ast.InvariantClause) *Generator {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assert s.a > 25 || s.b < 27;
+	for 3 init{l = new f;} run {
+		l.fn;
+	}`
+
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	for _, st := range l.AST.Statements {
+		if a, ok := st.(*ast.AssertionStatement); ok {
+			a.Constraint = rewrite(a.Constraint)
+		}
+	}
+
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre)
+	sw := swaps.NewPrecompiler(ty)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		panic(err)
+	}
+	return Execute(llvm.Execute(tree, ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true))
+}
+
+func TestLTLUntil(t *testing.T) {
+	g := prepLTL(func(c *ast.InvariantClause) *ast.InvariantClause {
+		c.Operator = "until"
+		return c
+	})
+	smt := g.SMT()
+
+	for _, e := range []string{
+		"(assert (= __ltl_0_2 (< test1_l_data_b_0 27)))",
+		"(assert (= __ltl_0_1 (or (< test1_l_data_b_0 27) (and (> test1_l_data_a_2 25) __ltl_0_2))))",
+		"(assert (not __ltl_0_0))",
+	} {
+		if !strings.Contains(smt, e) {
+			t.Fatalf("until not encoded correctly, missing %s. got=%s", e, smt)
+		}
+	}
+
+	f := g.Log.Temporal[0]
+	if f == nil || f.Op != "until" || f.Rounds() != 3 {
+		t.Fatalf("temporal assert not logged. got=%s", f)
+	}
+}
+
+func TestLTLNested(t *testing.T) {
+	g := prepLTL(func(c *ast.InvariantClause) *ast.InvariantClause {
+		c.Left = &ast.TemporalPrefix{Token: c.Token, Operator: "next", Right: c.Left}
+		c.Operator = "&&"
+		return c
+	})
+	smt := g.SMT()
+
+	for _, e := range []string{
+		"(assert (= __ltl_0_0 (> test1_l_data_a_2 25)))",
+		"(assert (= __ltl_0_2 false))",
+		"(assert (not (and __ltl_0_0 (< test1_l_data_b_0 27))))",
+	} {
+		if !strings.Contains(smt, e) {
+			t.Fatalf("nested next not encoded correctly, missing %s. got=%s", e, smt)
+		}
+	}
+}
+
+func TestLTLWhenThen(t *testing.T) {
+	g := prepLTL(func(c *ast.InvariantClause) *ast.InvariantClause {
+		return &ast.InvariantClause{
+			Token:    c.Token,
+			Left:     c.Left,
+			Operator: "then",
+			Right:    &ast.TemporalInfix{Token: c.Token, Left: c.Left, Operator: "release", Right: c.Right},
+		}
+	})
+	smt := g.SMT()
+
+	// always (a => (a release b)), the release is term 1
+	for _, e := range []string{
+		"(assert (= __ltl_1_2 (< test1_l_data_b_0 27)))",
+		"(assert (= __ltl_0_2 (=> (> test1_l_data_a_3 25) __ltl_1_2)))",
+		"(assert (= __ltl_0_0 (and (=> (> test1_l_data_a_1 25) __ltl_1_0) __ltl_0_1)))",
+	} {
+		if !strings.Contains(smt, e) {
+			t.Fatalf("when/then not encoded correctly, missing %s. got=%s", e, smt)
+		}
+	}
+}
+
+func TestLTLWithin(t *testing.T) {
+	g := prepLTL(func(c *ast.InvariantClause) *ast.InvariantClause {
+		return &ast.InvariantClause{
+			Token:    c.Token,
+			Left:     c.Left,
+			Operator: "then",
+			Right:    &ast.TemporalWindow{Token: c.Token, Operator: "between", From: 1, To: 2, Right: c.Right},
+		}
+	})
+	smt := g.SMT()
+
+	// The windows opened in rounds 1 and 2 close after the run
+	for _, e := range []string{
+		"(assert (= __ltl_0_2 (=> (> test1_l_data_a_3 25) true)))",
+		"(assert (= __ltl_0_0 (and (=> (> test1_l_data_a_1 25) (or (< test1_l_data_b_0 27) (< test1_l_data_b_0 27))) __ltl_0_1)))",
+	} {
+		if !strings.Contains(smt, e) {
+			t.Fatalf("window not encoded correctly, missing %s. got=%s", e, smt)
+		}
+	}
+}
+
+func TestProbability(t *testing.T) {
+	g := prepLTL(func(c *ast.InvariantClause) *ast.InvariantClause {
+		return &ast.InvariantClause{
+			Token:    c.Token,
+			Left:     &ast.FloatLiteral{Token: c.Token, Value: 0.01},
+			Operator: ">",
+			Right:    &ast.ProbabilityExpression{Token: c.Token, Event: c.Left},
+		}
+	})
+
+	if len(g.Log.Probabilities) != 1 {
+		t.Fatalf("prob() assert not logged. got=%d", len(g.Log.Probabilities))
+	}
+
+	p := g.Log.Probabilities[0]
+	if p.Op != "<" || p.Bound != 0.01 {
+		t.Fatalf("bound not moved to the right. got=%s %v", p.Op, p.Bound)
+	}
+
+	if p.Event != "__ltl_0_0" {
+		t.Fatalf("event not encoded as eventually. got=%s", p.Event)
+	}
+
+	for _, e := range []string{
+		"(assert (= __ltl_0_2 (> test1_l_data_a_3 25)))",
+		"(assert (= __ltl_0_0 (or (> test1_l_data_a_1 25) __ltl_0_1)))",
+	} {
+		if !strings.Contains(g.Model(), e) {
+			t.Fatalf("event not encoded correctly, missing %s. got=%s", e, g.Model())
+		}
+	}
+
+	if strings.Contains(g.SMT(), "(assert (not") || len(g.Log.ProcessedAsserts) != 0 {
+		t.Fatalf("prob() assert should not be checked by the solver. got=%s", g.SMT())
+	}
+}
+
+func TestBernoulli(t *testing.T) {
+	test := `spec test1;
+
+	def st = stock{
+		up: uncertain(bernoulli, 0.9),
+		level: 10,
+	};
+
+	def fl = flow{
+		vault: new st,
+		fn: func{
+			if vault.up {
+				vault.level = vault.level + 1;
+			}else{
+				vault.level = vault.level - 5;
+			}
+		},
+	};
+
+	for 1 init{l = new fl;} run {
+		l.fn;
+	}
+	`
+
+	g := prepTest("", test, true, false)
+	smt := g.SMT()
+	if !strings.Contains(smt, "(declare-fun test1_l_vault_up_0 () Bool)") {
+		t.Fatalf("bernoulli value not declared as a boolean. got=%s", smt)
+	}
+
+	if strings.Contains(smt, "(assert (= test1_l_vault_up_0") {
+		t.Fatalf("bernoulli value should be left to the solver. got=%s", smt)
+	}
+}
+
+func TestTruncatedUncertain(t *testing.T) {
+	test := `spec test1;
+
+	def st = stock{
+		arrivals: uncertain(poisson, 4, 1, 10),
+		delay: uncertain(normal, 2, 1, -0.5, 3),
+		level: 10,
+	};
+
+	def fl = flow{
+		vault: new st,
+		fn: func{
+			vault.level = vault.level + vault.arrivals - vault.delay;
+		},
+	};
+
+	for 1 init{l = new fl;} run {
+		l.fn;
+	}
+	`
+
+	g := prepTest("", test, true, false)
+	smt := g.SMT()
+	if !strings.Contains(smt, "(set-logic QF_NIRA)") {
+		t.Fatalf("logic does not allow integers. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(declare-fun test1_l_vault_arrivals_0 () Int)") {
+		t.Fatalf("poisson value not declared as an integer. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(assert (<= 1 test1_l_vault_arrivals_0 10))") {
+		t.Fatalf("poisson value not kept inside its bounds. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(declare-fun test1_l_vault_delay_0 () Real)") {
+		t.Fatalf("normal value not declared as a real. got=%s", smt)
+	}
+
+	if !strings.Contains(smt, "(assert (<= (- 0.5) test1_l_vault_delay_0 3.0))") {
+		t.Fatalf("normal value not kept inside its bounds. got=%s", smt)
+	}
+}
+
 func TestSys(t *testing.T) {
 	specs := [][]string{
 		{"testdata/statecharts/statechart.fsystem", "0"},
@@ -600,6 +1137,10 @@ func stripAndEscape(str string) string {
 }
 
 func prepTest(filepath string, test string, specType bool, testRun bool) *Generator {
+	return Execute(prepCompiler(filepath, test, specType, testRun))
+}
+
+func prepCompiler(filepath string, test string, specType bool, testRun bool) *llvm.Compiler {
 	flags := make(map[string]bool)
 	flags["specType"] = specType
 	flags["testing"] = testRun
@@ -615,12 +1156,14 @@ func prepTest(filepath string, test string, specType bool, testRun bool) *Genera
 	pre := preprocess.Execute(l)
 	ty := types.Execute(pre.Processed, pre)
 	sw := swaps.NewPrecompiler(ty)
-	tree := sw.Swap(ty.Checked)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		panic(err)
+	}
 	compiler := llvm.Execute(tree, ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true)
 
 	//fmt.Println(compiler.GetIR())
-	generator := Execute(compiler)
-	return generator
+	return compiler
 }
 
 func notStrictlyOrdered(want string, got string) bool {
@@ -0,0 +1,71 @@
+package smt
+
+import (
+	"fault/ast"
+	"fault/smt/rules"
+	"fault/util"
+	"strings"
+)
+
+// The inductive step of k-induction. The run starts from any
+// state at all (only values the run never changes keep their
+// initial value), the asserts are assumed to hold in every
+// round but the last and are only checked in the last round.
+// If that's unsat no run of k good rounds can be followed by a
+// bad one, so together with a k round bounded check that
+// found nothing the asserts hold however long the model runs.
+
+func (g *Generator) newInductiveAsserts(asserts []*ast.AssertionStatement) error {
+	last := g.currentRound()
+
+	var violations []string
+	for idx, a := range asserts {
+		if a.Temporal != "" || a.TemporalFilter != "" || a.Constraint.Operator == "then" || a.IsLTL() {
+			return ast.Errorf(a.Position(), "k-induction can only prove invariants, assert uses temporal logic")
+		}
+		g.currentAssert = idx
+
+		g.assertRounds = func(r int) bool { return r < last }
+		if held := g.parseAssert(a); held != "" {
+			g.asserts = append(g.asserts, g.writeAssert("not", held))
+		}
+
+		g.assertRounds = func(r int) bool { return r == last }
+		if v := g.parseAssert(a); v != "" {
+			violations = append(violations, v)
+		}
+	}
+	g.assertRounds = nil
+
+	switch len(violations) {
+	case 0: // Nothing changes in the last round, nothing can break
+		g.asserts = append(g.asserts, g.writeAssert("", "false"))
+	case 1:
+		g.asserts = append(g.asserts, g.writeAssert("", violations[0]))
+	default:
+		g.asserts = append(g.asserts, g.writeAssert("or", strings.Join(violations, "")))
+	}
+	return nil
+}
+
+// freeStart declares the initial value of a variable the run
+// changes without setting it
+func (g *Generator) freeStart(ru rules.Rule) bool {
+	r, ok := ru.(*rules.Infix)
+	if !ok || (r.Op != "" && r.Op != "=") {
+		return false
+	}
+
+	x, ok := r.X.(*rules.Wrap)
+	if !ok {
+		return false
+	}
+
+	base, n := util.GetVarBase(x.Value)
+	if n != 0 || g.variables.SSA[base] == 0 {
+		return false
+	}
+
+	g.declareVar(x.Value, r.Ty)
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"fault/smt/rules"
 	"fault/util"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -20,6 +21,61 @@ type ResultLog struct {
 	ProcessedAsserts []*ast.AssertionStatement
 	IsStringRule     map[string]bool   // Quick lookup
 	StringRules      map[string]string //Store the string value of the rule
+	Schedules        map[string]*Schedule
+	Terms            map[string]*Term // Named assertions in the SMT, by name
+	Temporal         map[int]*Formula // Bounded LTL asserts, by index in ProcessedAsserts
+	Probabilities    []*Probability   // prob() asserts, checked by sampling
+	Objectives       []*Objective     // maximize and minimize queries
+	Rounds           []string         // Violations of the asserts in each round, for iterative deepening
+	RoundRules       [][]string       // Rules and assumes each round adds, for iterative deepening
+	Violation        string           // Term that's true in runs that break an assert, empty if there are none
+	Likelihood       string           // Joint probability of the scenario's uncertain values, empty if it has none
+}
+
+// Probability is a prob() assert. The event is an SMT term that
+// is true in runs where it happens.
+type Probability struct {
+	Assert string // As written
+	Line   int
+	Col    int
+	Op     string // How the probability compares to the bound
+	Bound  float64
+	Event  string
+}
+
+// Objective is a maximize or minimize query. Var is a Real
+// the Rules tie to the value of the target, the model checker
+// passes it to the solver as the objective.
+type Objective struct {
+	Query     string // As written
+	Line      int
+	Col       int
+	Direction string // maximize or minimize
+	Var       string
+	Rules     []string
+}
+
+// Term is where a named assertion in the SMT came from, so
+// an unsat core can be traced back to the spec
+type Term struct {
+	Kind string // assert, assume, init or rule
+	Line int    // Position in the spec, if there is one
+	Col  int
+	Desc string
+}
+
+func (t *Term) String() string {
+	if t.Line > 0 {
+		return fmt.Sprintf("%s (line %d, col %d)", t.Desc, t.Line, t.Col)
+	}
+	return t.Desc
+}
+
+// Schedule is a parallel group where the solver picks the
+// order the steps run in
+type Schedule struct {
+	Steps []string // Functions in the group, as written
+	Vars  []string // Position of each step
 }
 
 type Event struct {
@@ -252,6 +308,16 @@ func (e *Event) String() string {
 	return fmt.Sprintf("%d,%s,%s,%s,%s,%s,%s\n", e.Round, e.Type, e.Scope, e.Variable, e.Previous, e.Current, e.Probability)
 }
 
+// Cut drops every event after the round, the rest of the
+// run doesn't matter once the asserts fail
+func (rl *ResultLog) Cut(round int) {
+	for _, e := range rl.Events {
+		if e.Round > round {
+			e.Kill()
+		}
+	}
+}
+
 func (e *Event) Kill() {
 	e.Dead = true
 
@@ -265,9 +331,50 @@ func NewLog() *ResultLog {
 		AssertChains:  make(map[string]*rules.AssertChain),
 		IsStringRule:  make(map[string]bool),
 		StringRules:   make(map[string]string),
+		Schedules:     make(map[string]*Schedule),
+		Terms:         make(map[string]*Term),
+		Temporal:      make(map[int]*Formula),
 	}
 }
 
+// Copy returns a log the formatters can filter and evaluate
+// without touching this one. Only what evaluation changes is
+// copied, the rest is shared.
+func (rl *ResultLog) Copy() *ResultLog {
+	c := *rl
+
+	c.Events = make([]*Event, len(rl.Events))
+	for i, e := range rl.Events {
+		ev := *e
+		c.Events[i] = &ev
+	}
+
+	c.Asserts = make([]*Assert, len(rl.Asserts))
+	for i, a := range rl.Asserts {
+		as := *a
+		c.Asserts[i] = &as
+	}
+
+	c.AssertClauses = make(map[string]bool, len(rl.AssertClauses))
+	for k, v := range rl.AssertClauses {
+		c.AssertClauses[k] = v
+	}
+
+	c.AssertChains = make(map[string]*rules.AssertChain, len(rl.AssertChains))
+	for k, v := range rl.AssertChains {
+		ch := *v
+		ch.Chain = append([]int{}, v.Chain...)
+		c.AssertChains[k] = &ch
+	}
+
+	c.ProcessedAsserts = make([]*ast.AssertionStatement, len(rl.ProcessedAsserts))
+	for i, a := range rl.ProcessedAsserts {
+		pa := *a
+		c.ProcessedAsserts[i] = &pa
+	}
+	return &c
+}
+
 func NewInit(round int, scope string, variable string) *Event {
 	return &Event{
 		Round:    round,
@@ -305,6 +412,15 @@ func NewTransition(round int, previous string, current string) *Event {
 	}
 }
 
+func NewSchedule(round int, scope string, variable string) *Event {
+	return &Event{
+		Round:    round,
+		Type:     "SCHEDULE",
+		Scope:    scope,
+		Variable: variable,
+	}
+}
+
 func NewTrigger(round int, scope string, variable string) *Event {
 	return &Event{
 		Round:    round,
@@ -339,6 +455,46 @@ func (rl *ResultLog) FilterTrigger(idx int) {
 	}
 }
 
+// ResolveSchedules fills in the order the solver picked for
+// each scheduled parallel group
+func (rl *ResultLog) ResolveSchedules(values map[string]string) {
+	for _, e := range rl.Events {
+		if e.Type != "SCHEDULE" {
+			continue
+		}
+		s, ok := rl.Schedules[e.Variable]
+		if !ok {
+			continue
+		}
+
+		pos := make([]int64, len(s.Vars))
+		var missing bool
+		for i, v := range s.Vars {
+			n, err := strconv.ParseInt(values[v], 10, 64)
+			if err != nil {
+				missing = true // Not in the model
+				break
+			}
+			pos[i] = n
+		}
+		if missing || len(s.Steps) != len(s.Vars) {
+			continue
+		}
+
+		idx := make([]int, len(s.Steps))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool { return pos[idx[i]] < pos[idx[j]] })
+
+		var order []string
+		for _, i := range idx {
+			order = append(order, s.Steps[i])
+		}
+		e.Current = strings.Join(order, " ")
+	}
+}
+
 func (rl *ResultLog) FilterStateTransitions() {
 	for idx, l := range rl.Events {
 		if idx > 1 && l.Type == "TRANSITION" {
@@ -401,6 +557,9 @@ func (rl *ResultLog) Static() string {
 
 func (rl *ResultLog) String() string {
 	var str = "Round,Type,Scope,Variable,Previous,Current,Probability\n"
+	if rl.Likelihood != "" {
+		str = fmt.Sprintf("Scenario likelihood: %s\n%s", rl.Likelihood, str)
+	}
 	for _, l := range rl.Events {
 		if l.Dead {
 			continue
@@ -457,3 +616,7 @@ func (rl *ResultLog) UpdatePrevious(idx int, val string) {
 func (rl *ResultLog) UpdateProbability(idx int, p float64) {
 	rl.Events[idx].Probability = fmt.Sprintf("%f", p)
 }
+
+func (rl *ResultLog) UpdateLikelihood(p float64) {
+	rl.Likelihood = fmt.Sprintf("%g", p)
+}
@@ -0,0 +1,87 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Formula is a bounded LTL assert with the variables already
+// resolved to their state at the end of every round. The SMT
+// is generated from it and the model checker evaluates the
+// same tree against the solver's values.
+type Formula struct {
+	Op     string     // Temporal, logical, comparison or arithmetic operator, or var and const
+	Args   []*Formula // Operands, in order
+	States []string   // var: the variable's state at the end of each round
+	Value  string     // const
+	From   int        // window: first and last round after the current one
+	To     int        // Args[0] can hold in, To is -1 for the end of the run
+}
+
+func NewVar(states []string) *Formula {
+	return &Formula{Op: "var", States: states}
+}
+
+func NewConst(value string) *Formula {
+	return &Formula{Op: "const", Value: value}
+}
+
+func NewOp(op string, args ...*Formula) *Formula {
+	return &Formula{Op: op, Args: args}
+}
+
+func NewWindow(from int, to int, arg *Formula) *Formula {
+	return &Formula{Op: "window", Args: []*Formula{arg}, From: from, To: to}
+}
+
+// Window is the rounds a window opened in round i covers.
+// Open is true when the window closes after the last round, or
+// an after window starts after it, the run ended before the
+// assert was due.
+func (f *Formula) Window(i int, last int) (from int, to int, open bool) {
+	if f.To < 0 {
+		return i + f.From, last, i+f.From > last
+	}
+	return i + f.From, i + f.To, i+f.To > last
+}
+
+// Always is true for after windows, their operand has to hold
+// in every round of the window rather than in any of them
+func (f *Formula) Always() bool {
+	return f.To < 0
+}
+
+// Rounds is how many rounds the formula covers
+func (f *Formula) Rounds() int {
+	if f.Op == "var" {
+		return len(f.States)
+	}
+
+	var n int
+	for _, a := range f.Args {
+		if r := a.Rounds(); r > n {
+			n = r
+		}
+	}
+	return n
+}
+
+func (f *Formula) String() string {
+	switch f.Op {
+	case "var":
+		if len(f.States) == 0 {
+			return ""
+		}
+		return f.States[0]
+	case "const":
+		return f.Value
+	case "window":
+		return fmt.Sprintf("(window %d %d %s)", f.From, f.To, f.Args[0].String())
+	}
+
+	var args []string
+	for _, a := range f.Args {
+		args = append(args, a.String())
+	}
+	return fmt.Sprintf("(%s %s)", f.Op, strings.Join(args, " "))
+}
@@ -0,0 +1,289 @@
+package smt
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"fmt"
+	"strings"
+)
+
+// Bounded LTL. Asserts with temporal operators nested in them
+// are turned into a formula over the value every variable has
+// at the end of each round, and each temporal operator gets a
+// Bool per round defined by the usual expansion:
+//
+//	(a until b)@i   = b@i or (a@i and (a until b)@i+1)
+//	(a release b)@i = b@i and (a@i or (a release b)@i+1)
+//
+// Past the last round until, next and eventually are false,
+// weak-until, release and always are true. The assert is
+// violated when the formula doesn't hold in round 0.
+//
+// within and between windows hold in round i if their operand
+// holds in any round of the window, after N holds if it holds in
+// every round from i+N on. A window that closes after the last
+// round isn't due yet and holds, but one that's longer than the
+// whole run is rejected in checkAsserts.
+
+func (g *Generator) parseLTL(a *ast.AssertionStatement) string {
+	last := g.currentRound()
+	if last < 0 {
+		last = 0
+	}
+
+	f := g.ltlFormula(a, last)
+	if a.Assume {
+		return g.encodeLTL(f, 0, last)
+	}
+
+	g.Log.Temporal[g.currentAssert] = f
+	return fmt.Sprintf("(not %s)", g.encodeLTL(f, 0, last))
+}
+
+// ltlFormula resolves the variables in the assert. Asserts on
+// a struct check every instance, so the formula is repeated for
+// every combination of instances.
+func (g *Generator) ltlFormula(a *ast.AssertionStatement, last int) *resultlog.Formula {
+	var fs []*resultlog.Formula
+	for _, b := range bindings(a.Constraint) {
+		f := g.formula(a.Constraint, b, last)
+		switch a.Temporal {
+		case "always", "eventually":
+			f = resultlog.NewOp(a.Temporal, f)
+		case "eventually-always":
+			f = resultlog.NewOp("eventually", resultlog.NewOp("always", f))
+		}
+		fs = append(fs, f)
+	}
+	return joinFormulas("and", fs)
+}
+
+// bindings is every combination of instances for the
+// variables in an expression
+func bindings(ex ast.Expression) []map[*ast.AssertVar]string {
+	var vars []*ast.AssertVar
+	collectAssertVars(ex, &vars)
+
+	bindings := []map[*ast.AssertVar]string{{}}
+	for _, v := range vars {
+		var next []map[*ast.AssertVar]string
+		for _, b := range bindings {
+			for _, inst := range v.Instances {
+				nb := map[*ast.AssertVar]string{v: inst}
+				for k, val := range b {
+					nb[k] = val
+				}
+				next = append(next, nb)
+			}
+		}
+		bindings = next
+	}
+	return bindings
+}
+
+func joinFormulas(op string, fs []*resultlog.Formula) *resultlog.Formula {
+	if len(fs) == 1 {
+		return fs[0]
+	}
+	return resultlog.NewOp(op, fs...)
+}
+
+func (g *Generator) formula(ex ast.Expression, b map[*ast.AssertVar]string, last int) *resultlog.Formula {
+	switch e := ex.(type) {
+	case *ast.InvariantClause:
+		l := g.formula(e.Left, b, last)
+		r := g.formula(e.Right, b, last)
+		switch {
+		case e.Operator == "then":
+			return resultlog.NewOp("always", resultlog.NewOp("=>", l, r))
+		case ast.TEMPORAL_BINARY[e.Operator]:
+			return resultlog.NewOp(e.Operator, l, r)
+		}
+		return infixFormula(e.Operator, l, r)
+	case *ast.InfixExpression:
+		return infixFormula(e.Operator, g.formula(e.Left, b, last), g.formula(e.Right, b, last))
+	case *ast.PrefixExpression:
+		r := g.formula(e.Right, b, last)
+		if e.Operator == "!" {
+			return resultlog.NewOp("not", r)
+		}
+		return resultlog.NewOp(e.Operator, r)
+	case *ast.TemporalPrefix:
+		return resultlog.NewOp(e.Operator, g.formula(e.Right, b, last))
+	case *ast.TemporalInfix:
+		return resultlog.NewOp(e.Operator, g.formula(e.Left, b, last), g.formula(e.Right, b, last))
+	case *ast.TemporalWindow:
+		return resultlog.NewWindow(e.From, e.To, g.formula(e.Right, b, last))
+	case *ast.AssertVar:
+		return resultlog.NewVar(g.roundStates(b[e], last))
+	case *ast.IndexExpression:
+		return resultlog.NewVar(repeat(g.convertIndexExpr(e), last+1))
+	case *ast.IntegerLiteral:
+		return resultlog.NewConst(fmt.Sprint(e.Value))
+	case *ast.FloatLiteral:
+		return resultlog.NewConst(fmt.Sprint(e.Value))
+	case *ast.Boolean:
+		return resultlog.NewConst(fmt.Sprint(e.Value))
+	default:
+		pos := e.Position()
+		panic(fmt.Sprintf("illegal node %T in temporal assert or assume line: %d, col: %d", e, pos[0], pos[1]))
+	}
+}
+
+func infixFormula(op string, l *resultlog.Formula, r *resultlog.Formula) *resultlog.Formula {
+	if op == "!=" {
+		return resultlog.NewOp("not", resultlog.NewOp("=", l, r))
+	}
+	return resultlog.NewOp(smtlibOperators(op), l, r)
+}
+
+// roundStates is the state a variable is in at the end of
+// each round, the state it starts in if it hasn't been set yet
+func (g *Generator) roundStates(base string, last int) []string {
+	if st, _, _ := captureState(base); st != "" { // Already a specific state
+		return repeat(base, last+1)
+	}
+
+	lookup := g.RVarLookup[base]
+	states := make([]string, last+1)
+	for i := range states {
+		states[i] = fmt.Sprintf("%s_0", base)
+		if len(lookup) > 0 {
+			states[i] = fmt.Sprintf("%s_%d", base, lookup[0][0])
+		}
+		for _, s := range lookup {
+			if s[1] <= i {
+				states[i] = fmt.Sprintf("%s_%d", base, s[0])
+			}
+		}
+	}
+	return states
+}
+
+func (g *Generator) encodeLTL(f *resultlog.Formula, i int, last int) string {
+	switch f.Op {
+	case "var":
+		return f.States[i]
+	case "const":
+		return f.Value
+	case "next", "until", "weak-until", "release", "always", "eventually":
+		return g.temporalTerm(f, i, last)
+	case "window":
+		from, to, open := f.Window(i, last)
+		if open {
+			return "true"
+		}
+
+		var args []string
+		for j := from; j <= to; j++ {
+			args = append(args, g.encodeLTL(f.Args[0], j, last))
+		}
+
+		op := "or"
+		if f.Always() {
+			op = "and"
+		}
+
+		switch len(args) {
+		case 0:
+			return "false"
+		case 1:
+			return args[0]
+		}
+		return fmt.Sprintf("(%s %s)", op, strings.Join(args, " "))
+	}
+
+	var args []string
+	for _, a := range f.Args {
+		args = append(args, g.encodeLTL(a, i, last))
+	}
+	return fmt.Sprintf("(%s %s)", f.Op, strings.Join(args, " "))
+}
+
+// temporalTerm is the Bool standing in for a temporal operator
+// in round i, declared and defined the first time it's needed
+func (g *Generator) temporalTerm(f *resultlog.Formula, i int, last int) string {
+	id, ok := g.ltlTerms[f]
+	if !ok {
+		id = len(g.ltlTerms)
+		g.ltlTerms[f] = id
+	}
+
+	name := fmt.Sprintf("__ltl_%d_%d", id, i)
+	if g.ltlDeclared[name] {
+		return name
+	}
+	g.ltlDeclared[name] = true
+
+	var def string
+	if i == last {
+		def = g.lastRound(f, i, last)
+	} else {
+		next := g.temporalTerm(f, i+1, last)
+		switch f.Op {
+		case "next":
+			def = g.encodeLTL(f.Args[0], i+1, last)
+		case "until", "weak-until":
+			def = fmt.Sprintf("(or %s (and %s %s))", g.encodeLTL(f.Args[1], i, last), g.encodeLTL(f.Args[0], i, last), next)
+		case "release":
+			def = fmt.Sprintf("(and %s (or %s %s))", g.encodeLTL(f.Args[1], i, last), g.encodeLTL(f.Args[0], i, last), next)
+		case "always":
+			def = fmt.Sprintf("(and %s %s)", g.encodeLTL(f.Args[0], i, last), next)
+		case "eventually":
+			def = fmt.Sprintf("(or %s %s)", g.encodeLTL(f.Args[0], i, last), next)
+		}
+	}
+
+	g.declareVar(name, "Bool")
+	g.rules = append(g.rules, fmt.Sprintf("(assert (= %s %s))", name, def))
+	return name
+}
+
+// lastRound is the expansion with nothing after it
+func (g *Generator) lastRound(f *resultlog.Formula, i int, last int) string {
+	switch f.Op {
+	case "next":
+		return "false"
+	case "weak-until":
+		return fmt.Sprintf("(or %s %s)", g.encodeLTL(f.Args[1], i, last), g.encodeLTL(f.Args[0], i, last))
+	case "until", "release":
+		return g.encodeLTL(f.Args[1], i, last)
+	default: // always, eventually
+		return g.encodeLTL(f.Args[0], i, last)
+	}
+}
+
+func collectAssertVars(ex ast.Expression, vars *[]*ast.AssertVar) {
+	switch e := ex.(type) {
+	case *ast.AssertVar:
+		for _, v := range *vars {
+			if v == e {
+				return
+			}
+		}
+		*vars = append(*vars, e)
+	case *ast.InvariantClause:
+		collectAssertVars(e.Left, vars)
+		collectAssertVars(e.Right, vars)
+	case *ast.InfixExpression:
+		collectAssertVars(e.Left, vars)
+		collectAssertVars(e.Right, vars)
+	case *ast.PrefixExpression:
+		collectAssertVars(e.Right, vars)
+	case *ast.TemporalPrefix:
+		collectAssertVars(e.Right, vars)
+	case *ast.TemporalWindow:
+		collectAssertVars(e.Right, vars)
+	case *ast.TemporalInfix:
+		collectAssertVars(e.Left, vars)
+		collectAssertVars(e.Right, vars)
+	}
+}
+
+func repeat(s string, n int) []string {
+	ret := make([]string, n)
+	for i := range ret {
+		ret[i] = s
+	}
+	return ret
+}
@@ -0,0 +1,67 @@
+package smt
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"fmt"
+	"strings"
+)
+
+// Optimization queries. maximize x asks for the largest value x
+// reaches in any run of the spec. The target is encoded over
+// the round states like a bounded LTL formula and a fresh Real
+// is tied to its value at the round asked for. Without a round
+// it can take the value from any round, so the best the solver
+// finds is the best over the whole run. Targets on a struct
+// can take the value of any instance.
+
+func (g *Generator) newObjectives() error {
+	last := g.currentRound()
+	if last < 0 {
+		last = 0
+	}
+
+	for i, o := range g.objectives {
+		pos := o.Position()
+		if o.Round > int64(last) {
+			return ast.Errorf(pos, "%s asks for round %d but the last round is %d", o.Direction, o.Round, last)
+		}
+
+		rounds := []int{int(o.Round)}
+		if o.Round < 0 {
+			rounds = nil
+			for r := 0; r <= last; r++ {
+				rounds = append(rounds, r)
+			}
+		}
+
+		name := fmt.Sprintf("__objective_%d", i)
+		var options []string
+		seen := make(map[string]bool) // States carry over rounds they don't change in
+		for _, b := range bindings(o.Target) {
+			f := g.formula(o.Target, b, last)
+			for _, r := range rounds {
+				opt := fmt.Sprintf("(= %s %s)", name, g.encodeLTL(f, r, last))
+				if !seen[opt] {
+					seen[opt] = true
+					options = append(options, opt)
+				}
+			}
+		}
+
+		rule := options[0]
+		if len(options) > 1 {
+			rule = fmt.Sprintf("(or %s)", strings.Join(options, " "))
+		}
+
+		g.Log.Objectives = append(g.Log.Objectives, &resultlog.Objective{
+			Query:     g.rawObjectives[i].String(),
+			Line:      pos[0],
+			Col:       pos[1],
+			Direction: o.Direction,
+			Var:       name,
+			Rules:     []string{fmt.Sprintf("(declare-fun %s () Real)", name), fmt.Sprintf("(assert %s)", rule)},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package smt
+
+import (
+	"fault/ast"
+	"fault/listener"
+	"fault/llvm"
+	"fault/preprocess"
+	"fault/swaps"
+	"fault/types"
+	"strings"
+	"testing"
+)
+
+// prepObjective swaps the assert in the spec for a query on
+// the stock it checks
+func prepObjective(direction string, round int64) *Generator {
+	test := `spec test1;
+	def s = stock{
+		a: 30,
+		b: 2,
+	};
+	def f = flow{
+		data: new s,
+		fn: func{
+			data.a <- data.a - data.b;
+		},
+	};
+	assert s.a > 25;
+	for 3 init{l = new f;} run {
+		l.fn;
+	}`
+
+	flags := map[string]bool{"specType": true, "testing": false, "skipRun": false}
+	l := listener.Execute(test, "", flags)
+	for i, st := range l.AST.Statements {
+		if a, ok := st.(*ast.AssertionStatement); ok {
+			l.AST.Statements[i] = &ast.OptimizeStatement{Token: a.Token, Direction: direction, Target: a.Constraint.Left, Round: round}
+		}
+	}
+
+	pre := preprocess.Execute(l)
+	ty := types.Execute(pre.Processed, pre)
+	sw := swaps.NewPrecompiler(ty)
+	tree, err := sw.Swap(ty.Checked)
+	if err != nil {
+		panic(err)
+	}
+	return Execute(llvm.Execute(tree, ty.SpecStructs, l.Uncertains, l.Unknowns, sw.Alias, true))
+}
+
+func TestObjective(t *testing.T) {
+	g := prepObjective("maximize", -1)
+	if len(g.Log.Objectives) != 1 {
+		t.Fatalf("query not logged. got=%d", len(g.Log.Objectives))
+	}
+
+	o := g.Log.Objectives[0]
+	if o.Query != "maximize s.a;" || o.Direction != "maximize" || o.Line != 12 {
+		t.Fatalf("query not logged correctly. got=%+v", o)
+	}
+
+	expected := "(assert (or (= __objective_0 test1_l_data_a_1) (= __objective_0 test1_l_data_a_2) (= __objective_0 test1_l_data_a_3)))"
+	if len(o.Rules) != 2 || o.Rules[0] != "(declare-fun __objective_0 () Real)" || o.Rules[1] != expected {
+		t.Fatalf("query not encoded over every round. got=%s", o.Rules)
+	}
+
+	if strings.Contains(g.SMT(), "__objective") || strings.Contains(g.Model(), "__objective") {
+		t.Fatalf("query should be left out of the model. got=%s", g.SMT())
+	}
+}
+
+func TestObjectiveRound(t *testing.T) {
+	g := prepObjective("minimize", 2)
+	o := g.Log.Objectives[0]
+	if o.Query != "minimize s.a at 2;" || o.Rules[1] != "(assert (= __objective_0 test1_l_data_a_3))" {
+		t.Fatalf("query not encoded at round 2. got=%s %s", o.Query, o.Rules)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("query past the last round did not fail")
+		}
+	}()
+	prepObjective("minimize", 3)
+}
@@ -0,0 +1,200 @@
+package smt
+
+import (
+	"strings"
+
+	"github.com/llir/llvm/ir"
+	irtypes "github.com/llir/llvm/ir/types"
+)
+
+// Partial-order reduction for parallel run steps. Two steps
+// that don't touch any of the same stocks (or one only reads
+// what the other reads) end up in the same state whichever
+// runs first, so only the orderings of conflicting steps need
+// to be explored. Each set of orderings that agree on every
+// conflicting pair is collapsed down to one representative.
+
+type footprint struct {
+	reads  map[string]bool
+	writes map[string]bool
+}
+
+func newFootprint() *footprint {
+	return &footprint{
+		reads:  make(map[string]bool),
+		writes: make(map[string]bool),
+	}
+}
+
+func (fp *footprint) merge(o *footprint) {
+	for k := range o.reads {
+		fp.reads[k] = true
+	}
+	for k := range o.writes {
+		fp.writes[k] = true
+	}
+}
+
+// conflicts is true if running the steps in a different order
+// could change the outcome
+func (fp *footprint) conflicts(o *footprint) bool {
+	for k := range fp.writes {
+		if o.reads[k] || o.writes[k] {
+			return true
+		}
+	}
+	for k := range o.writes {
+		if fp.reads[k] {
+			return true
+		}
+	}
+	return false
+}
+
+// footprint collects the variables a function (and everything
+// it calls) reads and writes
+func (g *Generator) footprint(fname string, seen map[string]bool) *footprint {
+	fp := newFootprint()
+	f, ok := g.functions[fname]
+	if !ok || seen[fname] {
+		return fp
+	}
+	seen[fname] = true
+
+	states := make(map[string]string) // Temps holding the name of a state for advance()
+	for _, block := range f.Blocks {
+		for _, instruction := range block.Insts {
+			switch inst := instruction.(type) {
+			case *ir.InstLoad:
+				if id := inst.Src.Ident(); !g.variables.IsTemp(id) {
+					fp.reads[id] = true
+				}
+			case *ir.InstStore:
+				id := inst.Dst.Ident()
+				if _, ok := inst.Src.Type().(*irtypes.ArrayType); ok {
+					states[id] = inst.Src.Ident()
+					continue
+				}
+				if !g.variables.IsTemp(id) && id != "@__rounds" && id != "@__parallelGroup" {
+					fp.writes[id] = true
+				}
+			case *ir.InstCall:
+				callee := inst.Callee.Ident()
+				if callee == "@advance" {
+					fp.merge(g.advanceFootprint(fname, inst, states))
+					continue
+				}
+				if !g.isBuiltIn(callee) {
+					fp.merge(g.footprint(callee, seen))
+				}
+			}
+		}
+	}
+	return fp
+}
+
+// advance() moves the component out of the current state and
+// into the one passed in
+func (g *Generator) advanceFootprint(fname string, call *ir.InstCall, states map[string]string) *footprint {
+	fp := newFootprint()
+	if len(fname) > 8 && fname[len(fname)-7:] == "__state" {
+		fp.writes["%"+fname[1:len(fname)-7]] = true
+	}
+
+	if len(call.Args) == 0 {
+		return fp
+	}
+	if bc, ok := call.Args[0].(*ir.InstBitCast); ok {
+		if s, ok := states[bc.From.Ident()]; ok && len(s) > 3 {
+			fp.writes["%"+s[2:len(s)-1]] = true // Strip the c"..." LLVM puts around char arrays
+		}
+	}
+	return fp
+}
+
+// reducedPermutations is one ordering of the parallel steps
+// per distinct outcome. Steps that commute keep the order they
+// were written in.
+func (g *Generator) reducedPermutations(p []string) [][]string {
+	fps := make([]*footprint, len(p))
+	for i, f := range p {
+		fps[i] = g.footprint(f, make(map[string]bool))
+	}
+
+	conflict := make([][]bool, len(p))
+	for i := range p {
+		conflict[i] = make([]bool, len(p))
+		for j := range p {
+			conflict[i][j] = i != j && fps[i].conflicts(fps[j])
+		}
+	}
+
+	var permuts [][]string
+	seen := make(map[string]bool)
+	used := make([]bool, len(p))
+	var order []int
+
+	var rc func()
+	rc = func() {
+		if len(order) == len(p) {
+			var perm []string
+			for _, i := range order {
+				perm = append(perm, p[i])
+			}
+
+			// The same step can be in the group twice
+			k, names := orderKey(order, conflict), strings.Join(perm, ",")
+			if !seen[k] && !seen[names] {
+				seen[k], seen[names] = true, true
+				permuts = append(permuts, perm)
+			}
+			return
+		}
+
+		for i := range p {
+			if used[i] {
+				continue
+			}
+			// Swapping two independent neighbors gives the same
+			// outcome, only keep them in their written order
+			if len(order) > 0 {
+				last := order[len(order)-1]
+				if last > i && !conflict[last][i] {
+					continue
+				}
+			}
+			used[i] = true
+			order = append(order, i)
+			rc()
+			order = order[:len(order)-1]
+			used[i] = false
+		}
+	}
+	rc()
+
+	return permuts
+}
+
+// orderKey identifies an ordering by which step of each
+// conflicting pair runs first
+func orderKey(order []int, conflict [][]bool) string {
+	pos := make([]int, len(order))
+	for n, i := range order {
+		pos[i] = n
+	}
+
+	key := make([]byte, 0, len(order)*len(order)/2)
+	for i := range order {
+		for j := i + 1; j < len(order); j++ {
+			if !conflict[i][j] {
+				continue
+			}
+			if pos[i] < pos[j] {
+				key = append(key, '<')
+			} else {
+				key = append(key, '>')
+			}
+		}
+	}
+	return string(key)
+}
@@ -0,0 +1,68 @@
+package smt
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"fmt"
+)
+
+// Probabilistic asserts. The solver can't check prob(event) < p
+// directly, so the event is encoded like a bounded LTL formula
+// and left out of the asserts. The model checker samples the
+// uncertain values and asks the solver whether the event happens
+// in each run.
+
+func (g *Generator) newProbabilities() error {
+	last := g.currentRound()
+	if last < 0 {
+		last = 0
+	}
+
+	for i, a := range g.compiledProbs {
+		p := a.Constraint.Left.(*ast.ProbabilityExpression)
+		pos := a.Position()
+		bound, err := probabilityBound(a.Constraint.Right)
+		if err != nil {
+			return err
+		}
+		g.Log.Probabilities = append(g.Log.Probabilities, &resultlog.Probability{
+			Assert: describeProbability(g.rawProbs[i]),
+			Line:   pos[0],
+			Col:    pos[1],
+			Op:     a.Constraint.Operator,
+			Bound:  bound,
+			Event:  g.encodeLTL(g.eventFormula(p.Event, last), 0, last),
+		})
+	}
+	return nil
+}
+
+// eventFormula is true in runs where the event happens for any
+// of the instances. Events without temporal operators can
+// happen in any round.
+func (g *Generator) eventFormula(ev ast.Expression, last int) *resultlog.Formula {
+	var fs []*resultlog.Formula
+	for _, b := range bindings(ev) {
+		f := g.formula(ev, b, last)
+		if !ast.HasTemporal(ev) {
+			f = resultlog.NewOp("eventually", f)
+		}
+		fs = append(fs, f)
+	}
+	return joinFormulas("or", fs)
+}
+
+func probabilityBound(ex ast.Expression) (float64, error) {
+	switch n := ex.(type) {
+	case *ast.FloatLiteral:
+		return n.Value, nil
+	case *ast.IntegerLiteral:
+		return float64(n.Value), nil
+	default:
+		return 0, ast.Errorf(n.Position(), "prob() must be compared to a number")
+	}
+}
+
+func describeProbability(a *ast.AssertionStatement) string {
+	return fmt.Sprintf("assert %s %s %s;", a.Constraint.Left, a.Constraint.Operator, a.Constraint.Right)
+}
@@ -0,0 +1,172 @@
+package smt
+
+import (
+	resultlog "fault/smt/log"
+	"fault/smt/rules"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Symbolic interleaving for parallel run steps. Instead of a
+// branch per ordering, every step in the group is encoded once
+// and given an Int position the solver picks (all positions
+// distinct). Every step that touches a stock some step in the
+// group writes reads it through its own input variable, set to
+// the output of whichever other writer ran last before it (or
+// the value going into the group). A writer's input never
+// depends on its own output. Written stocks end on the output
+// of the last writer. The step rules grow linearly with the
+// number of steps, only the glue between the steps sharing a
+// stock grows faster.
+
+func (g *Generator) runScheduled(steps []string) []rules.Rule {
+	var ru []rules.Rule
+
+	group := fmt.Sprintf("__schedule_%d", g.schedules)
+	g.schedules++
+
+	pos := make([]string, len(steps))
+	for i := range steps {
+		pos[i] = fmt.Sprintf("%s_%d", group, i)
+		g.declareVar(pos[i], "Int")
+		g.rules = append(g.rules, g.writeAssert("and", fmt.Sprintf("(<= 0 %s) (< %s %d)", pos[i], pos[i], len(steps))))
+	}
+	if len(steps) > 1 {
+		g.rules = append(g.rules, g.writeAssert("distinct", strings.Join(pos, " ")))
+	}
+
+	fps := make([]*footprint, len(steps))
+	writers := make(map[string][]int)
+	for i, s := range steps {
+		fps[i] = g.footprint(s, make(map[string]bool))
+		for _, k := range keys(fps[i].writes) {
+			writers[k] = append(writers[k], i)
+		}
+	}
+
+	start := make(map[string]string) // Value going into the group
+	for k := range writers {
+		start[k] = g.variables.GetSSA(util.FormatIdent(k))
+	}
+
+	type input struct {
+		step int
+		key  string
+		id   string
+	}
+	var inputs []input
+	outs := make([]map[string]string, len(steps))
+	for i, s := range steps {
+		touched := make(map[string]bool)
+		for k := range fps[i].reads {
+			touched[k] = true
+		}
+		for k := range fps[i].writes {
+			touched[k] = true
+		}
+
+		for _, k := range keys(touched) {
+			if len(writers[k]) == 0 { // Nothing in the group changes it
+				continue
+			}
+			inputs = append(inputs, input{i, k, g.nextSSA(util.FormatIdent(k))})
+		}
+
+		ru = append(ru, g.parseFunction(g.functions[s])...)
+
+		outs[i] = make(map[string]string)
+		for k := range fps[i].writes {
+			outs[i][k] = g.variables.GetSSA(util.FormatIdent(k))
+		}
+	}
+
+	// What each step reads is whatever the last writer
+	// before it left behind
+	for _, in := range inputs {
+		w := others(writers[in.key], in.step)
+		value := start[in.key]
+		for n := len(w) - 1; n >= 0; n-- {
+			j := w[n]
+			conds := []string{fmt.Sprintf("(< %s %s)", pos[j], pos[in.step])}
+			for _, k := range others(w, j) {
+				conds = append(conds, fmt.Sprintf("(not (and (< %s %s) (< %s %s)))", pos[j], pos[k], pos[k], pos[in.step]))
+			}
+			value = fmt.Sprintf("(ite %s %s %s)", conjunction(conds), outs[j][in.key], value)
+		}
+		ru = append(ru, g.scheduleRule(in.key, in.id, value))
+	}
+
+	// And the group ends on whatever the last writer left
+	var written []string
+	for k := range writers {
+		written = append(written, k)
+	}
+	for _, k := range util.StableSortKeys(written) {
+		w := writers[k]
+		value := outs[w[len(w)-1]][k]
+		for n := len(w) - 2; n >= 0; n-- {
+			j := w[n]
+			var conds []string
+			for _, o := range others(w, j) {
+				conds = append(conds, fmt.Sprintf("(< %s %s)", pos[o], pos[j]))
+			}
+			value = fmt.Sprintf("(ite %s %s %s)", conjunction(conds), outs[j][k], value)
+		}
+		ru = append(ru, g.scheduleRule(k, g.nextSSA(util.FormatIdent(k)), value))
+	}
+
+	var names []string
+	for _, s := range steps {
+		names = append(names, util.FormatIdent(s))
+	}
+	g.Log.Schedules[group] = &resultlog.Schedule{Steps: names, Vars: pos}
+	g.Log.Add(resultlog.NewSchedule(g.currentRound(), g.currentFunction, group))
+	return ru
+}
+
+func (g *Generator) scheduleRule(key string, id string, value string) rules.Rule {
+	ty := g.variables.LookupType(util.FormatIdent(key), nil)
+	return &rules.Infix{X: &rules.Wrap{Value: id}, Y: &rules.Wrap{Value: value}, Ty: ty, Op: "="}
+}
+
+// nextSSA moves the variable on to a new state the same way
+// a store does
+func (g *Generator) nextSSA(base string) string {
+	n := g.variables.GetSSANum(base)
+	prev := fmt.Sprintf("%s_%d", base, n)
+	if !g.inPhiState.Check() {
+		g.variables.NewPhi(base, n+1)
+	} else {
+		g.variables.StoreLastState(base, n+1)
+	}
+	id := g.variables.AdvanceSSA(base)
+	g.addVarToRound(base, int(n+1))
+	g.AddNewVarChange(base, id, prev)
+	return id
+}
+
+func others(steps []int, skip int) []int {
+	var ret []int
+	for _, s := range steps {
+		if s != skip {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func conjunction(conds []string) string {
+	if len(conds) == 1 {
+		return conds[0]
+	}
+	return fmt.Sprintf("(and %s)", strings.Join(conds, " "))
+}
+
+func keys(m map[string]bool) []string {
+	var ret []string
+	for k := range m {
+		ret = append(ret, k)
+	}
+	return util.StableSortKeys(ret)
+}
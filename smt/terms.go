@@ -0,0 +1,83 @@
+package smt
+
+import (
+	"fault/ast"
+	resultlog "fault/smt/log"
+	"fault/smt/rules"
+	"fault/util"
+	"fmt"
+	"strings"
+)
+
+// Named terms. With NamedTerms set every assertion is written
+// as (! ... :named __kind_n) and logged with where it came
+// from, so when the solver can't find a failure the unsat core
+// says which assumes, starting values and rules rule it out.
+
+func (g *Generator) nameTerm(rule string, kind string, desc string, pos []int) string {
+	if !g.NamedTerms || !strings.HasPrefix(rule, "(assert ") {
+		return rule
+	}
+
+	name := fmt.Sprintf("__%s_%d", kind, len(g.Log.Terms))
+	t := &resultlog.Term{Kind: kind, Desc: desc}
+	if len(pos) > 1 {
+		t.Line, t.Col = pos[0], pos[1]
+	}
+	g.Log.Terms[name] = t
+
+	term := strings.TrimSuffix(strings.TrimPrefix(rule, "(assert "), ")")
+	return fmt.Sprintf("(assert (! %s :named %s))", term, name)
+}
+
+// nameRule tells the starting value of a variable apart from
+// the rules that change it. Starting values point at where the
+// variable is declared, changes at the first place it's changed
+// in. Without a position the description still names the
+// instance and property.
+func (g *Generator) nameRule(ru rules.Rule, rule string) string {
+	if !g.NamedTerms {
+		return rule
+	}
+
+	id := ruleTarget(ru)
+	if id == "" {
+		return g.nameTerm(rule, "rule", "rule", nil)
+	}
+
+	base, n := util.GetVarBase(id)
+	if n == 0 {
+		return g.nameTerm(rule, "init", fmt.Sprintf("initial value of %s", base), g.declared[base])
+	}
+	return g.nameTerm(rule, "rule", fmt.Sprintf("change to %s (state %s)", base, id), g.changed[base])
+}
+
+// ruleTarget is the variable a rule sets
+func ruleTarget(ru rules.Rule) string {
+	switch r := ru.(type) {
+	case *rules.Infix:
+		if x, ok := r.X.(*rules.Wrap); ok {
+			return x.Value
+		}
+	case *rules.Ite:
+		if len(r.T) > 0 {
+			return ruleTarget(r.T[0])
+		}
+	case *rules.Phi:
+		return r.EndState
+	}
+	return ""
+}
+
+func describeAssert(a *ast.AssertionStatement) string {
+	return strings.TrimPrefix(strings.TrimPrefix(a.EvLogString(true), "OK  "), "FAILED  ")
+}
+
+func describeAsserts(asserts []*ast.AssertionStatement) string {
+	var desc []string
+	for _, a := range asserts {
+		pos := a.Position()
+		desc = append(desc, fmt.Sprintf("%s (line %d, col %d)", describeAssert(a), pos[0], pos[1]))
+	}
+	return strings.Join(desc, " or ")
+}
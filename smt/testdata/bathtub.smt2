@@ -1,53 +1,21 @@
 (set-logic QF_NRA)
-(declare-fun bathtub_drawn_water_level_0 () Real)
-(declare-fun bathtub_pipe_water_level_0 () Real)
 (declare-fun bathtub_drawn_water_level_1 () Real)
 (declare-fun bathtub_pipe_water_level_1 () Real)
-(declare-fun bathtub_pipe_water_level_2 () Real)
 (declare-fun bathtub_drawn_water_level_2 () Real)
+(declare-fun bathtub_pipe_water_level_2 () Real)
 (declare-fun bathtub_drawn_water_level_3 () Real)
 (declare-fun bathtub_pipe_water_level_3 () Real)
+(declare-fun bathtub_drawn_water_level_0 () Real)
+(declare-fun bathtub_pipe_water_level_0 () Real)
 (declare-fun bathtub_drawn_water_level_4 () Real)
 (declare-fun bathtub_pipe_water_level_4 () Real)
-(declare-fun bathtub_pipe_water_level_5 () Real)
-(declare-fun bathtub_drawn_water_level_5 () Real)
-(declare-fun bathtub_pipe_water_level_6 () Real)
-(declare-fun bathtub_drawn_water_level_6 () Real)
-(declare-fun bathtub_drawn_water_level_7 () Real)
-(declare-fun bathtub_pipe_water_level_7 () Real)
-(declare-fun bathtub_pipe_water_level_8 () Real)
-(declare-fun bathtub_drawn_water_level_8 () Real)
-(declare-fun bathtub_drawn_water_level_9 () Real)
-(declare-fun bathtub_pipe_water_level_9 () Real)
-(declare-fun bathtub_drawn_water_level_10 () Real)
-(declare-fun bathtub_pipe_water_level_10 () Real)
-(declare-fun bathtub_pipe_water_level_11 () Real)
-(declare-fun bathtub_drawn_water_level_11 () Real)
-(declare-fun bathtub_drawn_water_level_12 () Real)
-(declare-fun bathtub_pipe_water_level_12 () Real)
-(assert (= bathtub_drawn_water_level_0 5.0))
-(assert (= bathtub_pipe_water_level_0 5.0))
 (assert (= bathtub_drawn_water_level_1 (+ bathtub_drawn_water_level_0 10.0)))
 (assert (= bathtub_pipe_water_level_1 (- bathtub_pipe_water_level_0 20.0)))
-(assert (= bathtub_pipe_water_level_2 (- bathtub_pipe_water_level_0 20.0)))
-(assert (= bathtub_drawn_water_level_2 (+ bathtub_drawn_water_level_0 10.0)))
-(assert (or (= bathtub_drawn_water_level_3 bathtub_drawn_water_level_1) (= bathtub_drawn_water_level_3 bathtub_drawn_water_level_2)))
-(assert (or (= bathtub_pipe_water_level_3 bathtub_pipe_water_level_1) (= bathtub_pipe_water_level_3 bathtub_pipe_water_level_2)))
+(assert (= bathtub_drawn_water_level_2 (+ bathtub_drawn_water_level_1 10.0)))
+(assert (= bathtub_pipe_water_level_2 (- bathtub_pipe_water_level_1 20.0)))
+(assert (= bathtub_drawn_water_level_3 (+ bathtub_drawn_water_level_2 10.0)))
+(assert (= bathtub_pipe_water_level_3 (- bathtub_pipe_water_level_2 20.0)))
+(assert (= bathtub_drawn_water_level_0 5.0))
+(assert (= bathtub_pipe_water_level_0 5.0))
 (assert (= bathtub_drawn_water_level_4 (+ bathtub_drawn_water_level_3 10.0)))
 (assert (= bathtub_pipe_water_level_4 (- bathtub_pipe_water_level_3 20.0)))
-(assert (= bathtub_pipe_water_level_5 (- bathtub_pipe_water_level_3 20.0)))
-(assert (= bathtub_drawn_water_level_5 (+ bathtub_drawn_water_level_3 10.0)))
-(assert (or (= bathtub_pipe_water_level_6 bathtub_pipe_water_level_4) (= bathtub_pipe_water_level_6 bathtub_pipe_water_level_5)))
-(assert (or (= bathtub_drawn_water_level_6 bathtub_drawn_water_level_4) (= bathtub_drawn_water_level_6 bathtub_drawn_water_level_5)))
-(assert (= bathtub_drawn_water_level_7 (+ bathtub_drawn_water_level_6 10.0)))
-(assert (= bathtub_pipe_water_level_7 (- bathtub_pipe_water_level_6 20.0)))
-(assert (= bathtub_pipe_water_level_8 (- bathtub_pipe_water_level_6 20.0)))
-(assert (= bathtub_drawn_water_level_8 (+ bathtub_drawn_water_level_6 10.0)))
-(assert (or (= bathtub_drawn_water_level_9 bathtub_drawn_water_level_7) (= bathtub_drawn_water_level_9 bathtub_drawn_water_level_8)))
-(assert (or (= bathtub_pipe_water_level_9 bathtub_pipe_water_level_7) (= bathtub_pipe_water_level_9 bathtub_pipe_water_level_8)))
-(assert (= bathtub_drawn_water_level_10 (+ bathtub_drawn_water_level_9 10.0)))
-(assert (= bathtub_pipe_water_level_10 (- bathtub_pipe_water_level_9 20.0)))
-(assert (= bathtub_pipe_water_level_11 (- bathtub_pipe_water_level_9 20.0)))
-(assert (= bathtub_drawn_water_level_11 (+ bathtub_drawn_water_level_9 10.0)))
-(assert (or (= bathtub_drawn_water_level_12 bathtub_drawn_water_level_10) (= bathtub_drawn_water_level_12 bathtub_drawn_water_level_11)))
-(assert (or (= bathtub_pipe_water_level_12 bathtub_pipe_water_level_10) (= bathtub_pipe_water_level_12 bathtub_pipe_water_level_11)))
\ No newline at end of file
@@ -0,0 +1,48 @@
+(set-logic QF_NIRA)
+(declare-fun __schedule_0_0 () Int)
+(declare-fun __schedule_0_1 () Int)
+(declare-fun __schedule_1_0 () Int)
+(declare-fun __schedule_1_1 () Int)
+(declare-fun __schedule_2_0 () Int)
+(declare-fun __schedule_2_1 () Int)
+(declare-fun bathtub_drawn_water_level_2 () Real)
+(declare-fun bathtub_drawn_water_level_4 () Real)
+(declare-fun bathtub_drawn_water_level_1 () Real)
+(declare-fun bathtub_drawn_water_level_3 () Real)
+(declare-fun bathtub_drawn_water_level_5 () Real)
+(declare-fun bathtub_drawn_water_level_7 () Real)
+(declare-fun bathtub_drawn_water_level_9 () Real)
+(declare-fun bathtub_drawn_water_level_6 () Real)
+(declare-fun bathtub_drawn_water_level_8 () Real)
+(declare-fun bathtub_drawn_water_level_10 () Real)
+(declare-fun bathtub_drawn_water_level_0 () Real)
+(declare-fun bathtub_drawn_water_level_12 () Real)
+(declare-fun bathtub_drawn_water_level_14 () Real)
+(declare-fun bathtub_drawn_water_level_11 () Real)
+(declare-fun bathtub_drawn_water_level_13 () Real)
+(declare-fun bathtub_drawn_water_level_15 () Real)
+(assert (and (<= 0 __schedule_0_0) (< __schedule_0_0 2)))
+(assert (and (<= 0 __schedule_0_1) (< __schedule_0_1 2)))
+(assert (distinct __schedule_0_0 __schedule_0_1))
+(assert (and (<= 0 __schedule_1_0) (< __schedule_1_0 2)))
+(assert (and (<= 0 __schedule_1_1) (< __schedule_1_1 2)))
+(assert (distinct __schedule_1_0 __schedule_1_1))
+(assert (and (<= 0 __schedule_2_0) (< __schedule_2_0 2)))
+(assert (and (<= 0 __schedule_2_1) (< __schedule_2_1 2)))
+(assert (distinct __schedule_2_0 __schedule_2_1))
+(assert (= bathtub_drawn_water_level_2 (+ bathtub_drawn_water_level_1 10.0)))
+(assert (= bathtub_drawn_water_level_4 (- bathtub_drawn_water_level_3 20.0)))
+(assert (= bathtub_drawn_water_level_1 (ite (< __schedule_0_1 __schedule_0_0) bathtub_drawn_water_level_4 bathtub_drawn_water_level_0)))
+(assert (= bathtub_drawn_water_level_3 (ite (< __schedule_0_0 __schedule_0_1) bathtub_drawn_water_level_2 bathtub_drawn_water_level_0)))
+(assert (= bathtub_drawn_water_level_5 (ite (< __schedule_0_1 __schedule_0_0) bathtub_drawn_water_level_2 bathtub_drawn_water_level_4)))
+(assert (= bathtub_drawn_water_level_7 (+ bathtub_drawn_water_level_6 10.0)))
+(assert (= bathtub_drawn_water_level_9 (- bathtub_drawn_water_level_8 20.0)))
+(assert (= bathtub_drawn_water_level_6 (ite (< __schedule_1_1 __schedule_1_0) bathtub_drawn_water_level_9 bathtub_drawn_water_level_5)))
+(assert (= bathtub_drawn_water_level_8 (ite (< __schedule_1_0 __schedule_1_1) bathtub_drawn_water_level_7 bathtub_drawn_water_level_5)))
+(assert (= bathtub_drawn_water_level_10 (ite (< __schedule_1_1 __schedule_1_0) bathtub_drawn_water_level_7 bathtub_drawn_water_level_9)))
+(assert (= bathtub_drawn_water_level_0 5.0))
+(assert (= bathtub_drawn_water_level_12 (+ bathtub_drawn_water_level_11 10.0)))
+(assert (= bathtub_drawn_water_level_14 (- bathtub_drawn_water_level_13 20.0)))
+(assert (= bathtub_drawn_water_level_11 (ite (< __schedule_2_1 __schedule_2_0) bathtub_drawn_water_level_14 bathtub_drawn_water_level_10)))
+(assert (= bathtub_drawn_water_level_13 (ite (< __schedule_2_0 __schedule_2_1) bathtub_drawn_water_level_12 bathtub_drawn_water_level_10)))
+(assert (= bathtub_drawn_water_level_15 (ite (< __schedule_2_1 __schedule_2_0) bathtub_drawn_water_level_12 bathtub_drawn_water_level_14)))
@@ -20,127 +20,153 @@ func NewPrecompiler(check *types.Checker) *Precompiler {
 	}
 }
 
-func (c *Precompiler) Swap(n *ast.Spec) *ast.Spec {
-	s := c.walk(n)
-	return s.(*ast.Spec)
+func (c *Precompiler) Swap(n *ast.Spec) (*ast.Spec, error) {
+	s, err := c.walk(n)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*ast.Spec), nil
 }
 
-func (c *Precompiler) walk(n ast.Node) ast.Node {
-	var err error
+func (c *Precompiler) walk(n ast.Node) (ast.Node, error) {
 	switch node := n.(type) {
 	case *ast.StructInstance:
-		node, err = c.swapValues(node)
-		if err != nil {
-			panic(err)
-		}
-		return node
+		return c.swapValues(node)
 	case *ast.Spec:
 		var st []ast.Statement
 		for _, v := range node.Statements {
-			snode := c.walk(v)
+			snode, err := c.walk(v)
+			if err != nil {
+				return node, err
+			}
 			st = append(st, snode.(ast.Statement))
 		}
 		node.Statements = st
-		return node
+		return node, nil
 	case *ast.SpecDeclStatement:
-		return node
+		return node, nil
 	case *ast.SysDeclStatement:
-		return node
+		return node, nil
 	case *ast.ImportStatement:
-		snode := c.walk(node.Tree)
+		snode, err := c.walk(node.Tree)
+		if err != nil {
+			return node, err
+		}
 		node.Tree = snode.(*ast.Spec)
-		return node
+		return node, nil
 	case *ast.ConstantStatement:
-		return node
+		return node, nil
 	case *ast.Identifier:
-		return node
+		return node, nil
 	case *ast.DefStatement:
-		return node
+		return node, nil
 	case *ast.StockLiteral:
-		return node
+		return node, nil
 	case *ast.FlowLiteral:
-		return node
+		return node, nil
 	case *ast.ComponentLiteral:
-		return node
+		return node, nil
 	case *ast.AssertionStatement:
-		return node
+		return node, nil
+	case *ast.OptimizeStatement:
+		return node, nil
 	case *ast.ForStatement:
 		var st []ast.Statement
 		for _, v := range node.Inits.Statements {
-			snode := c.walk(v)
+			snode, err := c.walk(v)
+			if err != nil {
+				return node, err
+			}
 			st = append(st, snode.(ast.Statement))
 		}
 		node.Inits.Statements = st
-		return node
+		return node, nil
 	case *ast.StartStatement:
-		return node
+		return node, nil
 	case *ast.FunctionLiteral:
-		return node
+		return node, nil
 	case *ast.BlockStatement:
 		if node == nil {
-			return node
+			return node, nil
 		}
 		for i := 0; i < len(node.Statements); i++ {
 			if e, ok := node.Statements[i].(*ast.ExpressionStatement); ok {
-				snode := c.walk(e.Expression)
+				snode, err := c.walk(e.Expression)
+				if err != nil {
+					return node, err
+				}
 				node.Statements[i].(*ast.ExpressionStatement).Expression = snode.(ast.Expression)
 			}
 		}
-		return node
+		return node, nil
 	case *ast.BuiltIn:
-		return node
+		return node, nil
 	case *ast.IntegerLiteral:
-		return node
+		return node, nil
 	case *ast.FloatLiteral:
-		return node
+		return node, nil
 	case *ast.Boolean:
-		return node
+		return node, nil
 	case *ast.StringLiteral:
-		return node
+		return node, nil
 	case *ast.ParameterCall:
-		return node
+		return node, nil
 	case *ast.ExpressionStatement:
-		snode := c.walk(node.Expression)
+		snode, err := c.walk(node.Expression)
+		if err != nil {
+			return node, err
+		}
 		node.Expression = snode.(ast.Expression)
-		return node
+		return node, nil
 	case *ast.Natural:
-		return node
+		return node, nil
 	case *ast.Uncertain:
-		return node
+		return node, nil
 	case *ast.Unknown:
-		return node
+		return node, nil
 	case *ast.PrefixExpression:
-		return node
+		return node, nil
 	case *ast.InfixExpression:
-		return node
+		return node, nil
 	case *ast.This:
-		return node
+		return node, nil
 	case *ast.Clock:
-		return node
+		return node, nil
 	case *ast.Nil:
-		return node
+		return node, nil
 	case *ast.ParallelFunctions:
-		return node
+		return node, nil
 	case *ast.InitExpression:
-		return node
+		return node, nil
 	case *ast.IfExpression:
 		if node == nil {
-			return node
+			return node, nil
 		}
 		//Not sure to allow this
-		con := c.walk(node.Consequence)
-		alt := c.walk(node.Alternative)
-		elif := c.walk(node.Elif)
+		con, err := c.walk(node.Consequence)
+		if err != nil {
+			return node, err
+		}
+		alt, err := c.walk(node.Alternative)
+		if err != nil {
+			return node, err
+		}
+		elif, err := c.walk(node.Elif)
+		if err != nil {
+			return node, err
+		}
 		node.Consequence = con.(*ast.BlockStatement)
 		node.Alternative = alt.(*ast.BlockStatement)
 		node.Elif = elif.(*ast.IfExpression)
-		return node
+		return node, nil
 	case *ast.IndexExpression:
-		return node
+		return node, nil
 	case *ast.InvariantClause:
-		return node
+		return node, nil
+	case *ast.TemporalPrefix, *ast.TemporalInfix, *ast.TemporalWindow, *ast.ProbabilityExpression:
+		return node, nil
 	default:
-		panic(fmt.Errorf("unimplemented: %s type %T", node, node))
+		return node, ast.Errorf(node.Position(), "unimplemented: %s type %T", node, node)
 	}
 }
 
@@ -182,22 +208,25 @@ func (c *Precompiler) swapValues(base *ast.StructInstance) (*ast.StructInstance,
 		}
 
 		base.Properties[key].Value = val
-		base = c.swapDeepNames(base)
+		base, err = c.swapDeepNames(base)
+		if err != nil {
+			return base, err
+		}
 
 	}
 	return base, nil
 }
 
-func (c *Precompiler) swapDeepNames(val *ast.StructInstance) *ast.StructInstance {
+func (c *Precompiler) swapDeepNames(val *ast.StructInstance) (*ast.StructInstance, error) {
 	rawid := val.RawId()
 	err := c.checker.SpecStructs[rawid[0]].Update(rawid, ast.ExtractBranches(val.Properties))
 	if err != nil {
-		panic(fmt.Sprintf("failed to update spec record on swap %s: %s", val.String(), err))
+		return val, ast.Errorf(val.Position(), "failed to update spec record on swap %s: %s", val.String(), err)
 	}
 
 	node, err := c.checker.Preprocesser.Partial(rawid[0], val)
 	if err != nil {
-		panic(fmt.Sprintf("failed to update process ids on swap %s: %s", val.String(), err))
+		return val, ast.Errorf(val.Position(), "failed to update process ids on swap %s: %s", val.String(), err)
 	}
-	return node.(*ast.StructInstance)
+	return node.(*ast.StructInstance), nil
 }
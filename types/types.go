@@ -67,7 +67,7 @@ func (c *Checker) Reference(n ast.Node) (ast.Node, error) {
 
 func (c *Checker) typecheck(n ast.Node) (ast.Node, error) {
 	if n == nil {
-		panic("nil value")
+		return n, fmt.Errorf("nil value")
 	}
 	var tnode ast.Node
 	var err error
@@ -188,9 +188,26 @@ func (c *Checker) typecheck(n ast.Node) (ast.Node, error) {
 			return node, err
 		}
 		if valtype.Type != "BOOL" {
-			return nil, fmt.Errorf("assert statement not testing a Boolean expression. got=%s", valtype.Type)
+			return nil, ast.Errorf(node.Position(), "assert statement not testing a Boolean expression. got=%s", valtype.Type)
+		}
+		if node.IsProbabilistic() {
+			err = checkProbability(node)
 		}
 		return node, err
+	case *ast.OptimizeStatement:
+		n, err := c.inferOperand(node.Target)
+		if err != nil {
+			return node, err
+		}
+		pos := node.Position()
+		if valtype := typeable(n); valtype == nil || !IsNumeric(valtype) || ast.HasTemporal(node.Target) {
+			return nil, ast.Errorf(pos, "can only %s a number", node.Direction)
+		}
+		if node.Round < -1 {
+			return nil, ast.Errorf(pos, "%s at round %d, rounds start at 0", node.Direction, node.Round)
+		}
+		node.Target = n.(ast.Expression)
+		return node, nil
 
 	case *ast.ForStatement:
 		var st1 []ast.Statement
@@ -312,8 +329,16 @@ func (c *Checker) typecheck(n ast.Node) (ast.Node, error) {
 		return node, err
 	case *ast.InvariantClause:
 		return c.inferFunction(node)
+	case *ast.TemporalPrefix:
+		return c.inferFunction(node)
+	case *ast.TemporalInfix:
+		return c.inferFunction(node)
+	case *ast.TemporalWindow:
+		return c.inferFunction(node)
+	case *ast.ProbabilityExpression:
+		return c.inferFunction(node)
 	default:
-		return node, fmt.Errorf("unimplemented: %s type %T", node, node)
+		return node, ast.Errorf(node.Position(), "unimplemented: %s type %T", node, node)
 	}
 }
 
@@ -398,8 +423,15 @@ func (c *Checker) infer(exp interface{}) (ast.Node, error) {
 		return node, nil
 	case *ast.Uncertain:
 		if node.InferredType == nil {
+			if err := checkDistribution(node); err != nil {
+				return nil, err
+			}
 			params := c.inferUncertain(node)
-			node.InferredType = &ast.Type{Type: "UNCERTAIN", Scope: 0, Parameters: params}
+			if node.Dist == "bernoulli" { // A coin flip, used wherever a boolean can be
+				node.InferredType = &ast.Type{Type: "BOOL", Scope: 0, Parameters: params}
+			} else {
+				node.InferredType = &ast.Type{Type: "UNCERTAIN", Scope: 0, Parameters: params}
+			}
 		}
 		return node, nil
 
@@ -464,7 +496,7 @@ func (c *Checker) infer(exp interface{}) (ast.Node, error) {
 		spec := c.SpecStructs[rawid[0]]
 		con, _ := spec.FetchConstant(rawid[1])
 		if con != nil {
-			return nil, fmt.Errorf("variable %s is a constant cannot access by index", node.Left.String())
+			return nil, ast.Errorf(node.Position(), "variable %s is a constant cannot access by index", node.Left.String())
 		}
 
 		if node.InferredType == nil {
@@ -482,7 +514,7 @@ func (c *Checker) infer(exp interface{}) (ast.Node, error) {
 		return node, nil
 	default:
 		pos := node.(ast.Node).Position()
-		return nil, fmt.Errorf("unrecognized type: line %d col %d got=%T", pos[0], pos[1], node)
+		return nil, ast.Errorf(pos, "unrecognized type got=%T", node)
 	}
 }
 
@@ -523,7 +555,7 @@ func (c *Checker) LookupType(node ast.Node) (*ast.Type, error) {
 	ty, _ := spec.GetStructType(rawid)
 	v, err := spec.FetchVar(rawid, ty)
 	if err != nil {
-		return nil, fmt.Errorf("can't find node %s line:%d, col:%d", rawid, pos[0], pos[1])
+		return nil, ast.Errorf(pos, "can't find node %s", rawid)
 	}
 
 	if v.TokenLiteral() == "COMPOUND_STRING" {
@@ -542,6 +574,14 @@ func (c *Checker) LookupType(node ast.Node) (*ast.Type, error) {
 	return ret, err
 }
 
+// inferOperand types one side of an expression
+func (c *Checker) inferOperand(n ast.Expression) (ast.Node, error) {
+	if c.isValue(n) {
+		return c.infer(n)
+	}
+	return c.inferFunction(n)
+}
+
 func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 	var err error
 	switch node := f.(type) {
@@ -552,7 +592,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 			tn, ok := typedNode.(ast.Expression)
 			if !ok {
 				pos := typedNode.Position()
-				return nil, fmt.Errorf("node %T not an valid expression line: %d, col: %d", typedNode, pos[0], pos[1])
+				return nil, ast.Errorf(pos, "node %T not an valid expression", typedNode)
 			}
 			node.Body.Statements[0].(*ast.ExpressionStatement).Expression = tn
 			return node, err
@@ -589,7 +629,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 
 		if node.Operator == "<-" {
 			if c.inStock != "" {
-				return nil, fmt.Errorf("stock is the store of values, stock %s should be a flow", c.inStock)
+				return nil, ast.Errorf(node.Position(), "stock is the store of values, stock %s should be a flow", c.inStock)
 			}
 		}
 
@@ -616,7 +656,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 			node.Token.Type == "COMPOUND_STRING" { //In case of compound string based rules
 			ty, _ := c.LookupType(node.Left)
 			if ty != nil && !isConvertible(ty, right) {
-				return node, fmt.Errorf("cannot redeclare variable %s is type %s got %s", node.Left.String(), ty.Type, right.Type)
+				return node, ast.Errorf(node.Position(), "cannot redeclare variable %s is type %s got %s", node.Left.String(), ty.Type, right.Type)
 			}
 			node.InferredType = right
 			node.Left.SetType(right)
@@ -660,7 +700,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 
 		ty, err := typeAdju(left, right, node.Operator)
 		if err != nil {
-			return nil, err
+			return nil, ast.Errorf(node.Position(), "%s", err)
 		}
 		node.InferredType = ty
 		return node, err
@@ -691,7 +731,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 		node.Left = nl.(ast.Expression)
 		node.Right = nr.(ast.Expression)
 
-		if COMPARE[node.Operator] {
+		if COMPARE[node.Operator] || ast.TEMPORAL_BINARY[node.Operator] {
 			node.InferredType = &ast.Type{Type: "BOOL",
 				Scope:      0,
 				Parameters: nil}
@@ -716,11 +756,75 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 
 		ty, err := typeAdju(left, right, node.Operator)
 		if err != nil {
-			return nil, err
+			return nil, ast.Errorf(node.Position(), "%s", err)
 		}
 		node.InferredType = ty
 		return node, err
 
+	case *ast.TemporalPrefix:
+		nr, err := c.inferOperand(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		node.Right = nr.(ast.Expression)
+
+		node.InferredType = &ast.Type{Type: "BOOL",
+			Scope:      0,
+			Parameters: nil}
+		return node, err
+
+	case *ast.TemporalInfix:
+		nl, err := c.inferOperand(node.Left)
+		if err != nil {
+			return nil, err
+		}
+
+		nr, err := c.inferOperand(node.Right)
+		if err != nil {
+			return nil, err
+		}
+
+		node.Left = nl.(ast.Expression)
+		node.Right = nr.(ast.Expression)
+
+		node.InferredType = &ast.Type{Type: "BOOL",
+			Scope:      0,
+			Parameters: nil}
+		return node, err
+
+	case *ast.ProbabilityExpression:
+		ne, err := c.inferOperand(node.Event)
+		if err != nil {
+			return nil, err
+		}
+		if ty := typeable(ne); ty == nil || ty.Type != "BOOL" {
+			pos := node.Position()
+			return nil, ast.Errorf(pos, "prob() needs a Boolean event")
+		}
+		node.Event = ne.(ast.Expression)
+
+		node.InferredType = &ast.Type{Type: "FLOAT",
+			Scope:      0,
+			Parameters: nil}
+		return node, err
+
+	case *ast.TemporalWindow:
+		if node.From < 0 || (node.To >= 0 && node.To < node.From) {
+			pos := node.Position()
+			return nil, ast.Errorf(pos, "invalid window %s %d to %d", node.Operator, node.From, node.To)
+		}
+
+		nr, err := c.inferOperand(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		node.Right = nr.(ast.Expression)
+
+		node.InferredType = &ast.Type{Type: "BOOL",
+			Scope:      0,
+			Parameters: nil}
+		return node, err
+
 	case *ast.IfExpression:
 		var ncond ast.Node
 		var typedNode ast.Node
@@ -812,7 +916,7 @@ func (c *Checker) inferFunction(f ast.Expression) (ast.Expression, error) {
 		return node, err
 	default:
 		pos := node.(ast.Node).Position()
-		return nil, fmt.Errorf("unrecognized type: line %d col %d got=%T", pos[0], pos[1], node)
+		return nil, ast.Errorf(pos, "unrecognized type got=%T", node)
 	}
 }
 
@@ -823,10 +927,62 @@ func (c *Checker) inferScope(fl float64) int64 {
 }
 
 func (c *Checker) inferUncertain(node *ast.Uncertain) []ast.Type {
-	return []ast.Type{
-		{Type: "MEAN", Scope: c.inferScope(node.Mean), Parameters: nil},
-		{Type: "SIGMA", Scope: c.inferScope(node.Sigma), Parameters: nil},
+	d := node.Distribution()
+	var params []ast.Type
+	for i, name := range ast.DISTRIBUTIONS[d.Kind] {
+		params = append(params, ast.Type{Type: strings.ToUpper(name), Scope: c.inferScope(d.Params[i]), Parameters: nil})
 	}
+	return params
+}
+
+// checkDistribution makes sure the parameters of an uncertain
+// value describe a distribution gonum can sample
+func checkDistribution(node *ast.Uncertain) error {
+	d := node.Distribution()
+	pos := node.Position()
+	names, ok := ast.DISTRIBUTIONS[d.Kind]
+	if !ok {
+		return ast.Errorf(pos, "unknown distribution %s", d.Kind)
+	}
+	if len(d.Params) != len(names) {
+		return ast.Errorf(pos, "%s distribution takes %s got %d values", d.Kind, strings.Join(names, ", "), len(d.Params))
+	}
+
+	var positive []string
+	switch d.Kind {
+	case "normal", "lognormal":
+		positive = []string{"sigma"}
+	case "exponential":
+		positive = []string{"rate"}
+	case "poisson":
+		positive = []string{"lambda"}
+	case "beta":
+		positive = []string{"alpha", "beta"}
+	case "bernoulli":
+		if d.Params[0] < 0 || d.Params[0] > 1 {
+			return ast.Errorf(pos, "p of bernoulli distribution must be between 0 and 1 got %v", d.Params[0])
+		}
+		if d.Truncated {
+			return ast.Errorf(pos, "bernoulli distribution cannot be truncated")
+		}
+	case "uniform":
+		if d.Params[0] >= d.Params[1] {
+			return ast.Errorf(pos, "uniform distribution min %v must be less than max %v", d.Params[0], d.Params[1])
+		}
+	}
+
+	for i, name := range names {
+		for _, p := range positive {
+			if name == p && d.Params[i] <= 0 {
+				return ast.Errorf(pos, "%s of %s distribution must be positive got %v", name, d.Kind, d.Params[i])
+			}
+		}
+	}
+
+	if d.Truncated && d.Low >= d.High {
+		return ast.Errorf(pos, "truncation bounds [%v, %v] are empty", d.Low, d.High)
+	}
+	return nil
 }
 
 func (c *Checker) inferSwap(node *ast.InfixExpression) (ast.Expression, error) {
@@ -844,11 +1000,11 @@ func (c *Checker) inferSwap(node *ast.InfixExpression) (ast.Expression, error) {
 	}
 
 	if left.Type() != right.Type() {
-		return node, fmt.Errorf("cannot redeclare variable %s is type %s got %s", node.Left.String(), left.Type(), right.Type())
+		return node, ast.Errorf(node.Position(), "cannot redeclare variable %s is type %s got %s", node.Left.String(), left.Type(), right.Type())
 	}
 
 	if c.InstanceOf(left) != c.InstanceOf(right) {
-		return node, fmt.Errorf("cannot redeclare variable %s is instance of %s got %s", node.Left.String(), c.InstanceOf(left), c.InstanceOf(right))
+		return node, ast.Errorf(node.Position(), "cannot redeclare variable %s is instance of %s got %s", node.Left.String(), c.InstanceOf(left), c.InstanceOf(right))
 	}
 
 	node.Left = left.(ast.Expression)
@@ -945,13 +1101,13 @@ func (c *Checker) swapValues(base *ast.StructInstance) (*ast.StructInstance, err
 	return base, nil
 }
 
-func (c *Checker) swapDeepNames(val *ast.StructInstance) *ast.StructInstance {
+func (c *Checker) swapDeepNames(val *ast.StructInstance) (*ast.StructInstance, error) {
 	rawid := val.RawId()
 	node, err := c.Preprocesser.Partial(rawid[0], val)
 	if err != nil {
-		panic(fmt.Sprintf("failed to update process ids on swap %s", val.String()))
+		return nil, ast.Errorf(val.Position(), "failed to update process ids on swap %s", val.String())
 	}
-	return node.(*ast.StructInstance)
+	return node.(*ast.StructInstance), nil
 }
 
 func (c *Checker) InstanceOf(node ast.Node) string {
@@ -1014,7 +1170,7 @@ func (c *Checker) lookupReference(base ast.Node) (ast.Node, error) {
 		if n != nil {
 			return n, err
 		}
-		return nil, fmt.Errorf("cannot establish node %s", b.IdString())
+		return nil, ast.Errorf(b.Position(), "cannot establish node %s", b.IdString())
 	default:
 		if c.isValue(base) {
 			return c.infer(base)
@@ -1222,7 +1378,54 @@ func typeable(node ast.Node) *ast.Type {
 		return n.InferredType
 	case *ast.InvariantClause:
 		return n.InferredType
+	case *ast.TemporalPrefix:
+		return n.InferredType
+	case *ast.TemporalInfix:
+		return n.InferredType
+	case *ast.TemporalWindow:
+		return n.InferredType
+	case *ast.ProbabilityExpression:
+		return n.InferredType
 	default:
 		return nil
 	}
 }
+
+// checkProbability makes sure a prob() assert compares the
+// probability to a number between 0 and 1. The bound is one
+// sided, prob(x) == 0.5 can't be estimated.
+func checkProbability(a *ast.AssertionStatement) error {
+	pos := a.Position()
+	if a.Assume {
+		return ast.Errorf(pos, "prob() can't be assumed")
+	}
+	if a.Temporal != "" || a.TemporalFilter != "" {
+		return ast.Errorf(pos, "temporal logic goes inside prob()")
+	}
+
+	bound := a.Constraint.Right
+	if _, ok := bound.(*ast.ProbabilityExpression); ok {
+		bound = a.Constraint.Left
+	}
+
+	var b float64
+	switch n := bound.(type) {
+	case *ast.FloatLiteral:
+		b = n.Value
+	case *ast.IntegerLiteral:
+		b = float64(n.Value)
+	default:
+		return ast.Errorf(pos, "prob() must be compared to a number")
+	}
+
+	switch a.Constraint.Operator {
+	case "<", "<=", ">", ">=":
+	default:
+		return ast.Errorf(pos, "prob() can only be bounded with <, <=, > or >= got=%s", a.Constraint.Operator)
+	}
+
+	if b < 0 || b > 1 {
+		return ast.Errorf(pos, "probability bound %v is not between 0 and 1", b)
+	}
+	return nil
+}
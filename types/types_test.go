@@ -4,6 +4,7 @@ import (
 	"fault/ast"
 	"fault/listener"
 	"fault/preprocess"
+	"fmt"
 	"testing"
 )
 
@@ -92,7 +93,7 @@ func TestStructTypeError(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "stock is the store of values, stock test1_fizz should be a flow"
+	actual := "stock is the store of values, stock test1_fizz should be a flow line: 9, col: 5"
 
 	if err == nil {
 		t.Fatalf("Type checking failed to catch invalid expression. Error is nil")
@@ -119,7 +120,7 @@ func TestInstanceError(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "can't find node [test1 fizz buzz] line:9, col:5"
+	actual := "can't find node [test1 fizz buzz] line: 9, col: 5"
 
 	if err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -599,7 +600,7 @@ func TestInvalidAssert(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "assert statement not testing a Boolean expression. got=FLOAT"
+	actual := "assert statement not testing a Boolean expression. got=FLOAT line: 4, col: 3"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -615,7 +616,7 @@ func TestInvalidAssert2(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "assert statement not testing a Boolean expression. got=FLOAT"
+	actual := "assert statement not testing a Boolean expression. got=FLOAT line: 4, col: 3"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -631,7 +632,7 @@ func TestInvalidAssert3(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "invalid expression: got=BOOL + FLOAT"
+	actual := "invalid expression: got=BOOL + FLOAT line: 4, col: 10"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -661,7 +662,7 @@ func TestInvalidInfix(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "type mismatch: got=INT,STRING"
+	actual := "type mismatch: got=INT,STRING line: 3, col: 13"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -677,7 +678,7 @@ func TestInvalidInfix2(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "type mismatch: got=STRING,INT"
+	actual := "type mismatch: got=STRING,INT line: 3, col: 12"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -696,7 +697,7 @@ func TestRedeclareError(t *testing.T) {
 	`
 	_, err := prepTest(test, true)
 
-	actual := "cannot redeclare variable a is type BOOL got FLOAT"
+	actual := "cannot redeclare variable a is type BOOL got FLOAT line: 5, col: 5"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -917,7 +918,7 @@ func TestSwapError(t *testing.T) {
 
 	_, err := prepTest(test, true)
 
-	actual := "cannot redeclare variable f2.x is type STOCK got FLOAT"
+	actual := "cannot redeclare variable f2.x is type STOCK got FLOAT line: 14, col: 2"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -948,7 +949,7 @@ func TestSwapError2(t *testing.T) {
 
 	_, err := prepTest(test, true)
 
-	actual := "cannot redeclare variable f2.x is instance of test.s1 got test.s2"
+	actual := "cannot redeclare variable f2.x is instance of test.s1 got test.s2 line: 18, col: 2"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -980,7 +981,7 @@ func TestSwapError3(t *testing.T) {
 
 	_, err := prepTest(test, true)
 
-	actual := "cannot redeclare variable f2.x is instance of test.s1 got test.s2"
+	actual := "cannot redeclare variable f2.x is instance of test.s1 got test.s2 line: 19, col: 2"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -995,7 +996,7 @@ func TestIndexError(t *testing.T) {
 
 	_, err := prepTest(test, true)
 
-	actual := "variable a is a constant cannot access by index"
+	actual := "variable a is a constant cannot access by index line: 3, col: 8"
 
 	if err == nil || err.Error() != actual {
 		t.Fatalf("Type checking failed to catch invalid expression. got=%s", err)
@@ -1015,3 +1016,107 @@ func prepTest(test string, specType bool) (*Checker, error) {
 	_, err := ty.Check(pre.Processed)
 	return ty, err
 }
+
+func TestDistributionError(t *testing.T) {
+	for _, u := range []string{
+		"uncertain(beta, 2, -1)",
+		"uncertain(uniform, 5, 1)",
+		"uncertain(exponential, 0)",
+		"uncertain(normal, 10, 2, 5, 1)",
+		"uncertain(bernoulli, 1.5)",
+		"uncertain(bernoulli, 0.5, 0, 1)",
+	} {
+		test := fmt.Sprintf(`spec test1;
+			const z = %s;
+		`, u)
+		if _, err := prepTest(test, true); err == nil {
+			t.Errorf("%s should not type check", u)
+		}
+	}
+
+	test := `spec test1;
+			const z = uncertain(lognormal, 0, 0.25, 0, 3);
+	`
+	checker, err := prepTest(test, true)
+	if err != nil {
+		t.Fatalf("Type checking failed on valid distribution. got=%s", err)
+	}
+
+	z, _ := checker.SpecStructs["test1"].FetchConstant("z")
+	params := z.(*ast.Uncertain).InferredType.Parameters
+	if len(params) != 2 || params[0].Type != "MU" || params[1].Type != "SIGMA" || params[1].Scope != 100 {
+		t.Fatalf("lognormal parameters typed wrong. got=%v", params)
+	}
+
+	test = `spec test1;
+			const flip = uncertain(bernoulli, 0.9);
+	`
+	checker, err = prepTest(test, true)
+	if err != nil {
+		t.Fatalf("Type checking failed on valid distribution. got=%s", err)
+	}
+
+	flip, _ := checker.SpecStructs["test1"].FetchConstant("flip")
+	if ty := flip.(*ast.Uncertain).InferredType; ty.Type != "BOOL" || ty.Parameters[0].Type != "P" {
+		t.Fatalf("bernoulli uncertain typed wrong. got=%v", ty)
+	}
+}
+
+func TestProbabilityBound(t *testing.T) {
+	token := ast.Token{Literal: "assert", Position: []int{3, 1, 3, 20}}
+	event := &ast.Boolean{Token: token, Value: true}
+	prob := &ast.ProbabilityExpression{Token: token, Event: event}
+
+	tests := []struct {
+		op    string
+		bound ast.Expression
+		ok    bool
+	}{
+		{"<", &ast.FloatLiteral{Token: token, Value: 0.01}, true},
+		{">=", &ast.IntegerLiteral{Token: token, Value: 1}, true},
+		{"==", &ast.FloatLiteral{Token: token, Value: 0.5}, false},
+		{"<", &ast.FloatLiteral{Token: token, Value: 1.5}, false},
+		{"<", &ast.Boolean{Token: token, Value: true}, false},
+	}
+
+	for _, test := range tests {
+		a := &ast.AssertionStatement{Token: token, Constraint: &ast.InvariantClause{Token: token, Left: prob, Operator: test.op, Right: test.bound}}
+		err := checkProbability(a)
+		if test.ok && err != nil {
+			t.Errorf("prob() %s %s should be valid. got=%s", test.op, test.bound, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("prob() %s %s should not be valid", test.op, test.bound)
+		}
+	}
+}
+
+func TestOptimizeTarget(t *testing.T) {
+	token := ast.Token{Literal: "maximize", Position: []int{3, 1, 3, 20}}
+	tests := []struct {
+		target ast.Expression
+		round  int64
+		ok     bool
+	}{
+		{&ast.FloatLiteral{Token: token, Value: 2.5}, -1, true},
+		{&ast.FloatLiteral{Token: token, Value: 2}, 4, true},
+		{&ast.Boolean{Token: token, Value: true}, -1, false},
+		{&ast.FloatLiteral{Token: token, Value: 2.5}, -2, false},
+	}
+
+	for _, test := range tests {
+		checker, err := prepTest("spec test1;", true)
+		if err != nil {
+			t.Fatalf("type checking failed on an empty spec. got=%s", err)
+		}
+
+		o := &ast.OptimizeStatement{Token: token, Direction: "maximize", Target: test.target, Round: test.round}
+		_, err = checker.typecheck(o)
+		if test.ok && err != nil {
+			t.Errorf("%s should be valid. got=%s", o, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("%s should not be valid", o)
+		}
+	}
+}
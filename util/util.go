@@ -22,6 +22,16 @@ var OP_NEGATE = map[string]string{
 	//"=": "!=",
 }
 
+// Same comparison with the sides swapped
+var OP_FLIP = map[string]string{
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+	"==": "==",
+	"!=": "!=",
+}
+
 type StringSet struct {
 	base map[string]bool
 	vals []string